@@ -0,0 +1,8 @@
+data "cloudflare_access_ca_certificate" "example" {
+  account_id     = "f037e56e89293a057740de681ac9abbe"
+  application_id = "6cd6cea3-3ef2-4542-9aea-85a0bbcd5414"
+}
+
+output "ssh_ca_public_key" {
+  value = data.cloudflare_access_ca_certificate.example.public_key
+}
@@ -0,0 +1,7 @@
+data "cloudflare_access_organization" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
+
+output "access_login_url" {
+  value = "https://${data.cloudflare_access_organization.example.auth_domain}"
+}
@@ -0,0 +1,8 @@
+data "cloudflare_api_shield_operations" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  filter {
+    method = "GET"
+    host   = "api.example.com"
+  }
+}
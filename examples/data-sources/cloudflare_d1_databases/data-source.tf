@@ -0,0 +1,4 @@
+data "cloudflare_d1_databases" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "terraform"
+}
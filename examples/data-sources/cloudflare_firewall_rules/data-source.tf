@@ -0,0 +1,16 @@
+data "cloudflare_firewall_rules" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
+
+# Import every existing firewall rule and its filter.
+import {
+  for_each = { for rule in data.cloudflare_firewall_rules.example.rules : rule.id => rule }
+  to       = cloudflare_firewall_rule.imported[each.key]
+  id       = "0da42c8d2132a9ddaf714f9e7c920711/${each.value.id}"
+}
+
+import {
+  for_each = { for rule in data.cloudflare_firewall_rules.example.rules : rule.id => rule }
+  to       = cloudflare_filter.imported[each.key]
+  id       = "0da42c8d2132a9ddaf714f9e7c920711/${each.value.filter_id}"
+}
@@ -0,0 +1,14 @@
+data "cloudflare_gateway_app_types" "slack" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Slack"
+}
+
+resource "cloudflare_teams_rule" "block_slack" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "block slack"
+  description = "Block Slack"
+  precedence  = 1
+  action      = "block"
+  filters     = ["http"]
+  traffic     = "any(app.ids[*] in {${data.cloudflare_gateway_app_types.slack.app_types[0].id}})"
+}
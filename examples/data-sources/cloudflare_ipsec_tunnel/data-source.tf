@@ -0,0 +1,12 @@
+data "cloudflare_ipsec_tunnel" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "IPsec_1"
+}
+
+output "ipsec_tunnel_health_check" {
+  value = {
+    enabled = data.cloudflare_ipsec_tunnel.example.health_check_enabled
+    target  = data.cloudflare_ipsec_tunnel.example.health_check_target
+    rate    = data.cloudflare_ipsec_tunnel.example.tunnel_health_check_rate
+  }
+}
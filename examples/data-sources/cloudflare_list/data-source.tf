@@ -0,0 +1,22 @@
+data "cloudflare_list" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "allowed_ips"
+}
+
+resource "cloudflare_ruleset" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  name    = "allow listed IPs"
+  kind    = "zone"
+  phase   = "http_request_firewall_custom"
+
+  rules {
+    action      = "skip"
+    expression  = format("ip.src in $%s", data.cloudflare_list.example.name)
+    description = "Skip remaining rules for allowed IPs"
+    enabled     = true
+
+    action_parameters {
+      ruleset = "current"
+    }
+  }
+}
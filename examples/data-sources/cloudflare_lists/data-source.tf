@@ -0,0 +1,8 @@
+data "cloudflare_lists" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  kind       = "ip"
+}
+
+output "ip_list_names" {
+  value = data.cloudflare_lists.example.lists[*].name
+}
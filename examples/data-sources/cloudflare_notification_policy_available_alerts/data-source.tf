@@ -0,0 +1,3 @@
+data "cloudflare_notification_policy_available_alerts" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
@@ -0,0 +1,7 @@
+data "cloudflare_observatory_pages" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
+
+output "tested_pages" {
+  value = data.cloudflare_observatory_pages.example.pages[*].url
+}
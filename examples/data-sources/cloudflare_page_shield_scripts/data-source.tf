@@ -0,0 +1,4 @@
+data "cloudflare_page_shield_scripts" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  status  = "active"
+}
@@ -0,0 +1,7 @@
+data "cloudflare_teams_proxy_endpoints" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
+
+output "proxy_endpoint_subdomains" {
+  value = data.cloudflare_teams_proxy_endpoints.example.endpoints[*].subdomain
+}
@@ -0,0 +1,4 @@
+data "cloudflare_waiting_room_events" "example" {
+  zone_id         = "0da42c8d2132a9ddaf714f9e7c920711"
+  waiting_room_id = "d41d8cd98f00b204e9800998ecf8427e"
+}
@@ -0,0 +1,10 @@
+data "cloudflare_waiting_rooms" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+}
+
+locals {
+  waiting_rooms_by_name = {
+    for room in data.cloudflare_waiting_rooms.example.waiting_rooms :
+    room.name => room
+  }
+}
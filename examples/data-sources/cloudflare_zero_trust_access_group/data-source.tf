@@ -0,0 +1,16 @@
+data "cloudflare_zero_trust_access_group" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Engineering"
+}
+
+resource "cloudflare_access_policy" "example" {
+  application_id = "cb029e245cfdd66dc8d2e570d5dd3322"
+  account_id     = "f037e56e89293a057740de681ac9abbe"
+  name           = "engineering"
+  precedence     = "1"
+  decision       = "allow"
+
+  include {
+    group = [data.cloudflare_zero_trust_access_group.example.id]
+  }
+}
@@ -0,0 +1,10 @@
+data "cloudflare_zero_trust_access_service_tokens" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+}
+
+locals {
+  service_token_ids_by_name = {
+    for token in data.cloudflare_zero_trust_access_service_tokens.example.service_tokens :
+    token.name => token.id
+  }
+}
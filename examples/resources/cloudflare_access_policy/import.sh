@@ -3,3 +3,6 @@ $ terraform import cloudflare_access_policy.example account/<account_id>/<applic
 
 # Zone level import.
 $ terraform import cloudflare_access_policy.example zone/<zone_id>/<application_id>/<policy_id>
+
+# Reusable account level import.
+$ terraform import cloudflare_access_policy.example account/<account_id>/<policy_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_address_map.example <account_id>/<address_map_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_api_shield_operation.example <zone_id>/<operation_id>
@@ -118,3 +118,15 @@ resource "cloudflare_api_token" "dns_edit_all_account" {
     }
   }
 }
+
+# Permission groups referenced by name
+resource "cloudflare_api_token" "dns_read" {
+  name = "dns_read"
+
+  policy {
+    permission_group_names = ["DNS Read"]
+    resources = {
+      "com.cloudflare.api.account.zone.*" = "*"
+    }
+  }
+}
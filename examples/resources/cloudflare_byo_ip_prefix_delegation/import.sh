@@ -0,0 +1 @@
+$ terraform import cloudflare_byo_ip_prefix_delegation.example <account_id>/<prefix_id>/<delegation_id>
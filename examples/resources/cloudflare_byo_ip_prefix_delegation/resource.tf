@@ -0,0 +1,6 @@
+resource "cloudflare_byo_ip_prefix_delegation" "example" {
+  account_id           = "f037e56e89293a057740de681ac9abbe"
+  prefix_id            = "d41d8cd98f00b204e9800998ecf8427e"
+  cidr                 = "192.0.2.0/26"
+  delegated_account_id = "b1946ac92492d2347c6235b4d2611184"
+}
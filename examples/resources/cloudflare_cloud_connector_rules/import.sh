@@ -0,0 +1 @@
+$ terraform import cloudflare_cloud_connector_rules.example <zone_id>
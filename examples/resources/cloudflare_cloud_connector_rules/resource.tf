@@ -0,0 +1,21 @@
+resource "cloudflare_cloud_connector_rules" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+
+  rules {
+    description = "Serve images from S3"
+    expression  = "http.request.uri.path wildcard \"/images/*\""
+    provider    = "aws_s3"
+    parameters {
+      host = "images.s3.us-east-1.amazonaws.com"
+    }
+  }
+
+  rules {
+    description = "Serve everything else from R2"
+    expression  = "true"
+    provider    = "cloudflare_r2"
+    parameters {
+      host = "assets.example.com"
+    }
+  }
+}
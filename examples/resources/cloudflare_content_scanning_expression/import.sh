@@ -0,0 +1 @@
+$ terraform import cloudflare_content_scanning_expression.example <zone_id>/<expression_id>
@@ -0,0 +1 @@
+$ terraform import cloudflare_d1_database.example <account_id>/<database_uuid>
@@ -39,4 +39,10 @@ resource "cloudflare_dlp_profile" "example_custom" {
 		validation = "luhn"
 	}
   }
-}
\ No newline at end of file
+
+  entry {
+	name = "Matches customer records"
+	enabled = true
+	dataset_id = "0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11"
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_gateway_certificate.example <account_id>/<certificate_id>
@@ -0,0 +1,17 @@
+# Gateway managed root CA.
+resource "cloudflare_gateway_certificate" "example" {
+  account_id      = "f037e56e89293a057740de681ac9abbe"
+  gateway_managed = true
+  activate        = true
+}
+
+# Custom root CA.
+resource "cloudflare_gateway_certificate" "custom" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  activate   = false
+
+  custom {
+    certificate = file("root-ca.pem")
+    private_key = file("root-ca-key.pem")
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_hyperdrive_config.example <account_id>/<hyperdrive_config_id>
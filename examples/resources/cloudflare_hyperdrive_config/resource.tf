@@ -0,0 +1,17 @@
+resource "cloudflare_hyperdrive_config" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "production-database"
+
+  origin {
+    host     = "database.example.com"
+    port     = 5432
+    database = "app"
+    user     = "hyperdrive"
+    password = var.database_password
+  }
+
+  caching {
+    max_age                = 60
+    stale_while_revalidate = 15
+  }
+}
@@ -75,3 +75,20 @@ resource "cloudflare_logpush_job" "example_job" {
   dataset             = "http_requests"
   frequency           = "high"
 }
+
+# Example Usage (output options and filter)
+resource "cloudflare_logpush_job" "example_output_options" {
+  enabled          = true
+  zone_id          = "0da42c8d2132a9ddaf714f9e7c920711"
+  name             = "My-filtered-logpush-job"
+  destination_conf = "https://logs.example.com/cloudflare?header_Authorization=Basic%20REDACTED"
+  dataset          = "http_requests"
+  filter           = jsonencode({ where = { key = "ClientRequestHost", operator = "eq", value = "example.com" } })
+
+  output_options {
+    field_names      = ["ClientIP", "EdgeStartTimestamp", "RayID"]
+    timestamp_format = "rfc3339"
+    sample_rate      = 0.5
+    cve_2021_44228   = true
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_magic_network_monitoring_configuration.example <account_id>
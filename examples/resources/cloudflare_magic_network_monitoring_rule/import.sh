@@ -0,0 +1 @@
+$ terraform import cloudflare_magic_network_monitoring_rule.example <account_id>/<rule_id>
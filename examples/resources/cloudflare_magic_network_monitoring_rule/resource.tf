@@ -0,0 +1,8 @@
+resource "cloudflare_magic_network_monitoring_rule" "example" {
+  account_id              = "f037e56e89293a057740de681ac9abbe"
+  name                    = "example-rule"
+  automatic_advertisement = true
+  bandwidth_threshold     = 1000000000
+  duration                = "5m"
+  prefixes                = ["192.0.2.0/24", "198.51.100.0/24"]
+}
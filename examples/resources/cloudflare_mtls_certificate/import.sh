@@ -0,0 +1 @@
+$ terraform import cloudflare_mtls_certificate.example <account_id>/<mtls_certificate_id>
@@ -17,5 +17,8 @@ resource "cloudflare_origin_ca_certificate" "example" {
   csr                = tls_cert_request.example.cert_request_pem
   hostnames          = ["example.com"]
   request_type       = "origin-rsa"
-  requested_validity = 7
+  requested_validity = 90
+
+  # Replace the certificate on the first apply within 30 days of expiry.
+  min_days_for_renewal = 30
 }
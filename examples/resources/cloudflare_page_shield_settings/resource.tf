@@ -0,0 +1,6 @@
+resource "cloudflare_page_shield_settings" "example" {
+  zone_id                           = "0da42c8d2132a9ddaf714f9e7c920711"
+  enabled                           = true
+  use_cloudflare_reporting_endpoint = true
+  use_connection_url_path           = false
+}
@@ -1,5 +1,11 @@
 # Import an account scoped Ruleset configuration.
 $ terraform import cloudflare_ruleset.example account/<account_id>/<ruleset_id>
 
+# Import an account scoped Ruleset configuration without the resource type.
+$ terraform import cloudflare_ruleset.example <account_id>/<ruleset_id>
+
 # Import a zone scoped Ruleset configuration.
 $ terraform import cloudflare_ruleset.example zone/<zone_id>/<ruleset_id>
+
+# The resource type can also be given as a discriminator.
+$ terraform import cloudflare_ruleset.example zone:<zone_id>/<ruleset_id>
@@ -319,6 +319,35 @@ resource "cloudflare_ruleset" "redirect_from_list_example" {
   }
 }
 
+# Custom firewall rules referencing IP List resources
+resource "cloudflare_ruleset" "zone_custom_firewall_ip_lists" {
+  zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
+  name        = "Custom firewall rules using IP lists"
+  description = ""
+  kind        = "zone"
+  phase       = "http_request_firewall_custom"
+
+  rules {
+    action = "skip"
+    action_parameters {
+      ruleset = "current"
+    }
+    expression  = "ip.src in $allowed_ips"
+    description = "Skip the remaining custom rules for allowed IPs"
+    enabled     = true
+    logging {
+      enabled = true
+    }
+  }
+
+  rules {
+    action      = "block"
+    expression  = "ip.src in $blocked_ips"
+    description = "Block IPs in the blocked_ips list"
+    enabled     = true
+  }
+}
+
 # Dynamic Redirects from value resource
 resource "cloudflare_ruleset" "redirect_from_value_example" {
   zone_id     = "0da42c8d2132a9ddaf714f9e7c920711"
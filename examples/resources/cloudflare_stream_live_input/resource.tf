@@ -0,0 +1,13 @@
+resource "cloudflare_stream_live_input" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+
+  meta = {
+    name = "example"
+  }
+
+  recording {
+    mode                = "automatic"
+    timeout_seconds     = 10
+    require_signed_urls = false
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_web_analytics_rule.example <account_id>/<ruleset_id>/<rule_id>
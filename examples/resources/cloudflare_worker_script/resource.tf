@@ -44,4 +44,14 @@ resource "cloudflare_worker_script" "my_script" {
     name    = "MY_DATASET"
     dataset = "dataset1"
   }
+
+  queue_binding {
+    binding = "MY_QUEUE"
+    queue   = "my-queue"
+  }
+
+  d1_database_binding {
+    name        = "MY_DATABASE"
+    database_id = "ce8b95dc-b376-4ff8-9b9e-1801ed6d745d"
+  }
 }
@@ -0,0 +1 @@
+$ terraform import cloudflare_worker_secret.example <account_id>/<script_name>/<secret_name>
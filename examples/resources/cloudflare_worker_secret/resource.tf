@@ -0,0 +1,6 @@
+resource "cloudflare_worker_secret" "my_secret" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  script_name = "script_1"
+  name        = "MY_EXAMPLE_SECRET_TEXT"
+  secret_text = var.secret_foo_value
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_workers_for_platforms_dispatch_namespace.example <account_id>/<namespace_name>
@@ -9,3 +9,10 @@ resource "cloudflare_workers_kv" "example" {
   key          = "test-key"
   value        = "test value"
 }
+
+resource "cloudflare_workers_kv" "example_file" {
+  account_id   = "f037e56e89293a057740de681ac9abbe"
+  namespace_id = cloudflare_workers_kv_namespace.example_ns.id
+  key          = "test-file-key"
+  value_file   = "${path.module}/value.bin"
+}
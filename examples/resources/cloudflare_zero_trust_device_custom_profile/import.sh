@@ -0,0 +1 @@
+$ terraform import cloudflare_zero_trust_device_custom_profile.example <account_id>/<device_policy_id>
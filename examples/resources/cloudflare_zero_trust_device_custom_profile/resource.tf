@@ -0,0 +1,15 @@
+resource "cloudflare_zero_trust_device_custom_profile" "developer_warp_policy" {
+  account_id            = "f037e56e89293a057740de681ac9abbe"
+  name                  = "Developers WARP settings policy"
+  match                 = "any(identity.groups.name[*] in {\"Developers\"})"
+  precedence            = 10
+  allow_mode_switch     = true
+  allow_updates         = true
+  allowed_to_leave      = true
+  auto_connect          = 0
+  captive_portal        = 5
+  disable_auto_fallback = true
+  support_url           = "https://cloudflare.com"
+  switch_locked         = true
+  service_mode_v2_mode  = "warp"
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_zero_trust_device_custom_profile_local_domain_fallback.example <account_id>/<policy_id>
@@ -0,0 +1,18 @@
+resource "cloudflare_device_settings_policy" "developer_warp_policy" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "Developers"
+  enabled    = true
+  match      = "identity.email == \"test@cloudflare.com\""
+  precedence = 10
+}
+
+resource "cloudflare_zero_trust_device_custom_profile_local_domain_fallback" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  policy_id  = cloudflare_device_settings_policy.developer_warp_policy.id
+
+  domains {
+    suffix      = "example.com"
+    description = "Domain bypass for local development"
+    dns_server  = ["1.1.1.1", "192.168.0.1"]
+  }
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_zero_trust_device_settings.example <account_id>
@@ -0,0 +1,8 @@
+resource "cloudflare_zero_trust_device_settings" "example" {
+  account_id                            = "f037e56e89293a057740de681ac9abbe"
+  disable_for_time                      = 3600
+  gateway_proxy_enabled                 = true
+  gateway_udp_proxy_enabled             = true
+  root_certificate_installation_enabled = true
+  use_zt_virtual_ip                     = false
+}
@@ -0,0 +1 @@
+$ terraform import cloudflare_zero_trust_dex_test.example <account_id>/<dex_test_id>
@@ -0,0 +1,16 @@
+resource "cloudflare_zero_trust_dex_test" "example" {
+  account_id  = "f037e56e89293a057740de681ac9abbe"
+  name        = "Dashboard"
+  description = "Dashboard availability for engineering devices"
+  interval    = "0h30m0s"
+  enabled     = true
+
+  data {
+    kind   = "http"
+    host   = "https://dash.cloudflare.com"
+    method = "GET"
+  }
+
+  targeted        = true
+  target_policies = [cloudflare_device_settings_policy.engineering.id]
+}
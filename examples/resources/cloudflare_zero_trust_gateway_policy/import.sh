@@ -0,0 +1 @@
+$ terraform import cloudflare_zero_trust_gateway_policy.example <account_id>/<policy_id>
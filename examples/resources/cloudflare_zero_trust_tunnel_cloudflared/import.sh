@@ -0,0 +1,2 @@
+# The tunnel secret can't be imported as the API never returns it.
+$ terraform import cloudflare_zero_trust_tunnel_cloudflared.example <account_id>/<tunnel_id>
@@ -0,0 +1,10 @@
+resource "cloudflare_zero_trust_tunnel_cloudflared" "example" {
+  account_id = "f037e56e89293a057740de681ac9abbe"
+  name       = "my-tunnel"
+  secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+}
+
+output "tunnel_install_command" {
+  value     = "cloudflared service install ${cloudflare_zero_trust_tunnel_cloudflared.example.tunnel_token}"
+  sensitive = true
+}
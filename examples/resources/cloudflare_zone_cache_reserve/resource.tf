@@ -0,0 +1,4 @@
+resource "cloudflare_zone_cache_reserve" "example" {
+  zone_id = "0da42c8d2132a9ddaf714f9e7c920711"
+  enabled = true
+}
@@ -0,0 +1,21 @@
+resource "cloudflare_zone_setting" "always_online" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  setting_id = "always_online"
+  value      = "on"
+}
+
+resource "cloudflare_zone_setting" "browser_cache_ttl" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  setting_id = "browser_cache_ttl"
+  value      = jsonencode(14400)
+}
+
+resource "cloudflare_zone_setting" "minify" {
+  zone_id    = "0da42c8d2132a9ddaf714f9e7c920711"
+  setting_id = "minify"
+  value = jsonencode({
+    css  = "on"
+    html = "off"
+    js   = "on"
+  })
+}
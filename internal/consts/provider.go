@@ -43,6 +43,12 @@ const (
 	// Default value for the API base path.
 	APIBasePathDefault = "/client/v4"
 
+	// Schema key for the API base URL configuration.
+	APIBaseURLSchemaKey = "api_base_url"
+
+	// Environment variable key for the API base URL configuration.
+	APIBaseURLEnvVarKey = "CLOUDFLARE_API_BASE_URL"
+
 	// Schema key for the requests per second configuration.
 	RPSSchemaKey = "rps"
 
@@ -94,4 +100,32 @@ const (
 	ZoneIDSchemaKey = "zone_id"
 
 	UserAgentDefault = "terraform/%s terraform-plugin-sdk/%s terraform-provider-cloudflare/%s"
+
+	// Schema key for the operator suffix appended to the User-Agent.
+	UserAgentOperatorSuffixSchemaKey = "user_agent_operator_suffix"
+
+	// Environment variable key for the operator suffix appended to the
+	// User-Agent.
+	UserAgentOperatorSuffixEnvVarKey = "CLOUDFLARE_USER_AGENT_OPERATOR_SUFFIX"
+
+	// Schema key for the Cloudflare API error codes that should not be
+	// retried.
+	NonRetryableErrorCodesSchemaKey = "non_retryable_error_codes"
+
+	// Environment variable key for the comma separated Cloudflare API error
+	// codes that should not be retried.
+	NonRetryableErrorCodesEnvVarKey = "CLOUDFLARE_NON_RETRYABLE_ERROR_CODES"
+
+	// Schema key for refusing to delete any resource.
+	PreventDestroyOverrideSchemaKey = "prevent_destroy_override"
+
+	// Environment variable key for refusing to delete any resource.
+	PreventDestroyOverrideEnvVarKey = "CLOUDFLARE_PREVENT_DESTROY_OVERRIDE"
+
+	// Schema key for comparing content attributes byte for byte.
+	ExactContentDiffsSchemaKey = "exact_content_diffs"
+
+	// Environment variable key for comparing content attributes byte for
+	// byte.
+	ExactContentDiffsEnvVarKey = "CLOUDFLARE_EXACT_CONTENT_DIFFS"
 )
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/sdkv2provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// ProtocolVersion6ProviderServerFactory combines the SDKv2 provider and the
+// plugin framework provider into a single protocol version 6 provider server.
+// A resource type can only be served by one of them so resources which are
+// migrated to the plugin framework are dropped from the SDKv2 provider, and
+// the provider schemas of both must be identical.
+func ProtocolVersion6ProviderServerFactory(ctx context.Context, version string) (func() tfprotov6.ProviderServer, error) {
+	frameworkProvider := New(version)()
+
+	sdkProvider := sdkv2provider.New(version)()
+	for _, r := range frameworkProvider.Resources(ctx) {
+		metadata := &resource.MetadataResponse{}
+		r().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cloudflare"}, metadata)
+		delete(sdkProvider.ResourcesMap, metadata.TypeName)
+	}
+
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkProvider
+		},
+		providerserver.NewProtocol6(frameworkProvider),
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer, nil
+}
@@ -3,19 +3,23 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
 	"regexp"
 	"strconv"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/providerdata"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/dlp_profile"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/service/record"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -45,11 +49,17 @@ type CloudflareProviderModel struct {
 	RPS               types.Int64  `tfsdk:"rps"`
 	AccountID         types.String `tfsdk:"account_id"`
 	APIBasePath       types.String `tfsdk:"api_base_path"`
+	APIBaseURL        types.String `tfsdk:"api_base_url"`
 	APIToken          types.String `tfsdk:"api_token"`
 	Retries           types.Int64  `tfsdk:"retries"`
 	MaxBackoff        types.Int64  `tfsdk:"max_backoff"`
 	APIClientLogging  types.Bool   `tfsdk:"api_client_logging"`
 	APIHostname       types.String `tfsdk:"api_hostname"`
+	UserAgentSuffix   types.String `tfsdk:"user_agent_operator_suffix"`
+
+	NonRetryableErrorCodes types.List `tfsdk:"non_retryable_error_codes"`
+	PreventDestroyOverride types.Bool `tfsdk:"prevent_destroy_override"`
+	ExactContentDiffs      types.Bool `tfsdk:"exact_content_diffs"`
 }
 
 func (p *CloudflareProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -140,7 +150,7 @@ func (p *CloudflareProvider) Schema(ctx context.Context, req provider.SchemaRequ
 
 			consts.AccountIDSchemaKey: schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: fmt.Sprintf("Configure API client to always use a specific account. Alternatively, can be configured using the `%s` environment variable.", consts.AccountIDEnvVarKey),
+				MarkdownDescription: fmt.Sprintf("Configure API client to always use a specific account. Account level resources that don't configure their own `account_id` use this account. Alternatively, can be configured using the `%s` environment variable.", consts.AccountIDEnvVarKey),
 				DeprecationMessage:  "Use resource specific `account_id` attributes instead.",
 			},
 
@@ -153,6 +163,38 @@ func (p *CloudflareProvider) Schema(ctx context.Context, req provider.SchemaRequ
 				Optional:            true,
 				MarkdownDescription: fmt.Sprintf("Configure the base path used by the API client. Alternatively, can be configured using the `%s` environment variable.", consts.APIBasePathEnvVarKey),
 			},
+
+			consts.APIBaseURLSchemaKey: schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Configure the full base URL used by the API client, for example `https://api.cloudflare.com/client/v4`. Takes precedence over `%s` and `%s`. Alternatively, can be configured using the `%s` environment variable.", consts.APIHostnameSchemaKey, consts.APIBasePathSchemaKey, consts.APIBaseURLEnvVarKey),
+			},
+
+			consts.UserAgentOperatorSuffixSchemaKey: schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("A value to append to the HTTP User Agent for all API calls. Useful for identifying the team or product making the requests when raising Cloudflare support tickets. Must only contain printable ASCII characters. Alternatively, can be configured using the `%s` environment variable.", consts.UserAgentOperatorSuffixEnvVarKey),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						utils.UserAgentOperatorSuffixRegexp,
+						"user agent operator suffix must only contain printable ASCII characters",
+					),
+				},
+			},
+
+			consts.NonRetryableErrorCodesSchemaKey: schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: fmt.Sprintf("List of Cloudflare API error codes that should not be retried. By default, requests that fail with a server error (5xx) are retried according to `retries`, `min_backoff` and `max_backoff`; server errors containing any of these codes fail immediately instead. Rate limited requests are always retried. Alternatively, can be configured using the `%s` environment variable as a comma separated list.", consts.NonRetryableErrorCodesEnvVarKey),
+			},
+
+			consts.PreventDestroyOverrideSchemaKey: schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Whether to refuse to delete any resource managed by the provider, including resources that would be replaced. Deleting a resource returns an error instead of calling the API, protecting against a runaway `terraform destroy`. Alternatively, can be configured using the `%s` environment variable.", consts.PreventDestroyOverrideEnvVarKey),
+			},
+
+			consts.ExactContentDiffsSchemaKey: schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Whether to compare Worker script content and Workers KV values byte for byte. By default, differences in the trailing whitespace of Worker scripts and in the formatting of JSON encoded Workers KV values are ignored. Alternatively, can be configured using the `%s` environment variable.", consts.ExactContentDiffsEnvVarKey),
+			},
 		},
 	}
 }
@@ -172,6 +214,7 @@ func (p *CloudflareProvider) Configure(ctx context.Context, req provider.Configu
 		accountID         string
 		baseHostname      string
 		basePath          string
+		apiBaseURL        string
 	)
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -190,7 +233,22 @@ func (p *CloudflareProvider) Configure(ctx context.Context, req provider.Configu
 	} else {
 		basePath = utils.GetDefaultFromEnv(consts.APIBasePathEnvVarKey, consts.APIBasePathDefault)
 	}
-	baseURL := cloudflare.BaseURL(fmt.Sprintf("https://%s%s", baseHostname, basePath))
+	apiBaseURL = fmt.Sprintf("https://%s%s", baseHostname, basePath)
+
+	if data.APIBaseURL.ValueString() != "" {
+		apiBaseURL = data.APIBaseURL.ValueString()
+	} else if v := utils.GetDefaultFromEnv(consts.APIBaseURLEnvVarKey, ""); v != "" {
+		apiBaseURL = v
+	}
+
+	if err := utils.ValidateAPIBaseURL(apiBaseURL); err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%q is not set correctly", consts.APIBaseURLSchemaKey),
+			err.Error(),
+		)
+		return
+	}
+	baseURL := cloudflare.BaseURL(apiBaseURL)
 
 	if !data.RPS.IsNull() {
 		rps = int64(data.RPS.ValueInt64())
@@ -250,9 +308,50 @@ func (p *CloudflareProvider) Configure(ctx context.Context, req provider.Configu
 
 	options = append(options, cloudflare.Debug(logging.IsDebugOrHigher()))
 
-	ua := fmt.Sprintf(consts.UserAgentDefault, req.TerraformVersion, meta.SDKVersionString(), p.version)
+	var uaSuffix string
+	if data.UserAgentSuffix.ValueString() != "" {
+		uaSuffix = data.UserAgentSuffix.ValueString()
+	} else {
+		uaSuffix = utils.GetDefaultFromEnv(consts.UserAgentOperatorSuffixEnvVarKey, "")
+	}
+
+	ua, err := utils.BuildUserAgent(fmt.Sprintf(consts.UserAgentDefault, req.TerraformVersion, meta.SDKVersionString(), p.version), uaSuffix)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("%q is not set correctly", consts.UserAgentOperatorSuffixSchemaKey),
+			err.Error(),
+		)
+		return
+	}
 	options = append(options, cloudflare.UserAgent(ua))
 
+	var nonRetryableErrorCodes []int
+	if !data.NonRetryableErrorCodes.IsNull() {
+		var codes []int64
+		resp.Diagnostics.Append(data.NonRetryableErrorCodes.ElementsAs(ctx, &codes, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for _, code := range codes {
+			nonRetryableErrorCodes = append(nonRetryableErrorCodes, int(code))
+		}
+	} else {
+		nonRetryableErrorCodes, err = utils.ParseErrorCodes(utils.GetDefaultFromEnv(consts.NonRetryableErrorCodesEnvVarKey, ""))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%q is not set correctly", consts.NonRetryableErrorCodesSchemaKey),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(nonRetryableErrorCodes) > 0 {
+		options = append(options, cloudflare.HTTPClient(&http.Client{
+			Transport: utils.NewNonRetryableErrorCodesTransport(nonRetryableErrorCodes, http.DefaultTransport),
+		}))
+	}
+
 	config := Config{Options: options}
 
 	if !data.APIToken.IsNull() {
@@ -310,13 +409,21 @@ func (p *CloudflareProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	if !data.AccountID.IsNull() {
+	if data.AccountID.ValueString() != "" {
 		accountID = data.AccountID.ValueString()
 	} else {
 		accountID = utils.GetDefaultFromEnv(consts.AccountIDEnvVarKey, "")
 	}
 
 	if accountID != "" {
+		if err := utils.ValidateAccountID(accountID); err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%q is not set correctly", consts.AccountIDSchemaKey),
+				err.Error(),
+			)
+			return
+		}
+
 		tflog.Info(ctx, fmt.Sprintf("using specified account id %s in Cloudflare provider", accountID))
 		options = append(options, cloudflare.UsingAccount(accountID))
 	}
@@ -331,13 +438,33 @@ func (p *CloudflareProvider) Configure(ctx context.Context, req provider.Configu
 		return
 	}
 
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	var preventDestroyOverride bool
+	if !data.PreventDestroyOverride.IsNull() {
+		preventDestroyOverride = data.PreventDestroyOverride.ValueBool()
+	} else {
+		preventDestroyOverride, err = utils.PreventDestroyOverrideFromEnv()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				fmt.Sprintf("%q is not set correctly", consts.PreventDestroyOverrideSchemaKey),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	providerData := &providerdata.ProviderData{
+		Client:                 client,
+		PreventDestroyOverride: preventDestroyOverride,
+	}
+
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 func (p *CloudflareProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		// NewExampleResource,
+		dlp_profile.NewResource,
+		record.NewResource,
 	}
 }
 
@@ -355,16 +482,47 @@ func New(version string) func() provider.Provider {
 	}
 }
 
-// testAccProtoV6ProviderFactories are used to instantiate a provider during
+// TestAccProtoV6ProviderFactories are used to instantiate a provider during
 // acceptance testing. The factory function will be invoked for every Terraform
 // CLI command executed to create a provider server to which the CLI can
-// reattach.
+// reattach. The provider server is muxed so that test configurations can use
+// both SDKv2 and plugin framework resources.
 var TestAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-	"scaffolding": providerserver.NewProtocol6WithError(New("test")()),
+	"cloudflare": func() (tfprotov6.ProviderServer, error) {
+		server, err := ProtocolVersion6ProviderServerFactory(context.Background(), "test")
+		if err != nil {
+			return nil, err
+		}
+		return server(), nil
+	},
 }
 
 func TestAccPreCheck(t *testing.T) {
-	// You can add code here to run prior to any test case execution, for example assertions
-	// about the appropriate environment variables being set are common to see in a pre-check
-	// function.
+	if os.Getenv(consts.APITokenEnvVarKey) == "" && os.Getenv(consts.APIKeyEnvVarKey) == "" {
+		t.Fatalf("%s or %s must be set for acceptance tests", consts.APITokenEnvVarKey, consts.APIKeyEnvVarKey)
+	}
+
+	if os.Getenv(consts.APIKeyEnvVarKey) != "" && os.Getenv(consts.EmailEnvVarKey) == "" {
+		t.Fatalf("%s must be set with %s for acceptance tests", consts.EmailEnvVarKey, consts.APIKeyEnvVarKey)
+	}
+}
+
+func TestAccPreCheckAccount(t *testing.T) {
+	TestAccPreCheck(t)
+
+	if os.Getenv(consts.AccountIDEnvVarKey) == "" {
+		t.Fatalf("%s must be set for this acceptance test", consts.AccountIDEnvVarKey)
+	}
+}
+
+func TestAccPreCheckZone(t *testing.T) {
+	TestAccPreCheck(t)
+
+	if os.Getenv("CLOUDFLARE_ZONE_ID") == "" {
+		t.Fatal("CLOUDFLARE_ZONE_ID must be set for this acceptance test")
+	}
+
+	if os.Getenv("CLOUDFLARE_DOMAIN") == "" {
+		t.Fatal("CLOUDFLARE_DOMAIN must be set for this acceptance test")
+	}
 }
@@ -1 +1,110 @@
 package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/providerdata"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/sdkv2provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProtocolVersion6ProviderServerFactory(t *testing.T) {
+	ctx := context.Background()
+
+	server, err := ProtocolVersion6ProviderServerFactory(ctx, "test")
+	assert.NoError(t, err)
+
+	resp, err := server().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	assert.NoError(t, err)
+
+	// The mux server reports differing provider schemas and resource types
+	// served by both providers as diagnostics without a severity.
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+
+	for name := range sdkv2provider.New("test")().ResourcesMap {
+		assert.Contains(t, resp.ResourceSchemas, name)
+	}
+
+	for _, r := range New("test")().Resources(ctx) {
+		metadata := &resource.MetadataResponse{}
+		r().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "cloudflare"}, metadata)
+		assert.Contains(t, resp.ResourceSchemas, metadata.TypeName)
+	}
+}
+
+func TestConfigure(t *testing.T) {
+	testCases := map[string]struct {
+		config map[string]tftypes.Value
+		env    map[string]string
+		err    string
+	}{
+		"valid account id": {
+			config: map[string]tftypes.Value{"account_id": tftypes.NewValue(tftypes.String, "f037e56e89293a057740de681ac9abbe")},
+		},
+		"invalid account id": {
+			config: map[string]tftypes.Value{"account_id": tftypes.NewValue(tftypes.String, "my-account")},
+			err:    `"account_id" is not set correctly`,
+		},
+		"invalid account id from env": {
+			env: map[string]string{"CLOUDFLARE_ACCOUNT_ID": "my-account"},
+			err: `"account_id" is not set correctly`,
+		},
+		"empty user agent suffix uses env": {
+			config: map[string]tftypes.Value{"user_agent_operator_suffix": tftypes.NewValue(tftypes.String, "")},
+			env:    map[string]string{"CLOUDFLARE_USER_AGENT_OPERATOR_SUFFIX": "bad\nsuffix"},
+			err:    `"user_agent_operator_suffix" is not set correctly`,
+		},
+		"prevent destroy override setting over env": {
+			config: map[string]tftypes.Value{"prevent_destroy_override": tftypes.NewValue(tftypes.Bool, false)},
+			env:    map[string]string{"CLOUDFLARE_PREVENT_DESTROY_OVERRIDE": "true"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			t.Setenv("CLOUDFLARE_ACCOUNT_ID", "")
+			t.Setenv("CLOUDFLARE_USER_AGENT_OPERATOR_SUFFIX", "")
+			t.Setenv("CLOUDFLARE_PREVENT_DESTROY_OVERRIDE", "")
+			for k, v := range tc.env {
+				t.Setenv(k, v)
+			}
+
+			p := New("test")()
+			schemaResp := &provider.SchemaResponse{}
+			p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for attr, typ := range objectType.AttributeTypes {
+				values[attr] = tftypes.NewValue(typ, nil)
+			}
+			values["api_token"] = tftypes.NewValue(tftypes.String, "token")
+			for attr, v := range tc.config {
+				values[attr] = v
+			}
+
+			resp := &provider.ConfigureResponse{}
+			p.Configure(ctx, provider.ConfigureRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)},
+			}, resp)
+
+			if tc.err != "" {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tc.err, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+
+			assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.False(t, resp.ResourceData.(*providerdata.ProviderData).PreventDestroyOverride)
+		})
+	}
+}
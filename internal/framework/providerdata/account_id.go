@@ -0,0 +1,56 @@
+package providerdata
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var accountIDFallbackOnce sync.Once
+
+// PlanAccountID fills in the account_id planned for a resource that doesn't
+// configure one with the provider level account_id, the same way the SDKv2
+// provider does for its account level resources. The account_id of the
+// resource must be optional and computed. An error is added when neither the
+// resource nor the provider configure an account_id.
+func PlanAccountID(ctx context.Context, client *cloudflare.API, typeName string, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is planned when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configured, planned types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(consts.AccountIDSchemaKey), &configured)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(consts.AccountIDSchemaKey), &planned)...)
+	if resp.Diagnostics.HasError() || !configured.IsNull() || !planned.IsUnknown() {
+		return
+	}
+
+	// The provider isn't configured yet when its own configuration is
+	// unknown, the account_id is filled in by the next plan.
+	if client == nil {
+		return
+	}
+
+	if client.AccountID == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(consts.AccountIDSchemaKey),
+			"missing account_id",
+			fmt.Sprintf("%s requires an %s, either on the resource or on the provider.", typeName, consts.AccountIDSchemaKey),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(consts.AccountIDSchemaKey), client.AccountID)...)
+
+	accountIDFallbackOnce.Do(func() {
+		tflog.Debug(ctx, fmt.Sprintf("using the deprecated provider level %s for resources that don't configure one, starting with %s", consts.AccountIDSchemaKey, typeName))
+	})
+}
@@ -0,0 +1,85 @@
+package providerdata
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanAccountID(t *testing.T) {
+	ctx := context.Background()
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_id": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"account_id": tftypes.String}}
+	value := func(v interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"account_id": tftypes.NewValue(tftypes.String, v)})
+	}
+
+	testCases := map[string]struct {
+		config    interface{}
+		planned   interface{}
+		client    *cloudflare.API
+		want      types.String
+		wantError bool
+	}{
+		"provider account": {
+			config:  nil,
+			planned: tftypes.UnknownValue,
+			client:  &cloudflare.API{AccountID: "f037e56e89293a057740de681ac9abbe"},
+			want:    types.StringValue("f037e56e89293a057740de681ac9abbe"),
+		},
+		"resource account": {
+			config:  "0da42c8d2132a9ddaf714f9e7c920711",
+			planned: "0da42c8d2132a9ddaf714f9e7c920711",
+			client:  &cloudflare.API{AccountID: "f037e56e89293a057740de681ac9abbe"},
+			want:    types.StringValue("0da42c8d2132a9ddaf714f9e7c920711"),
+		},
+		"prior account": {
+			config:  nil,
+			planned: "0da42c8d2132a9ddaf714f9e7c920711",
+			client:  &cloudflare.API{AccountID: "f037e56e89293a057740de681ac9abbe"},
+			want:    types.StringValue("0da42c8d2132a9ddaf714f9e7c920711"),
+		},
+		"unconfigured provider": {
+			config:  nil,
+			planned: tftypes.UnknownValue,
+			want:    types.StringUnknown(),
+		},
+		"no account": {
+			config:    nil,
+			planned:   tftypes.UnknownValue,
+			client:    &cloudflare.API{},
+			want:      types.StringUnknown(),
+			wantError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: testSchema, Raw: value(tc.planned)}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			PlanAccountID(ctx, tc.client, "cloudflare_example", resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: testSchema, Raw: value(tc.config)},
+				Plan:   plan,
+			}, resp)
+
+			assert.Equal(t, tc.wantError, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var got types.String
+			resp.Plan.GetAttribute(ctx, path.Root("account_id"), &got)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Package providerdata holds the values the plugin framework provider passes
+// to its resources and data sources once it is configured.
+package providerdata
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// ProviderData is the configured state of the plugin framework provider.
+type ProviderData struct {
+	Client *cloudflare.API
+
+	// PreventDestroyOverride is whether prevent_destroy_override is enabled.
+	// Resources must refuse to be deleted when it is, the same way the
+	// SDKv2 provider guards the delete functions of its resources.
+	PreventDestroyOverride bool
+}
+
+// FromConfigure returns the ProviderData passed to the Configure method of a
+// resource or data source. It returns nil when the provider hasn't been
+// configured yet.
+func FromConfigure(v interface{}, diags *diag.Diagnostics) *ProviderData {
+	if v == nil {
+		return nil
+	}
+
+	data, ok := v.(*ProviderData)
+	if !ok {
+		diags.AddError(
+			"unexpected resource configure type",
+			fmt.Sprintf("Expected *providerdata.ProviderData, got: %T. Please report this issue to the provider developers.", v),
+		)
+		return nil
+	}
+
+	return data
+}
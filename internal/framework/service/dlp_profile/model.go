@@ -0,0 +1,28 @@
+package dlp_profile
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// DLPProfileModel describes the data model of a DLP profile.
+type DLPProfileModel struct {
+	AccountID   types.String    `tfsdk:"account_id"`
+	ID          types.String    `tfsdk:"id"`
+	Name        types.String    `tfsdk:"name"`
+	Description types.String    `tfsdk:"description"`
+	Type        types.String    `tfsdk:"type"`
+	Entries     []DLPEntryModel `tfsdk:"entry"`
+}
+
+// DLPEntryModel describes the data model of an entry of a DLP profile.
+type DLPEntryModel struct {
+	ID        types.String      `tfsdk:"id"`
+	Name      types.String      `tfsdk:"name"`
+	Enabled   types.Bool        `tfsdk:"enabled"`
+	Pattern   []DLPPatternModel `tfsdk:"pattern"`
+	DatasetID types.String      `tfsdk:"dataset_id"`
+}
+
+// DLPPatternModel describes the data model of the pattern of a DLP entry.
+type DLPPatternModel struct {
+	Regex      types.String `tfsdk:"regex"`
+	Validation types.String `tfsdk:"validation"`
+}
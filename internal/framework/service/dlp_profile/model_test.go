@@ -0,0 +1,123 @@
+package dlp_profile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildProfileDatasetEntry(t *testing.T) {
+	profile := buildProfile(&DLPProfileModel{
+		Name: types.StringValue("example"),
+		Type: types.StringValue(profileTypeCustom),
+		Entries: []DLPEntryModel{{
+			ID:        types.StringUnknown(),
+			Name:      types.StringValue("customers"),
+			Enabled:   types.BoolValue(true),
+			DatasetID: types.StringValue("0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11"),
+		}},
+	})
+
+	assert.Equal(t, []dlpEntry{{
+		DLPEntry: cloudflare.DLPEntry{
+			Name:    "customers",
+			Enabled: cloudflare.BoolPtr(true),
+			Type:    entryTypeDataset,
+		},
+		DatasetID: "0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11",
+	}}, profile.Entries)
+}
+
+func TestBuildModelRoundTrip(t *testing.T) {
+	data := &DLPProfileModel{
+		AccountID:   types.StringValue("f037e56e89293a057740de681ac9abbe"),
+		ID:          types.StringValue("29678c26-a191-428d-9f63-6e20a4a636a4"),
+		Name:        types.StringValue("example"),
+		Description: types.StringNull(),
+		Type:        types.StringValue(profileTypeCustom),
+		Entries: []DLPEntryModel{
+			{
+				ID:      types.StringValue("a5b2e8e6-0b8a-4d3b-9b1e-0a8f4e7f2c11"),
+				Name:    types.StringValue("cards"),
+				Enabled: types.BoolValue(true),
+				Pattern: []DLPPatternModel{{
+					Regex:      types.StringValue("^4[0-9]"),
+					Validation: types.StringValue("luhn"),
+				}},
+				DatasetID: types.StringNull(),
+			},
+			{
+				ID:        types.StringValue("b6c3f9f7-1c9b-4e4c-8c2f-1b9f5f8f3d22"),
+				Name:      types.StringValue("customers"),
+				Enabled:   types.BoolValue(false),
+				Pattern:   []DLPPatternModel{},
+				DatasetID: types.StringValue("0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11"),
+			},
+		},
+	}
+
+	profile := buildProfile(data)
+	profile.ID = data.ID.ValueString()
+	for i := range profile.Entries {
+		profile.Entries[i].ID = data.Entries[i].ID.ValueString()
+	}
+
+	assert.Equal(t, data, buildModel(data.AccountID.ValueString(), profile, &DLPProfileModel{Description: types.StringNull()}))
+}
+
+func TestValidateEntries(t *testing.T) {
+	pattern := []DLPPatternModel{{Regex: types.StringValue("^4[0-9]")}}
+
+	testCases := map[string]struct {
+		entry DLPEntryModel
+		err   bool
+	}{
+		"pattern entry": {entry: DLPEntryModel{Name: types.StringValue("cards"), Pattern: pattern}},
+		"dataset entry": {entry: DLPEntryModel{Name: types.StringValue("customers"), DatasetID: types.StringValue("0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11")}},
+		"dataset entry with pattern": {
+			entry: DLPEntryModel{Name: types.StringValue("customers"), DatasetID: types.StringValue("0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11"), Pattern: pattern},
+			err:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateEntries([]DLPEntryModel{tc.entry})
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDeletePreventDestroyOverride(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&DLPProfileResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+	attributes["account_id"] = tftypes.NewValue(tftypes.String, "f037e56e89293a057740de681ac9abbe")
+	attributes["id"] = tftypes.NewValue(tftypes.String, "2c0fc9fa-0c44-4b3e-9e0b-5f1d1f1a0c3b")
+	attributes["type"] = tftypes.NewValue(tftypes.String, profileTypeCustom)
+
+	// The resource has no client, deleting anything would panic.
+	r := &DLPProfileResource{preventDestroyOverride: true}
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, `refusing to delete cloudflare_dlp_profile "2c0fc9fa-0c44-4b3e-9e0b-5f1d1f1a0c3b": prevent_destroy_override is enabled in the provider configuration`, resp.Diagnostics[0].Detail())
+}
@@ -0,0 +1,345 @@
+package dlp_profile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/providerdata"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DLPProfileResource{}
+var _ resource.ResourceWithImportState = &DLPProfileResource{}
+var _ resource.ResourceWithValidateConfig = &DLPProfileResource{}
+var _ resource.ResourceWithModifyPlan = &DLPProfileResource{}
+
+func NewResource() resource.Resource {
+	return &DLPProfileResource{}
+}
+
+// DLPProfileResource defines the resource implementation.
+type DLPProfileResource struct {
+	client                 *cloudflare.API
+	preventDestroyOverride bool
+}
+
+// dlpProfile is a DLP profile whose entries may reference a DLP dataset,
+// which cloudflare-go does not model.
+type dlpProfile struct {
+	cloudflare.DLPProfile
+	Entries []dlpEntry `json:"entries,omitempty"`
+}
+
+type dlpEntry struct {
+	cloudflare.DLPEntry
+	DatasetID string `json:"dataset_id,omitempty"`
+}
+
+func (r *DLPProfileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dlp_profile"
+}
+
+func (r *DLPProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := providerdata.FromConfigure(req.ProviderData, &resp.Diagnostics)
+	if data == nil {
+		return
+	}
+
+	r.client = data.Client
+	r.preventDestroyOverride = data.PreventDestroyOverride
+}
+
+// ValidateConfig ensures that entries referencing a DLP dataset don't also
+// carry a pattern.
+func (r *DLPProfileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var entries types.Set
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entry"), &entries)...)
+	if resp.Diagnostics.HasError() || entries.IsNull() || entries.IsUnknown() {
+		return
+	}
+
+	var data []DLPEntryModel
+	if diags := entries.ElementsAs(ctx, &data, false); diags.HasError() {
+		// Entries which aren't fully known yet are validated once they are.
+		return
+	}
+
+	if err := validateEntries(data); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("entry"), "invalid DLP profile entry", err.Error())
+	}
+}
+
+// ModifyPlan fills in the account_id from the provider when the profile
+// doesn't configure one.
+func (r *DLPProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	providerdata.PlanAccountID(ctx, r.client, "cloudflare_dlp_profile", req, resp)
+}
+
+func validateEntries(entries []DLPEntryModel) error {
+	for _, entry := range entries {
+		if entry.DatasetID.ValueString() != "" && len(entry.Pattern) != 0 {
+			return fmt.Errorf("entry %q references dataset %q and must not have a pattern", entry.Name.ValueString(), entry.DatasetID.ValueString())
+		}
+	}
+
+	return nil
+}
+
+func (r *DLPProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *DLPProfileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Type.ValueString() == profileTypePredefined {
+		resp.Diagnostics.AddError("error creating DLP profile", "predefined DLP profiles cannot be created and must be imported")
+		return
+	}
+
+	accountID := data.AccountID.ValueString()
+	newProfile := buildProfile(data)
+
+	res, err := r.client.Raw(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/dlp/profiles/%s", accountID, newProfile.Type), map[string]interface{}{
+		"profiles": []dlpProfile{newProfile},
+	}, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("error creating DLP profile", utils.ErrorDetail(fmt.Errorf("error creating DLP Profile for name %s: %w", newProfile.Name, err)))
+		return
+	}
+
+	var profiles []dlpProfile
+	if err := json.Unmarshal(res, &profiles); err != nil {
+		resp.Diagnostics.AddError("error creating DLP profile", fmt.Sprintf("failed to unmarshal DLP profiles: %s", err))
+		return
+	}
+	if len(profiles) == 0 {
+		resp.Diagnostics.AddError("error creating DLP profile", fmt.Sprintf("error creating DLP Profile for name %s: no profile in response", newProfile.Name))
+		return
+	}
+
+	profile, err := r.readProfile(ctx, accountID, profiles[0].ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading DLP profile", utils.ErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildModel(accountID, profile, data))...)
+}
+
+func (r *DLPProfileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Only the identifiers are read from the prior state as an imported
+	// profile doesn't have anything else yet.
+	var accountID, profileID, description types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(consts.AccountIDSchemaKey), &accountID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &profileID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("description"), &description)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profile, err := r.readProfile(ctx, accountID.ValueString(), profileID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("DLP Profile %s no longer exists", profileID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error reading DLP profile", utils.ErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildModel(accountID.ValueString(), profile, &DLPProfileModel{Description: description}))...)
+}
+
+func (r *DLPProfileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *DLPProfileModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var profileID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &profileID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountID := data.AccountID.ValueString()
+	updatedProfile := buildProfile(data)
+	updatedProfile.ID = profileID.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare DLP Profile from struct: %+v", updatedProfile))
+
+	res, err := r.client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/dlp/profiles/%s/%s", accountID, updatedProfile.Type, updatedProfile.ID), updatedProfile, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("error updating DLP profile", utils.ErrorDetail(fmt.Errorf("error updating DLP profile for ID %q: %w", updatedProfile.ID, err)))
+		return
+	}
+
+	var updated dlpProfile
+	if err := json.Unmarshal(res, &updated); err != nil {
+		resp.Diagnostics.AddError("error updating DLP profile", fmt.Sprintf("failed to unmarshal DLP profile: %s", err))
+		return
+	}
+	if updated.ID == "" {
+		resp.Diagnostics.AddError("error updating DLP profile", "failed to find DLP Profile ID in update response; resource was empty")
+		return
+	}
+
+	profile, err := r.readProfile(ctx, accountID, updated.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading DLP profile", utils.ErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildModel(accountID, profile, data))...)
+}
+
+func (r *DLPProfileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var accountID, profileID, profileType types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(consts.AccountIDSchemaKey), &accountID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &profileID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("type"), &profileType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.preventDestroyOverride {
+		resp.Diagnostics.AddError("error deleting DLP profile", utils.PreventDestroyError("cloudflare_dlp_profile", profileID.ValueString()).Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare DLP Profile using ID: %s", profileID.ValueString()))
+
+	if profileType.ValueString() != profileTypeCustom {
+		resp.Diagnostics.AddError("error deleting DLP profile", "can only delete custom profiles")
+		return
+	}
+
+	if err := r.client.DeleteDLPProfile(ctx, cloudflare.AccountIdentifier(accountID.ValueString()), profileID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting DLP profile", utils.ErrorDetail(fmt.Errorf("error deleting DLP Profile for ID %q: %w", profileID.ValueString(), err)))
+	}
+}
+
+func (r *DLPProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	attributes := strings.Split(req.ID, "/")
+	if len(attributes) != 2 {
+		resp.Diagnostics.AddError(
+			"invalid import identifier",
+			fmt.Sprintf("invalid id (%q) specified, should be in format %q", req.ID, "accountID/dlpProfileID"),
+		)
+		return
+	}
+	accountID, profileID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare DLP Profile: %q, ID %q", accountID, profileID))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.AccountIDSchemaKey), accountID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), profileID)...)
+}
+
+func (r *DLPProfileResource) readProfile(ctx context.Context, accountID, profileID string) (dlpProfile, error) {
+	var profile dlpProfile
+
+	res, err := r.client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/dlp/profiles/%s", accountID, profileID), nil, nil)
+	if err != nil {
+		return profile, fmt.Errorf("error reading DLP profile: %w", err)
+	}
+
+	if err := json.Unmarshal(res, &profile); err != nil {
+		return profile, fmt.Errorf("failed to unmarshal DLP profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+func buildProfile(data *DLPProfileModel) dlpProfile {
+	profile := dlpProfile{
+		DLPProfile: cloudflare.DLPProfile{
+			Name:        data.Name.ValueString(),
+			Type:        data.Type.ValueString(),
+			Description: data.Description.ValueString(),
+		},
+	}
+
+	for _, entry := range data.Entries {
+		apiEntry := dlpEntry{
+			DLPEntry: cloudflare.DLPEntry{
+				ID:      entry.ID.ValueString(),
+				Name:    entry.Name.ValueString(),
+				Enabled: cloudflare.BoolPtr(entry.Enabled.ValueBool()),
+				Type:    profile.Type,
+			},
+		}
+		if len(entry.Pattern) != 0 {
+			apiEntry.Pattern = &cloudflare.DLPPattern{
+				Regex:      entry.Pattern[0].Regex.ValueString(),
+				Validation: entry.Pattern[0].Validation.ValueString(),
+			}
+		}
+		if datasetID := entry.DatasetID.ValueString(); datasetID != "" {
+			apiEntry.DatasetID = datasetID
+			apiEntry.Type = entryTypeDataset
+		}
+		profile.Entries = append(profile.Entries, apiEntry)
+	}
+
+	return profile
+}
+
+// buildModel converts a profile returned by the API into its data model. The
+// API omits empty descriptions so the one from prior is kept in that case.
+func buildModel(accountID string, profile dlpProfile, prior *DLPProfileModel) *DLPProfileModel {
+	data := &DLPProfileModel{
+		AccountID:   types.StringValue(accountID),
+		ID:          types.StringValue(profile.ID),
+		Name:        types.StringValue(profile.Name),
+		Description: prior.Description,
+		Type:        types.StringValue(profile.Type),
+		Entries:     make([]DLPEntryModel, 0, len(profile.Entries)),
+	}
+	if profile.Description != "" {
+		data.Description = types.StringValue(profile.Description)
+	}
+
+	for _, entry := range profile.Entries {
+		entryModel := DLPEntryModel{
+			ID:        types.StringValue(entry.ID),
+			Name:      types.StringValue(entry.Name),
+			Enabled:   types.BoolValue(entry.Enabled != nil && *entry.Enabled),
+			Pattern:   []DLPPatternModel{},
+			DatasetID: types.StringNull(),
+		}
+		if entry.Pattern != nil {
+			pattern := DLPPatternModel{
+				Regex:      types.StringValue(entry.Pattern.Regex),
+				Validation: types.StringNull(),
+			}
+			if entry.Pattern.Validation != "" {
+				pattern.Validation = types.StringValue(entry.Pattern.Validation)
+			}
+			entryModel.Pattern = append(entryModel.Pattern, pattern)
+		}
+		if entry.DatasetID != "" {
+			entryModel.DatasetID = types.StringValue(entry.DatasetID)
+		}
+		data.Entries = append(data.Entries, entryModel)
+	}
+
+	return data
+}
@@ -0,0 +1,223 @@
+package dlp_profile_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareDLPProfile_Custom(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := fmt.Sprintf("cloudflare_dlp_profile.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckAccount(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDLPProfileConfigCustom(accountID, rnd, "custom profile"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "description", "custom profile"),
+					resource.TestCheckResourceAttr(name, "type", "custom"),
+					resource.TestCheckResourceAttr(name, "entry.0.name", fmt.Sprintf("%s_entry1", rnd)),
+					resource.TestCheckResourceAttr(name, "entry.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "entry.0.pattern.0.regex", "^4[0-9]"),
+					resource.TestCheckResourceAttr(name, "entry.0.pattern.0.validation", "luhn"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDLPProfile_Custom_MultipleEntries(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := fmt.Sprintf("cloudflare_dlp_profile.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckAccount(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDLPProfileConfigCustomMultipleEntries(accountID, rnd, "custom profile 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "description", "custom profile 2"),
+					resource.TestCheckResourceAttr(name, "type", "custom"),
+
+					resource.TestCheckTypeSetElemNestedAttrs(name, "entry.*", map[string]string{
+						"name":                 fmt.Sprintf("%s_entry2", rnd),
+						"enabled":              "true",
+						"pattern.0.regex":      "^3[0-9]",
+						"pattern.0.validation": "luhn",
+					}),
+
+					resource.TestCheckTypeSetElemNestedAttrs(name, "entry.*", map[string]string{
+						"name":                 fmt.Sprintf("%s_entry1", rnd),
+						"enabled":              "true",
+						"pattern.0.regex":      "^4[0-9]",
+						"pattern.0.validation": "luhn",
+					}),
+				),
+			},
+			{
+				Config:   testAccCloudflareDLPProfileConfigCustomMultipleEntries(accountID, rnd, "custom profile 2"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDLPProfile_Custom_DatasetEntry(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := fmt.Sprintf("cloudflare_dlp_profile.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	datasetID := os.Getenv("CLOUDFLARE_DLP_DATASET_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckAccount(t)
+
+			if datasetID == "" {
+				t.Skip("Skipping acceptance test as CLOUDFLARE_DLP_DATASET_ID is not set")
+			}
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDLPProfileConfigCustomDatasetEntry(accountID, rnd, datasetID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "custom"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "entry.*", map[string]string{
+						"name":       fmt.Sprintf("%s_dataset", rnd),
+						"enabled":    "true",
+						"dataset_id": datasetID,
+						"pattern.#":  "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDLPProfile_Custom_DatasetEntryWithPattern(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckAccount(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareDLPProfileConfigCustomDatasetEntryWithPattern(accountID, rnd, "0d5d4f0a-0b7a-4a7c-b0c3-ad3b2e1f9a11"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`entry "%s_dataset_pattern" references dataset .* and must not have a pattern`, rnd)),
+			},
+		},
+	})
+}
+
+func testAccCloudflareDLPProfileConfigCustom(accountID, rnd, description string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dlp_profile" "%[1]s" {
+  account_id                = "%[3]s"
+  name                      = "%[1]s"
+  description               = "%[2]s"
+  type                      = "custom"
+  entry {
+	name = "%[1]s_entry1"
+	enabled = true
+	pattern {
+		regex = "^4[0-9]"
+		validation = "luhn"
+	}
+  }
+}
+`, rnd, description, accountID)
+}
+
+func testAccCloudflareDLPProfileConfigCustomMultipleEntries(accountID, rnd, description string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dlp_profile" "%[1]s" {
+  account_id                  = "%[3]s"
+  name                      = "%[1]s"
+  description               = "%[2]s"
+  type                      = "custom"
+  entry {
+	name = "%[1]s_entry1"
+	enabled = true
+	pattern {
+		regex = "^4[0-9]"
+		validation = "luhn"
+	}
+  }
+
+  entry {
+	name = "%[1]s_entry2"
+	enabled = true
+	pattern {
+		regex = "^3[0-9]"
+		validation = "luhn"
+	}
+  }
+}
+`, rnd, description, accountID)
+}
+
+func testAccCloudflareDLPProfileConfigCustomDatasetEntry(accountID, rnd, datasetID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dlp_profile" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "custom profile with dataset entry"
+  type        = "custom"
+
+  entry {
+    name       = "%[1]s_dataset"
+    enabled    = true
+    dataset_id = "%[3]s"
+  }
+}
+`, rnd, accountID, datasetID)
+}
+
+func testAccCloudflareDLPProfileConfigCustomDatasetEntryWithPattern(accountID, rnd, datasetID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_dlp_profile" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  type        = "custom"
+
+  entry {
+    name       = "%[1]s_dataset_pattern"
+    enabled    = true
+    dataset_id = "%[3]s"
+
+    pattern {
+      regex = "^4[0-9]"
+    }
+  }
+}
+`, rnd, accountID, datasetID)
+}
@@ -0,0 +1,123 @@
+package dlp_profile
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/modifiers/defaults"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	profileTypeCustom     = "custom"
+	profileTypePredefined = "predefined"
+
+	entryTypeDataset = "dataset"
+)
+
+func (r *DLPProfileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a Cloudflare DLP Profile resource. Data Loss Prevention profiles are a set of entries that can be matched in HTTP bodies or files. They are referenced in Zero Trust Gateway rules.",
+
+		Attributes: map[string]schema.Attribute{
+			consts.AccountIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: "The account identifier to target for the resource. Defaults to the `account_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Brief summary of the profile and its intended use.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the profile. Available values: `%s`, `%s`", profileTypeCustom, profileTypePredefined),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(profileTypeCustom, profileTypePredefined),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"entry": schema.SetNestedBlock{
+				MarkdownDescription: "List of entries to apply to the profile.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique entry identifier.",
+							Optional:            true,
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the entry to deploy.",
+							Required:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the entry is active. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.Bool{
+								defaults.DefaultBool(false),
+							},
+						},
+						"dataset_id": schema.StringAttribute{
+							MarkdownDescription: "The identifier of the DLP dataset whose exact data the entry matches. Entries referencing a dataset must not have a `pattern`.",
+							Optional:            true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"pattern": schema.ListNestedBlock{
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"regex": schema.StringAttribute{
+										MarkdownDescription: "The regex that defines the pattern.",
+										Required:            true,
+									},
+									"validation": schema.StringAttribute{
+										MarkdownDescription: "The validation algorithm to apply with this pattern.",
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package record
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RecordModel describes the data model of a DNS record.
+type RecordModel struct {
+	ZoneID         types.String   `tfsdk:"zone_id"`
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Hostname       types.String   `tfsdk:"hostname"`
+	Type           types.String   `tfsdk:"type"`
+	Value          types.String   `tfsdk:"value"`
+	Data           types.List     `tfsdk:"data"`
+	TTL            types.Int64    `tfsdk:"ttl"`
+	Priority       types.Int64    `tfsdk:"priority"`
+	Proxied        types.Bool     `tfsdk:"proxied"`
+	CreatedOn      types.String   `tfsdk:"created_on"`
+	Metadata       types.Map      `tfsdk:"metadata"`
+	ModifiedOn     types.String   `tfsdk:"modified_on"`
+	Proxiable      types.Bool     `tfsdk:"proxiable"`
+	AllowOverwrite types.Bool     `tfsdk:"allow_overwrite"`
+	Comment        types.String   `tfsdk:"comment"`
+	Tags           types.Set      `tfsdk:"tags"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
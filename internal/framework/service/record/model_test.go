@@ -0,0 +1,337 @@
+package record
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func testDataList(values map[string]attr.Value) types.List {
+	attrTypes := dataAttributeTypes()
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		attributes[name] = nullValue(attrType)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	return types.ListValueMust(types.ObjectType{AttrTypes: attrTypes}, []attr.Value{types.ObjectValueMust(attrTypes, attributes)})
+}
+
+// testConfig returns a configuration of the resource where every attribute
+// not in values is null.
+func testConfig(t *testing.T, values map[string]tftypes.Value) tfsdk.Config {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&RecordResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		attributes[name] = value
+	}
+
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestDeletePreventDestroyOverride(t *testing.T) {
+	ctx := context.Background()
+
+	config := testConfig(t, map[string]tftypes.Value{
+		"zone_id": tftypes.NewValue(tftypes.String, "0da42c8d2132a9ddaf714f9e7c920711"),
+		"id":      tftypes.NewValue(tftypes.String, "372e67954025e0ba6aaa6d586b9e0b59"),
+	})
+
+	// The resource has no client, deleting anything would panic.
+	r := &RecordResource{preventDestroyOverride: true}
+	resp := &resource.DeleteResponse{}
+	r.Delete(ctx, resource.DeleteRequest{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw}}, resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Equal(t, `refusing to delete cloudflare_record "372e67954025e0ba6aaa6d586b9e0b59": prevent_destroy_override is enabled in the provider configuration`, resp.Diagnostics[0].Detail())
+}
+
+func TestCreateAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+
+	requests := 0
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/zones/0da42c8d2132a9ddaf714f9e7c920711/dns_records", r.URL.Path)
+
+		testclient.WriteErrors(w, http.StatusBadRequest, cloudflare.ResponseInfo{Code: 81057, Message: "Record already exists."})
+	}))
+
+	config := testConfig(t, map[string]tftypes.Value{
+		"zone_id": tftypes.NewValue(tftypes.String, "0da42c8d2132a9ddaf714f9e7c920711"),
+		"name":    tftypes.NewValue(tftypes.String, "www"),
+		"type":    tftypes.NewValue(tftypes.String, "A"),
+		"value":   tftypes.NewValue(tftypes.String, "192.0.2.1"),
+	})
+
+	r := &RecordResource{client: client}
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: config.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: config.Schema, Raw: config.Raw}}, resp)
+
+	if assert.True(t, resp.Diagnostics.HasError()) {
+		detail := resp.Diagnostics[0].Detail()
+		assert.Contains(t, detail, `a DNS record named "www" of type A already exists in zone 0da42c8d2132a9ddaf714f9e7c920711`)
+		assert.Contains(t, detail, "allow_overwrite = true")
+		assert.Contains(t, detail, "terraform import cloudflare_record.<name> 0da42c8d2132a9ddaf714f9e7c920711/<record_id>")
+		assert.Contains(t, detail, "Cloudflare error codes: 81057")
+	}
+	assert.Equal(t, 1, requests, "the conflict should not be retried")
+}
+
+func TestExpandDataRoundTrip(t *testing.T) {
+	data := testDataList(map[string]attr.Value{
+		"priority": types.Int64Value(1),
+		"weight":   types.Int64Value(5),
+		"port":     types.Int64Value(443),
+		"target":   types.StringValue("example.com"),
+	})
+
+	expanded := expandData(data)
+	assert.Equal(t, map[string]interface{}{
+		"priority": int64(1),
+		"weight":   int64(5),
+		"port":     int64(443),
+		"target":   "example.com",
+	}, expanded)
+
+	// The API returns every number as a float64 and adds attributes which
+	// weren't configured.
+	assert.Equal(t, data, flattenData(map[string]interface{}{
+		"priority": float64(1),
+		"weight":   float64(5),
+		"port":     float64(443),
+		"target":   "example.com",
+		"service":  "_sip",
+	}, data))
+}
+
+func TestFlattenDataImport(t *testing.T) {
+	got := flattenData(map[string]interface{}{
+		"flags": float64(0),
+		"tag":   "issue",
+		"value": "letsencrypt.org",
+	}, types.ListNull(types.ObjectType{AttrTypes: dataAttributeTypes()}))
+
+	assert.Equal(t, testDataList(map[string]attr.Value{
+		"flags": types.StringValue("0"),
+		"tag":   types.StringValue("issue"),
+		"value": types.StringValue("letsencrypt.org"),
+	}), got)
+}
+
+func TestFlattenDataKeepsConfiguredCAATag(t *testing.T) {
+	prior := testDataList(map[string]attr.Value{
+		"flags": types.StringValue("0"),
+		"tag":   types.StringValue("ISSUE"),
+		"value": types.StringValue("letsencrypt.org"),
+	})
+
+	assert.Equal(t, prior, flattenData(map[string]interface{}{
+		"flags": float64(0),
+		"tag":   "issue",
+		"value": "letsencrypt.org",
+	}, prior))
+}
+
+func TestBuildModel(t *testing.T) {
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	prior := &RecordModel{
+		ZoneID:         types.StringValue("0da42c8d2132a9ddaf714f9e7c920711"),
+		Name:           types.StringValue("mail"),
+		Value:          types.StringValue("mx1.example.com."),
+		Data:           types.ListValueMust(types.ObjectType{AttrTypes: dataAttributeTypes()}, []attr.Value{}),
+		Priority:       types.Int64Unknown(),
+		AllowOverwrite: types.BoolValue(false),
+		Comment:        types.StringNull(),
+		Tags:           types.SetNull(types.StringType),
+	}
+
+	got := buildModel(cloudflare.DNSRecord{
+		ID:         "372e67954025e0ba6aaa6d586b9e0b59",
+		Type:       "MX",
+		Name:       "mail.example.com",
+		Content:    "mx1.example.com",
+		ZoneName:   "example.com",
+		Priority:   cloudflare.Uint16Ptr(10),
+		TTL:        1,
+		Proxied:    cloudflare.BoolPtr(false),
+		CreatedOn:  created,
+		ModifiedOn: created,
+		Meta:       map[string]interface{}{"auto_added": false},
+	}, prior)
+
+	assert.Equal(t, &RecordModel{
+		ZoneID:         prior.ZoneID,
+		ID:             types.StringValue("372e67954025e0ba6aaa6d586b9e0b59"),
+		Name:           types.StringValue("mail"),
+		Hostname:       types.StringValue("mail.example.com"),
+		Type:           types.StringValue("MX"),
+		Value:          types.StringValue("mx1.example.com."),
+		Data:           prior.Data,
+		TTL:            types.Int64Value(1),
+		Priority:       types.Int64Value(10),
+		Proxied:        types.BoolValue(false),
+		CreatedOn:      types.StringValue(created.Format(time.RFC3339Nano)),
+		Metadata:       types.MapValueMust(types.StringType, map[string]attr.Value{"auto_added": types.StringValue("false")}),
+		ModifiedOn:     types.StringValue(created.Format(time.RFC3339Nano)),
+		Proxiable:      types.BoolValue(false),
+		AllowOverwrite: types.BoolValue(false),
+		Comment:        types.StringNull(),
+		Tags:           types.SetNull(types.StringType),
+	}, got)
+}
+
+func TestBuildModelName(t *testing.T) {
+	record := cloudflare.DNSRecord{Name: "www.example.com", ZoneName: "example.com"}
+
+	for prior, want := range map[string]string{
+		"WWW":              "WWW",
+		"www.Example.com":  "www.Example.com",
+		"www.example.com.": "www.example.com.",
+		"api":              "www",
+	} {
+		got := buildModel(record, &RecordModel{Name: types.StringValue(prior), Priority: types.Int64Null()})
+		assert.Equal(t, types.StringValue(want), got.Name, prior)
+	}
+
+	apex := cloudflare.DNSRecord{Name: "example.com", ZoneName: "example.com"}
+	got := buildModel(apex, &RecordModel{Name: types.StringValue("@"), Priority: types.Int64Null()})
+	assert.Equal(t, types.StringValue("@"), got.Name)
+
+	imported := cloudflare.DNSRecord{Name: "WWW.example.com", ZoneName: "example.com"}
+	got = buildModel(imported, &RecordModel{Name: types.StringNull(), Priority: types.Int64Null()})
+	assert.Equal(t, types.StringValue("www"), got.Name)
+}
+
+func TestBuildModelIgnoresSRVTopLevelPriority(t *testing.T) {
+	got := buildModel(cloudflare.DNSRecord{
+		Type:     "SRV",
+		Name:     "_sip._tcp.example.com",
+		ZoneName: "example.com",
+		Priority: cloudflare.Uint16Ptr(10),
+		Data:     map[string]interface{}{"priority": float64(10)},
+	}, &RecordModel{Priority: types.Int64Null()})
+
+	assert.Equal(t, types.StringValue("_sip._tcp"), got.Name)
+	assert.Equal(t, types.Int64Null(), got.Priority)
+}
+
+func TestEqualIgnoringTrailingDot(t *testing.T) {
+	assert.True(t, equalIgnoringTrailingDot("example.com.", "example.com"))
+	assert.True(t, equalIgnoringTrailingDot("example.com", "example.com."))
+	assert.True(t, equalIgnoringTrailingDot(".", "."))
+	assert.False(t, equalIgnoringTrailingDot(".", ""))
+	assert.False(t, equalIgnoringTrailingDot("example.com", "example.org"))
+}
+
+func TestProxiedTTL(t *testing.T) {
+	testCases := map[string]struct {
+		proxied  tftypes.Value
+		state    types.Int64
+		expected types.Int64
+	}{
+		"proxied":               {proxied: tftypes.NewValue(tftypes.Bool, true), state: types.Int64Value(300), expected: types.Int64Value(1)},
+		"not proxied":           {proxied: tftypes.NewValue(tftypes.Bool, false), state: types.Int64Value(300), expected: types.Int64Value(300)},
+		"not proxied on create": {proxied: tftypes.NewValue(tftypes.Bool, nil), state: types.Int64Null(), expected: types.Int64Unknown()},
+		"proxied not yet known": {proxied: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), state: types.Int64Value(300), expected: types.Int64Unknown()},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &planmodifier.Int64Response{PlanValue: types.Int64Unknown()}
+			proxiedTTL().PlanModifyInt64(context.Background(), planmodifier.Int64Request{
+				Path:        path.Root("ttl"),
+				Config:      testConfig(t, map[string]tftypes.Value{"proxied": tc.proxied}),
+				ConfigValue: types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				StateValue:  tc.state,
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tc.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestRecordPriority(t *testing.T) {
+	testCases := map[string]struct {
+		recordType string
+		state      types.Int64
+		expected   types.Int64
+	}{
+		"MX":           {recordType: "MX", state: types.Int64Value(10), expected: types.Int64Value(10)},
+		"MX on create": {recordType: "MX", state: types.Int64Null(), expected: types.Int64Unknown()},
+		"SRV":          {recordType: "SRV", state: types.Int64Null(), expected: types.Int64Null()},
+		"A":            {recordType: "A", state: types.Int64Value(10), expected: types.Int64Null()},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			resp := &planmodifier.Int64Response{PlanValue: types.Int64Unknown()}
+			recordPriority().PlanModifyInt64(context.Background(), planmodifier.Int64Request{
+				Path:        path.Root("priority"),
+				Config:      testConfig(t, map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, tc.recordType)}),
+				ConfigValue: types.Int64Null(),
+				PlanValue:   types.Int64Unknown(),
+				StateValue:  tc.state,
+			}, resp)
+
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tc.expected, resp.PlanValue)
+		})
+	}
+}
+
+func TestUpgradeRecordStateV1(t *testing.T) {
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	(&RecordResource{}).Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgradeRecordStateV1(ctx, resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"id": "372e67954025e0ba6aaa6d586b9e0b59",
+			"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+			"name": "_sip._tcp",
+			"type": "SRV",
+			"data": {"priority": "10", "weight": "5", "port": "5060", "target": "sip.example.com"},
+			"timeouts": null
+		}`)},
+	}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var data RecordModel
+	assert.False(t, resp.State.Get(ctx, &data).HasError())
+	assert.Equal(t, types.StringValue("372e67954025e0ba6aaa6d586b9e0b59"), data.ID)
+	assert.Equal(t, testDataList(map[string]attr.Value{
+		"priority": types.Int64Value(10),
+		"weight":   types.Int64Value(5),
+		"port":     types.Int64Value(5060),
+		"target":   types.StringValue("sip.example.com"),
+	}), data.Data)
+}
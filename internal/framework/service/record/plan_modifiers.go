@@ -0,0 +1,89 @@
+package record
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Int64 = proxiedTTLModifier{}
+var _ planmodifier.Int64 = recordPriorityModifier{}
+
+// proxiedTTL plans the automatic TTL (1) for proxied records which don't
+// configure a TTL as the API enforces it for them. The TTL of other records
+// which don't configure one is kept from the prior state.
+func proxiedTTL() proxiedTTLModifier {
+	return proxiedTTLModifier{}
+}
+
+type proxiedTTLModifier struct{}
+
+func (m proxiedTTLModifier) Description(ctx context.Context) string {
+	return "If the TTL is not configured, proxied records use the automatic TTL of 1 and other records keep their current TTL."
+}
+
+func (m proxiedTTLModifier) MarkdownDescription(ctx context.Context) string {
+	return "If the TTL is not configured, proxied records use the automatic TTL of `1` and other records keep their current TTL."
+}
+
+func (m proxiedTTLModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var proxied types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("proxied"), &proxied)...)
+	if resp.Diagnostics.HasError() || proxied.IsUnknown() {
+		return
+	}
+
+	if proxied.ValueBool() {
+		resp.PlanValue = types.Int64Value(1)
+		return
+	}
+
+	if !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// recordPriority plans the priority of records which don't configure one.
+// Record types using the top-level priority, such as MX, keep the priority
+// from the prior state since the API assigns one when it's missing. Other
+// record types don't have a top-level priority so it's planned as null.
+func recordPriority() recordPriorityModifier {
+	return recordPriorityModifier{}
+}
+
+type recordPriorityModifier struct{}
+
+func (m recordPriorityModifier) Description(ctx context.Context) string {
+	return "If the priority is not configured, MX and URI records keep their current priority and other records have none."
+}
+
+func (m recordPriorityModifier) MarkdownDescription(ctx context.Context) string {
+	return "If the priority is not configured, `MX` and `URI` records keep their current priority and other records have none."
+}
+
+func (m recordPriorityModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if !req.ConfigValue.IsNull() {
+		return
+	}
+
+	var recordType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &recordType)...)
+	if resp.Diagnostics.HasError() || recordType.IsUnknown() {
+		return
+	}
+
+	if !contains(recordTypesWithPriority, recordType.ValueString()) {
+		resp.PlanValue = types.Int64Null()
+		return
+	}
+
+	if !req.StateValue.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
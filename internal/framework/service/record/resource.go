@@ -0,0 +1,673 @@
+package record
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/providerdata"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecordResource{}
+var _ resource.ResourceWithImportState = &RecordResource{}
+var _ resource.ResourceWithValidateConfig = &RecordResource{}
+var _ resource.ResourceWithUpgradeState = &RecordResource{}
+
+// dnsRecordAlreadyExistsErrorCode is returned by the API when creating a
+// record that conflicts with an existing record of the same name and type.
+const dnsRecordAlreadyExistsErrorCode = 81057
+
+// Default timeouts for creating and updating a record, matching the SDKv2
+// implementation.
+const (
+	defaultCreateTimeout = 30 * time.Second
+	defaultUpdateTimeout = 30 * time.Second
+)
+
+func NewResource() resource.Resource {
+	return &RecordResource{}
+}
+
+// RecordResource defines the resource implementation.
+type RecordResource struct {
+	client                 *cloudflare.API
+	preventDestroyOverride bool
+}
+
+func (r *RecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_record"
+}
+
+func (r *RecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	data := providerdata.FromConfigure(req.ProviderData, &resp.Diagnostics)
+	if data == nil {
+		return
+	}
+
+	r.client = data.Client
+	r.preventDestroyOverride = data.PreventDestroyOverride
+}
+
+// ValidateConfig ensures that exactly one of value and data is configured,
+// that only records of a proxiable type are proxied, that the priority is set
+// where the record type expects it and that proxied records don't configure a
+// TTL other than automatic.
+func (r *RecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RecordModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Value.IsUnknown() && !data.Data.IsUnknown() {
+		hasData := len(data.Data.Elements()) > 0
+		switch {
+		case !data.Value.IsNull() && hasData:
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "invalid DNS record", "only one of `value` or `data` can be configured")
+		case data.Value.IsNull() && !hasData:
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "invalid DNS record", "either `value` or `data` must be configured")
+		}
+	}
+
+	if data.Type.IsUnknown() {
+		return
+	}
+	recordType := data.Type.ValueString()
+
+	if err := validateRecordPriority(recordType, !data.Priority.IsNull(), dataPriorityConfigured(data.Data)); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("priority"), "invalid DNS record priority", err.Error())
+	}
+
+	if !data.Value.IsNull() && !data.Value.IsUnknown() {
+		if err := validateRecordContent(recordType, data.Value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("value"), "invalid DNS record value", err.Error())
+		}
+	}
+
+	if data.Proxied.IsUnknown() {
+		return
+	}
+
+	if err := validateRecordProxiable(recordType, data.Proxied.ValueBool()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("proxied"), "invalid DNS record type", err.Error())
+	}
+
+	if !data.TTL.IsNull() && !data.TTL.IsUnknown() {
+		if err := validateRecordTTL(data.Name.ValueString(), data.TTL.ValueInt64(), data.Proxied.ValueBool()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("ttl"), "invalid DNS record TTL", err.Error())
+		}
+	}
+}
+
+func dataPriorityConfigured(data types.List) bool {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return false
+	}
+
+	element, ok := data.Elements()[0].(types.Object)
+	if !ok {
+		return false
+	}
+
+	priority, ok := element.Attributes()["priority"]
+	return ok && !priority.IsNull()
+}
+
+func (r *RecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *RecordModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	zoneID := data.ZoneID.ValueString()
+	newRecord := buildRecordParams(data)
+
+	tflog.Debug(ctx, fmt.Sprintf("Cloudflare Record create configuration: %#v", newRecord))
+
+	var recordID string
+	res, err := r.client.CreateDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), newRecord)
+	switch {
+	case err != nil && data.AllowOverwrite.ValueBool() && strings.Contains(err.Error(), "already exist"):
+		tflog.Debug(ctx, "Cloudflare Record already exists however we are overwriting it")
+
+		recordID, err = r.findExistingRecord(ctx, newRecord)
+		if err != nil {
+			resp.Diagnostics.AddError("error overwriting DNS record", utils.ErrorDetail(err))
+			return
+		}
+
+		if err := r.client.UpdateDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), updateRecordParams(recordID, newRecord)); err != nil {
+			resp.Diagnostics.AddError("error overwriting DNS record", utils.ErrorDetail(fmt.Errorf("failed to update DNS record %q: %w", recordID, err)))
+			return
+		}
+	case err != nil:
+		// A record with the same name and type existing is not going to
+		// resolve itself so explain how to take over the existing record.
+		err = utils.FriendlyError(err, dnsRecordAlreadyExistsErrorCode, dnsRecordAlreadyExistsMessage(newRecord))
+		resp.Diagnostics.AddError("error creating DNS record", utils.ErrorDetail(fmt.Errorf("failed to create DNS record: %w", err)))
+		return
+	case res.Result.ID == "":
+		resp.Diagnostics.AddError("error creating DNS record", "failed to find record in Create response; Record was empty")
+		return
+	default:
+		recordID = res.Result.ID
+	}
+
+	record, err := r.client.GetDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), recordID)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading DNS record", utils.ErrorDetail(fmt.Errorf("failed to read DNS record %q: %w", recordID, err)))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildModel(record, data))...)
+}
+
+func dnsRecordAlreadyExistsMessage(record cloudflare.CreateDNSRecordParams) string {
+	return fmt.Sprintf(
+		"a DNS record named %q of type %s already exists in zone %s. Set `allow_overwrite = true` to overwrite it or bring it under management with `terraform import cloudflare_record.<name> %s/<record_id>`",
+		record.Name, record.Type, record.ZoneID, record.ZoneID,
+	)
+}
+
+func (r *RecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *RecordModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	record, err := r.client.GetDNSRecord(ctx, cloudflare.ZoneIdentifier(data.ZoneID.ValueString()), data.ID.ValueString())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Warn(ctx, "Removing record from state because it's not found in API")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("error reading DNS record", utils.ErrorDetail(fmt.Errorf("failed to read DNS record %q: %w", data.ID.ValueString(), err)))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildModel(record, data))...)
+}
+
+func (r *RecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *RecordModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var recordID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &recordID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	zoneID := data.ZoneID.ValueString()
+	updateRecord := updateRecordParams(recordID.ValueString(), buildRecordParams(data))
+
+	tflog.Debug(ctx, fmt.Sprintf("Cloudflare Record update configuration: %#v", updateRecord))
+
+	if err := r.client.UpdateDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), updateRecord); err != nil {
+		resp.Diagnostics.AddError("error updating DNS record", utils.ErrorDetail(fmt.Errorf("failed to update DNS record %q: %w", updateRecord.ID, err)))
+		return
+	}
+
+	record, err := r.client.GetDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID), updateRecord.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("error reading DNS record", utils.ErrorDetail(fmt.Errorf("failed to read DNS record %q: %w", updateRecord.ID, err)))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, buildModel(record, data))...)
+}
+
+func (r *RecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var zoneID, recordID types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), &zoneID)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &recordID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if r.preventDestroyOverride {
+		resp.Diagnostics.AddError("error deleting DNS record", utils.PreventDestroyError("cloudflare_record", recordID.ValueString()).Error())
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Record: %s, %s", zoneID.ValueString(), recordID.ValueString()))
+
+	if err := r.client.DeleteDNSRecord(ctx, cloudflare.ZoneIdentifier(zoneID.ValueString()), recordID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("error deleting DNS record", utils.ErrorDetail(fmt.Errorf("error deleting Cloudflare Record: %w", err)))
+	}
+}
+
+func (r *RecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	attributes := strings.SplitN(req.ID, "/", 2)
+	if len(attributes) != 2 {
+		resp.Diagnostics.AddError(
+			"invalid import identifier",
+			fmt.Sprintf("invalid id (%q) specified, should be in format %q", req.ID, "zoneID/recordID"),
+		)
+		return
+	}
+	zoneID, recordID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Record: %q, ID %q", zoneID, recordID))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(consts.ZoneIDSchemaKey), zoneID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), recordID)...)
+}
+
+// UpgradeState upgrades the state of the SDKv2 implementation prior to
+// version 2, which stored data as a map rather than a block.
+func (r *RecordResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		1: {StateUpgrader: upgradeRecordStateV1},
+	}
+}
+
+func upgradeRecordStateV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("failed to unmarshal prior state: %s", err))
+		return
+	}
+
+	data := []interface{}{}
+	if dataMap, ok := rawState["data"].(map[string]interface{}); ok && len(dataMap) > 0 {
+		data = append(data, upgradeRecordDataV1(dataMap))
+	}
+	rawState["data"] = data
+
+	upgraded, err := json.Marshal(rawState)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("failed to marshal upgraded state: %s", err))
+		return
+	}
+
+	// Attributes the SDKv2 implementation kept in state that don't exist in
+	// the current schema are dropped.
+	value, err := tfprotov6.RawState{JSON: upgraded}.UnmarshalWithOpts(
+		resp.State.Schema.Type().TerraformType(ctx),
+		tfprotov6.UnmarshalOpts{ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true}},
+	)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade Resource State", fmt.Sprintf("failed to read upgraded state: %s", err))
+		return
+	}
+
+	resp.State.Raw = value
+}
+
+// upgradeRecordDataV1 converts the data map of version 1, where every value
+// is a string, into the attributes of the data block.
+func upgradeRecordDataV1(dataMap map[string]interface{}) map[string]interface{} {
+	numeric := append(append([]string{}, dataInt64Attributes...), dataFloat64Attributes...)
+
+	upgraded := make(map[string]interface{}, len(dataMap))
+	for name, value := range dataMap {
+		s, ok := value.(string)
+		if !ok || !contains(numeric, name) {
+			upgraded[name] = value
+			continue
+		}
+
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			upgraded[name] = f
+		}
+	}
+
+	return upgraded
+}
+
+// findExistingRecord returns the ID of the record which conflicts with
+// record. Records such as MX can share a name, type and priority so the
+// content is needed to find the one being overwritten.
+func (r *RecordResource) findExistingRecord(ctx context.Context, record cloudflare.CreateDNSRecordParams) (string, error) {
+	zone, err := r.client.ZoneDetails(ctx, record.ZoneID)
+	if err != nil {
+		return "", fmt.Errorf("failed to read zone %q: %w", record.ZoneID, err)
+	}
+
+	name := record.Name
+	switch {
+	case name == "@" || name == zone.Name:
+		name = zone.Name
+	case !strings.HasSuffix(name, "."+zone.Name):
+		name = name + "." + zone.Name
+	}
+
+	records, _, err := r.client.ListDNSRecords(ctx, cloudflare.ZoneIdentifier(record.ZoneID), cloudflare.ListDNSRecordsParams{
+		Name: name,
+		Type: record.Type,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list DNS records named %q: %w", name, err)
+	}
+
+	if len(records) > 1 && record.Content != "" {
+		var matches []cloudflare.DNSRecord
+		for _, existing := range records {
+			if strings.TrimSuffix(existing.Content, ".") == strings.TrimSuffix(record.Content, ".") &&
+				cloudflare.Uint16(existing.Priority) == cloudflare.Uint16(record.Priority) {
+				matches = append(matches, existing)
+			}
+		}
+		records = matches
+	}
+
+	if len(records) != 1 {
+		return "", fmt.Errorf("attempted to override existing record however didn't find an exact match")
+	}
+
+	return records[0].ID, nil
+}
+
+func buildRecordParams(data *RecordModel) cloudflare.CreateDNSRecordParams {
+	record := cloudflare.CreateDNSRecordParams{
+		ZoneID:  data.ZoneID.ValueString(),
+		Type:    data.Type.ValueString(),
+		Name:    data.Name.ValueString(),
+		Content: data.Value.ValueString(),
+		Proxied: cloudflare.BoolPtr(data.Proxied.ValueBool()),
+		Comment: data.Comment.ValueString(),
+	}
+
+	if dataMap := expandData(data.Data); dataMap != nil {
+		record.Data = dataMap
+	}
+
+	// The TTL of proxied records is planned as automatic already.
+	if !data.TTL.IsNull() && !data.TTL.IsUnknown() {
+		record.TTL = int(data.TTL.ValueInt64())
+	}
+
+	if !data.Priority.IsNull() && !data.Priority.IsUnknown() && contains(recordTypesWithPriority, record.Type) {
+		record.Priority = cloudflare.Uint16Ptr(uint16(data.Priority.ValueInt64()))
+	}
+
+	for _, tag := range data.Tags.Elements() {
+		if tag, ok := tag.(types.String); ok {
+			record.Tags = append(record.Tags, tag.ValueString())
+		}
+	}
+
+	return record
+}
+
+func updateRecordParams(recordID string, record cloudflare.CreateDNSRecordParams) cloudflare.UpdateDNSRecordParams {
+	return cloudflare.UpdateDNSRecordParams{
+		ID:       recordID,
+		Type:     record.Type,
+		Name:     record.Name,
+		Content:  record.Content,
+		Data:     record.Data,
+		Priority: record.Priority,
+		TTL:      record.TTL,
+		Proxied:  record.Proxied,
+		Comment:  record.Comment,
+		Tags:     record.Tags,
+	}
+}
+
+// expandData converts the data block into the map sent to the API, leaving
+// out attributes which aren't set.
+func expandData(data types.List) map[string]interface{} {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return nil
+	}
+
+	element, ok := data.Elements()[0].(types.Object)
+	if !ok {
+		return nil
+	}
+
+	dataMap := make(map[string]interface{})
+	for name, value := range element.Attributes() {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		switch v := value.(type) {
+		case types.Int64:
+			dataMap[name] = v.ValueInt64()
+		case types.Float64:
+			dataMap[name] = v.ValueFloat64()
+		case types.String:
+			dataMap[name] = v.ValueString()
+		}
+	}
+
+	return dataMap
+}
+
+// flattenData converts the data of a record returned by the API into the
+// data block. Once the data block is known, attributes left unset in prior
+// stay null rather than picking up values the API adds, attributes the API
+// leaves out keep their prior value and records without a data block keep it
+// empty. Only an imported record has its data block populated from
+// everything the API returns.
+func flattenData(apiData interface{}, prior types.List) types.List {
+	attrTypes := dataAttributeTypes()
+	objectType := types.ObjectType{AttrTypes: attrTypes}
+
+	dataMap, _ := apiData.(map[string]interface{})
+	if len(dataMap) == 0 || (!prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0) {
+		return types.ListValueMust(objectType, []attr.Value{})
+	}
+
+	var priorAttributes map[string]attr.Value
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if element, ok := prior.Elements()[0].(types.Object); ok {
+			priorAttributes = element.Attributes()
+		}
+	}
+
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, attrType := range attrTypes {
+		priorValue, hasPrior := priorAttributes[name]
+		apiValue, inAPI := dataMap[name]
+		switch {
+		case hasPrior && priorValue.IsNull():
+			attributes[name] = nullValue(attrType)
+			continue
+		case hasPrior && !inAPI:
+			// The API doesn't echo every attribute of every record type.
+			attributes[name] = priorValue
+			continue
+		}
+
+		attributes[name] = dataValue(attrType, apiValue)
+
+		// CAA property tags are case insensitive however the API may
+		// return them in a different case to what was configured.
+		if name == "tag" && hasPrior {
+			if tag, ok := attributes[name].(types.String); ok && strings.EqualFold(tag.ValueString(), priorValue.(types.String).ValueString()) {
+				attributes[name] = priorValue
+			}
+		}
+	}
+
+	return types.ListValueMust(objectType, []attr.Value{types.ObjectValueMust(attrTypes, attributes)})
+}
+
+// dataValue converts a value of the data returned by the API, which is a
+// float64 for every number, into a value of attrType.
+func dataValue(attrType attr.Type, value interface{}) attr.Value {
+	switch v := value.(type) {
+	case float64:
+		switch {
+		case attrType.Equal(types.Int64Type):
+			return types.Int64Value(int64(v))
+		case attrType.Equal(types.Float64Type):
+			return types.Float64Value(v)
+		case attrType.Equal(types.StringType):
+			// CAA flags are numbers in the API.
+			return types.StringValue(fmt.Sprintf("%.0f", v))
+		}
+	case string:
+		if attrType.Equal(types.StringType) {
+			return types.StringValue(v)
+		}
+	}
+
+	return nullValue(attrType)
+}
+
+func nullValue(attrType attr.Type) attr.Value {
+	switch {
+	case attrType.Equal(types.Int64Type):
+		return types.Int64Null()
+	case attrType.Equal(types.Float64Type):
+		return types.Float64Null()
+	default:
+		return types.StringNull()
+	}
+}
+
+// buildModel converts a record returned by the API into its data model.
+// Values the API normalises, such as trailing dots of the value, and values
+// the API doesn't echo back when they are empty are kept from prior.
+func buildModel(record cloudflare.DNSRecord, prior *RecordModel) *RecordModel {
+	data := &RecordModel{
+		ZoneID:         prior.ZoneID,
+		ID:             types.StringValue(record.ID),
+		Name:           prior.Name,
+		Hostname:       types.StringValue(record.Name),
+		Type:           types.StringValue(record.Type),
+		Value:          types.StringValue(record.Content),
+		Data:           flattenData(record.Data, prior.Data),
+		TTL:            types.Int64Value(int64(record.TTL)),
+		Priority:       prior.Priority,
+		Proxied:        types.BoolValue(record.Proxied != nil && *record.Proxied),
+		CreatedOn:      types.StringValue(record.CreatedOn.Format(time.RFC3339Nano)),
+		ModifiedOn:     types.StringValue(record.ModifiedOn.Format(time.RFC3339Nano)),
+		Proxiable:      types.BoolValue(record.Proxiable),
+		AllowOverwrite: prior.AllowOverwrite,
+		Comment:        prior.Comment,
+		Tags:           prior.Tags,
+		Timeouts:       prior.Timeouts,
+	}
+
+	// The API lowercases names, so a name that refers to the record is kept
+	// as configured. Imported records and records renamed outside of
+	// Terraform take the lowercase name like the SDKv2 implementation.
+	if !sameRecordName(prior.Name, record) {
+		data.Name = types.StringValue(strings.ToLower(strings.TrimSuffix(record.Name, "."+record.ZoneName)))
+	}
+
+	if !prior.Value.IsNull() && !prior.Value.IsUnknown() && equalIgnoringTrailingDot(prior.Value.ValueString(), record.Content) {
+		data.Value = prior.Value
+	}
+
+	// The API reports the priority of SRV records at the top level as well
+	// as in data, only keep it where the record type expects it. Other record
+	// types ignore a configured priority.
+	switch {
+	case contains(recordTypesWithPriority, record.Type) && record.Priority != nil:
+		data.Priority = types.Int64Value(int64(*record.Priority))
+	case contains(recordTypesWithPriority, record.Type), data.Priority.IsUnknown():
+		data.Priority = types.Int64Null()
+	}
+
+	if data.AllowOverwrite.IsNull() || data.AllowOverwrite.IsUnknown() {
+		data.AllowOverwrite = types.BoolValue(false)
+	}
+
+	metadata := make(map[string]attr.Value)
+	if meta, ok := record.Meta.(map[string]interface{}); ok {
+		for k, v := range meta {
+			metadata[k] = types.StringValue(fmt.Sprintf("%v", v))
+		}
+	}
+	data.Metadata = types.MapValueMust(types.StringType, metadata)
+
+	if record.Comment != "" || !prior.Comment.IsNull() {
+		data.Comment = types.StringValue(record.Comment)
+	}
+
+	if len(record.Tags) > 0 || !prior.Tags.IsNull() {
+		tags := make([]attr.Value, 0, len(record.Tags))
+		for _, tag := range record.Tags {
+			tags = append(tags, types.StringValue(tag))
+		}
+		data.Tags = types.SetValueMust(types.StringType, tags)
+	} else {
+		data.Tags = types.SetNull(types.StringType)
+	}
+
+	return data
+}
+
+// sameRecordName reports whether name refers to the record regardless of
+// its case, either relative to the zone, as "@" for the zone apex or as the
+// FQDN. Without the zone name of the record any known name is assumed to
+// refer to it.
+func sameRecordName(name types.String, record cloudflare.DNSRecord) bool {
+	if name.IsNull() || name.IsUnknown() {
+		return false
+	}
+	if record.ZoneName == "" {
+		return true
+	}
+
+	n := strings.TrimSuffix(name.ValueString(), ".")
+	switch {
+	case n == "@":
+		return strings.EqualFold(record.Name, record.ZoneName)
+	case strings.EqualFold(n, record.Name):
+		return true
+	default:
+		return strings.EqualFold(n+"."+record.ZoneName, record.Name)
+	}
+}
+
+// equalIgnoringTrailingDot reports whether the record values a and b only
+// differ by a trailing dot. Values consisting of dots only must be equal.
+func equalIgnoringTrailingDot(a, b string) bool {
+	trimmed := strings.TrimSuffix(a, ".")
+	if trimmed == "" {
+		return a == b
+	}
+
+	return trimmed == strings.TrimSuffix(b, ".")
+}
@@ -0,0 +1,258 @@
+package record_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/provider"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareRecord_Basic(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := fmt.Sprintf("cloudflare_record.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckZone(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordConfigTTL(zoneID, rnd, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "hostname", fmt.Sprintf("%s.%s", rnd, domain)),
+					resource.TestCheckResourceAttr(name, "type", "A"),
+					resource.TestCheckResourceAttr(name, "value", "192.0.2.1"),
+					resource.TestCheckResourceAttr(name, "ttl", "3600"),
+					resource.TestCheckResourceAttr(name, "proxied", "false"),
+					resource.TestCheckNoResourceAttr(name, "priority"),
+					resource.TestCheckResourceAttr(name, "data.#", "0"),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_ProxiedCoercesTTL(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := fmt.Sprintf("cloudflare_record.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckZone(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordConfigTTL(zoneID, rnd, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "proxied", "false"),
+					resource.TestCheckResourceAttr(name, "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccCloudflareRecordConfigProxied(zoneID, rnd, "A", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "proxied", "true"),
+					resource.TestCheckResourceAttr(name, "proxiable", "true"),
+					resource.TestCheckResourceAttr(name, "ttl", "1"),
+				),
+			},
+			{
+				Config:   testAccCloudflareRecordConfigProxied(zoneID, rnd, "A", "192.0.2.1"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_ProxiedTTL(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckZone(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareRecordConfigProxiedTTL(zoneID, rnd, 3600),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(fmt.Sprintf("error validating record %s: ttl must be set to 1 when `proxied` is\\s+true", rnd)),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_ProxiedNonProxiableType(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckZone(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareRecordConfigProxied(zoneID, rnd, "TXT", "v=spf1 -all"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(`type "TXT" cannot be proxied`)),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_MX(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	name := fmt.Sprintf("cloudflare_record.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckZone(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareRecordConfigMX(zoneID, rnd, domain, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "MX"),
+					resource.TestCheckResourceAttr(name, "value", "mx1.example.com"),
+					resource.TestCheckResourceAttr(name, "priority", "10"),
+					resource.TestCheckResourceAttr(name+"_2", "value", "mx2.example.com"),
+					resource.TestCheckResourceAttr(name+"_2", "priority", "10"),
+				),
+			},
+			{
+				Config:   testAccCloudflareRecordConfigMX(zoneID, rnd, domain, 10),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCloudflareRecordConfigMX(zoneID, rnd, domain, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "priority", "0"),
+					resource.TestCheckResourceAttr(name+"_2", "priority", "0"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+				// The name is configured as a FQDN, imports use the name
+				// relative to the zone.
+				ImportStateVerifyIgnore: []string{"name"},
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_MXDataPriority(t *testing.T) {
+	rnd := acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			provider.TestAccPreCheckZone(t)
+		},
+		ProtoV6ProviderFactories: provider.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareRecordConfigMXDataPriority(zoneID, rnd),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("MX records must set the top-level `priority` instead of `data.priority`")),
+			},
+		},
+	})
+}
+
+func testAccCloudflareRecordConfigTTL(zoneID, rnd string, ttl int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id = "%[1]s"
+  name    = "%[2]s"
+  value   = "192.0.2.1"
+  type    = "A"
+  ttl     = %[3]d
+}
+`, zoneID, rnd, ttl)
+}
+
+func testAccCloudflareRecordConfigProxied(zoneID, rnd, recordType, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id = "%[1]s"
+  name    = "%[2]s"
+  value   = "%[4]s"
+  type    = "%[3]s"
+  proxied = true
+}
+`, zoneID, rnd, recordType, value)
+}
+
+func testAccCloudflareRecordConfigProxiedTTL(zoneID, rnd string, ttl int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id = "%[1]s"
+  name    = "%[2]s"
+  value   = "192.0.2.1"
+  type    = "A"
+  proxied = true
+  ttl     = %[3]d
+}
+`, zoneID, rnd, ttl)
+}
+
+func testAccCloudflareRecordConfigMX(zoneID, rnd, domain string, priority int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id  = "%[1]s"
+  name     = "%[2]s.%[3]s"
+  value    = "mx1.example.com"
+  type     = "MX"
+  priority = %[4]d
+}
+
+resource "cloudflare_record" "%[2]s_2" {
+  zone_id  = "%[1]s"
+  name     = "%[2]s.%[3]s"
+  value    = "mx2.example.com"
+  type     = "MX"
+  priority = %[4]d
+}
+`, zoneID, rnd, domain, priority)
+}
+
+func testAccCloudflareRecordConfigMXDataPriority(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id = "%[1]s"
+  name    = "%[2]s"
+  type    = "MX"
+
+  data {
+    priority = 10
+    target   = "mx1.example.com"
+  }
+}
+`, zoneID, rnd)
+}
@@ -0,0 +1,241 @@
+package record
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/modifiers/defaults"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var recordTypes = []string{"A", "AAAA", "CAA", "CNAME", "TXT", "SRV", "LOC", "MX", "NS", "SPF", "CERT", "DNSKEY", "DS", "NAPTR", "SMIMEA", "SSHFP", "TLSA", "URI", "PTR", "HTTPS"}
+
+var caaTags = []string{"issue", "issuewild", "iodef"}
+
+// The attributes of the data block grouped by type. Their values are sent to
+// and read from the API as they are, keyed by attribute name.
+var (
+	dataInt64Attributes = []string{
+		"algorithm", "key_tag", "type", "usage", "selector", "matching_type", "weight",
+		"priority", "port",
+		"long_degrees", "lat_degrees", "long_minutes", "lat_minutes",
+		"protocol", "digest_type", "order", "preference",
+	}
+	dataFloat64Attributes = []string{
+		"size", "altitude", "precision_horz", "precision_vert", "long_seconds", "lat_seconds",
+	}
+	dataStringAttributes = []string{
+		"flags", "service", "certificate", "proto", "name", "target",
+		"long_direction", "lat_direction", "public_key", "digest",
+		"regex", "replacement", "fingerprint", "content", "tag", "value",
+	}
+)
+
+func (r *RecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a Cloudflare record resource.",
+		// Version 2 is the schema version of the SDKv2 implementation this
+		// resource replaces, so its state is read without an upgrade.
+		Version: 2,
+
+		Attributes: map[string]schema.Attribute{
+			consts.ZoneIDSchemaKey: schema.StringAttribute{
+				MarkdownDescription: "The zone identifier to target for the resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of this resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the record.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = !strings.EqualFold(req.StateValue.ValueString(), req.PlanValue.ValueString())
+						},
+						"Changing the name other than by its case requires replacement.",
+						"Changing the name other than by its case requires replacement.",
+					),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The FQDN of the record.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The type of the record. Available values: %s", markdownValues(recordTypes)),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(recordTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the record. Conflicts with `data`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "The TTL of the record. Proxied records always use an automatic TTL of `1`, which is also planned when `ttl` is not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					proxiedTTL(),
+				},
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "The priority of the record. Only used by MX and URI records, SRV records set `data.priority` instead.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					recordPriority(),
+				},
+			},
+			"proxied": schema.BoolAttribute{
+				MarkdownDescription: "Whether the record gets Cloudflare's origin protection. Only `A`, `AAAA` and `CNAME` records can be proxied.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					defaults.DefaultBool(false),
+				},
+			},
+			"created_on": schema.StringAttribute{
+				MarkdownDescription: "The RFC3339 timestamp of when the record was created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"metadata": schema.MapAttribute{
+				MarkdownDescription: "A key-value map of string metadata Cloudflare associates with the record.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"modified_on": schema.StringAttribute{
+				MarkdownDescription: "The RFC3339 timestamp of when the record was last modified.",
+				Computed:            true,
+			},
+			"proxiable": schema.BoolAttribute{
+				MarkdownDescription: "Shows whether this record can be proxied.",
+				Computed:            true,
+			},
+			"allow_overwrite": schema.BoolAttribute{
+				MarkdownDescription: "Allow creation of this record in Terraform to overwrite an existing record, if any. This does not affect the ability to update the record in Terraform and does not prevent other resources within Terraform or manual changes outside Terraform from overwriting this record. **This configuration is not recommended for most environments**",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					defaults.DefaultBool(false),
+				},
+			},
+			"comment": schema.StringAttribute{
+				MarkdownDescription: "Comments or notes about the DNS record. This field has no effect on DNS responses.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Custom tags for the DNS record.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"data": schema.ListNestedBlock{
+				MarkdownDescription: "Map of attributes that constitute the record value. Conflicts with `value`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: dataAttributes(),
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func dataAttributes() map[string]schema.Attribute {
+	attributes := make(map[string]schema.Attribute)
+
+	for _, name := range dataInt64Attributes {
+		attributes[name] = schema.Int64Attribute{Optional: true}
+	}
+	for _, name := range dataFloat64Attributes {
+		attributes[name] = schema.Float64Attribute{Optional: true}
+	}
+	for _, name := range dataStringAttributes {
+		attributes[name] = schema.StringAttribute{Optional: true}
+	}
+
+	attributes["flags"] = schema.StringAttribute{
+		MarkdownDescription: "Flags for the record. For `CAA` records, `0` or `128` (issuer critical).",
+		Optional:            true,
+	}
+	attributes["tag"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("The property tag of a `CAA` record. Available values: %s", markdownValues(caaTags)),
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOfCaseInsensitive(caaTags...),
+		},
+	}
+	attributes["value"] = schema.StringAttribute{
+		MarkdownDescription: "The value of the property tag for `CAA` and `HTTPS` records.",
+		Optional:            true,
+	}
+
+	return attributes
+}
+
+// dataAttributeTypes returns the attribute types of the data block object.
+func dataAttributeTypes() map[string]attr.Type {
+	attrTypes := make(map[string]attr.Type)
+
+	for _, name := range dataInt64Attributes {
+		attrTypes[name] = types.Int64Type
+	}
+	for _, name := range dataFloat64Attributes {
+		attrTypes[name] = types.Float64Type
+	}
+	for _, name := range dataStringAttributes {
+		attrTypes[name] = types.StringType
+	}
+
+	return attrTypes
+}
+
+func markdownValues(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, v := range values {
+		quoted = append(quoted, fmt.Sprintf("`%s`", v))
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,82 @@
+package record
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// proxiableRecordTypes are the DNS record types Cloudflare can proxy.
+var proxiableRecordTypes = []string{"A", "AAAA", "CNAME"}
+
+// recordTypesWithPriority are the DNS record types which set their priority
+// with the top-level `priority` attribute. SRV records set it in `data`.
+var recordTypesWithPriority = []string{"MX", "URI"}
+
+// validateRecordProxiable ensures that a record is only proxied when its
+// type can be proxied.
+func validateRecordProxiable(t string, proxied bool) error {
+	if proxied && !contains(proxiableRecordTypes, t) {
+		return fmt.Errorf("type %q cannot be proxied, only %s records can be proxied. Remove `proxied` or set it to false", t, strings.Join(proxiableRecordTypes, ", "))
+	}
+
+	return nil
+}
+
+// validateRecordPriority ensures that the priority of a record is configured
+// where the API expects it for the record type.
+func validateRecordPriority(t string, priorityConfigured, dataPriorityConfigured bool) error {
+	switch {
+	case contains(recordTypesWithPriority, t) && dataPriorityConfigured:
+		return fmt.Errorf("%s records must set the top-level `priority` instead of `data.priority`", t)
+	case t == "SRV" && priorityConfigured:
+		return fmt.Errorf("SRV records must set `data.priority` instead of the top-level `priority`")
+	}
+
+	return nil
+}
+
+// validateRecordTTL ensures that a proxied record doesn't configure a TTL
+// other than automatic (1) as Cloudflare ignores it for proxied records.
+func validateRecordTTL(name string, ttl int64, proxied bool) error {
+	if proxied && ttl != 1 {
+		return fmt.Errorf("error validating record %s: ttl must be set to 1 when `proxied` is true", name)
+	}
+
+	return nil
+}
+
+// validateRecordContent ensures that the record's content is valid for the
+// supplied record type. Currently only validates A, AAAA and TXT types.
+func validateRecordContent(t string, value string) error {
+	switch t {
+	case "A":
+		addr := net.ParseIP(value)
+		if addr == nil || !strings.Contains(value, ".") {
+			return fmt.Errorf("A record must be a valid IPv4 address, got: %q", value)
+		}
+	case "AAAA":
+		addr := net.ParseIP(value)
+		if addr == nil || !strings.Contains(value, ":") {
+			return fmt.Errorf("AAAA record must be a valid IPv6 address, got: %q", value)
+		}
+	case "TXT":
+		for i := 0; i < len(value); i++ {
+			char := value[i]
+			if (char < 0x20) || (0x7F < char) {
+				return fmt.Errorf("TXT record must contain printable ASCII, found: %q", char)
+			}
+		}
+	}
+
+	return nil
+}
+
+func contains(slice []string, item string) bool {
+	for _, s := range slice {
+		if s == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package record
+
+import "testing"
+
+func TestValidateRecordProxiable(t *testing.T) {
+	testCases := map[string]struct {
+		recordType string
+		proxied    bool
+		err        bool
+	}{
+		"proxied A":          {recordType: "A", proxied: true},
+		"proxied AAAA":       {recordType: "AAAA", proxied: true},
+		"proxied CNAME":      {recordType: "CNAME", proxied: true},
+		"unproxied TXT":      {recordType: "TXT"},
+		"proxied TXT":        {recordType: "TXT", proxied: true, err: true},
+		"proxied MX":         {recordType: "MX", proxied: true, err: true},
+		"proxied HTTPS":      {recordType: "HTTPS", proxied: true, err: true},
+		"unproxied new type": {recordType: "SVCB"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRecordProxiable(tc.recordType, tc.proxied)
+			if tc.err && err == nil {
+				t.Fatalf("%s should not be proxiable", tc.recordType)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("%s should be allowed: %s", tc.recordType, err)
+			}
+		})
+	}
+}
+
+func TestValidateRecordPriority(t *testing.T) {
+	testCases := map[string]struct {
+		recordType             string
+		priorityConfigured     bool
+		dataPriorityConfigured bool
+		err                    bool
+	}{
+		"MX with priority":       {recordType: "MX", priorityConfigured: true},
+		"MX with data priority":  {recordType: "MX", dataPriorityConfigured: true, err: true},
+		"URI with priority":      {recordType: "URI", priorityConfigured: true},
+		"URI with data priority": {recordType: "URI", dataPriorityConfigured: true, err: true},
+		"SRV with data priority": {recordType: "SRV", dataPriorityConfigured: true},
+		"SRV with priority":      {recordType: "SRV", priorityConfigured: true, dataPriorityConfigured: true, err: true},
+		"A without priority":     {recordType: "A"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRecordPriority(tc.recordType, tc.priorityConfigured, tc.dataPriorityConfigured)
+			if tc.err && err == nil {
+				t.Fatalf("%s priority placement should be rejected", tc.recordType)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("%s priority placement should be allowed: %s", tc.recordType, err)
+			}
+		})
+	}
+}
+
+func TestValidateRecordTTL(t *testing.T) {
+	testCases := map[string]struct {
+		ttl     int64
+		proxied bool
+		err     bool
+	}{
+		"unproxied with ttl":      {ttl: 3600},
+		"unproxied automatic ttl": {ttl: 1},
+		"proxied automatic ttl":   {ttl: 1, proxied: true},
+		"proxied with ttl":        {ttl: 3600, proxied: true, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateRecordTTL("example", tc.ttl, tc.proxied)
+			if tc.err && err == nil {
+				t.Fatalf("ttl %d should be rejected", tc.ttl)
+			}
+			if !tc.err && err != nil {
+				t.Fatalf("ttl %d should be allowed: %s", tc.ttl, err)
+			}
+		})
+	}
+}
+
+func TestValidateRecordName(t *testing.T) {
+	validNames := map[string]string{
+		"A":    "192.168.0.1",
+		"AAAA": "2001:0db8:0000:0042:0000:8a2e:0370:7334",
+		"TXT":  " ",
+	}
+
+	for k, v := range validNames {
+		if err := validateRecordContent(k, v); err != nil {
+			t.Fatalf("%q should be valid content for type %q: %v", v, k, err)
+		}
+	}
+
+	invalidNames := map[string]string{
+		"A":    "terraform.io",
+		"AAAA": "192.168.0.1",
+		"TXT":  "\n",
+	}
+	for k, v := range invalidNames {
+		if err := validateRecordContent(k, v); err == nil {
+			t.Fatalf("%q should be invalid content for type %q", v, k)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accountIDFallback fills in the account_id of resources that don't configure
+// one with the deprecated provider level account_id. This keeps configurations
+// relying on the provider level account_id working while they are migrated to
+// resource specific account_id attributes, logging once at debug level rather
+// than warning for every resource.
+type accountIDFallback struct {
+	once sync.Once
+}
+
+// wrap makes the optional account_id of r computed and replaces its CRUD
+// functions with ones that fill in a missing account_id first. Resources that
+// can also be scoped to a zone are left alone since an empty account_id is
+// meaningful to them.
+func (f *accountIDFallback) wrap(name string, r *schema.Resource) {
+	s, ok := r.Schema[consts.AccountIDSchemaKey]
+	if !ok || !s.Optional || s.Default != nil || len(s.ConflictsWith) > 0 || len(s.ExactlyOneOf) > 0 || len(s.AtLeastOneOf) > 0 {
+		return
+	}
+	if _, ok := r.Schema[consts.ZoneIDSchemaKey]; ok {
+		return
+	}
+
+	s.Computed = true
+
+	if r.CreateContext != nil {
+		r.CreateContext = f.fallbackContext(name, r.CreateContext)
+	}
+	if r.ReadContext != nil {
+		r.ReadContext = f.fallbackContext(name, r.ReadContext)
+	}
+	if r.UpdateContext != nil {
+		r.UpdateContext = f.fallbackContext(name, r.UpdateContext)
+	}
+	if r.DeleteContext != nil {
+		r.DeleteContext = f.fallbackContext(name, r.DeleteContext)
+	}
+}
+
+func (f *accountIDFallback) fallbackContext(name string, fn func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if d.Get(consts.AccountIDSchemaKey).(string) != "" {
+			return fn(ctx, d, meta)
+		}
+
+		if client, ok := meta.(*cloudflare.API); ok && client.AccountID != "" {
+			d.Set(consts.AccountIDSchemaKey, client.AccountID)
+
+			f.once.Do(func() {
+				tflog.Debug(ctx, fmt.Sprintf("using the deprecated provider level %s for resources that don't configure one, starting with %s", consts.AccountIDSchemaKey, name))
+			})
+		}
+
+		return fn(ctx, d, meta)
+	}
+}
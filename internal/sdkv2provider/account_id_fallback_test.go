@@ -0,0 +1,74 @@
+package sdkv2provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountIDFallback(t *testing.T) {
+	testCases := map[string]struct {
+		config          map[string]interface{}
+		clientAccountID string
+		zoneScoped      bool
+		expected        string
+	}{
+		"provider account id fills in missing account id": {
+			config:          map[string]interface{}{},
+			clientAccountID: "f037e56e89293a057740de681ac9abbe",
+			expected:        "f037e56e89293a057740de681ac9abbe",
+		},
+		"resource account id takes precedence": {
+			config:          map[string]interface{}{"account_id": "01a7362d577a6c3019a474fd6f485823"},
+			clientAccountID: "f037e56e89293a057740de681ac9abbe",
+			expected:        "01a7362d577a6c3019a474fd6f485823",
+		},
+		"no provider account id": {
+			config:   map[string]interface{}{},
+			expected: "",
+		},
+		"zone scoped resources are left alone": {
+			config:          map[string]interface{}{},
+			clientAccountID: "f037e56e89293a057740de681ac9abbe",
+			zoneScoped:      true,
+			expected:        "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"account_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				CreateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					got = d.Get("account_id").(string)
+					return nil
+				},
+			}
+			if tc.zoneScoped {
+				r.Schema["zone_id"] = &schema.Schema{Type: schema.TypeString, Optional: true}
+			}
+
+			fallback := &accountIDFallback{}
+			fallback.wrap("cloudflare_example", r)
+			assert.Equal(t, !tc.zoneScoped, r.Schema["account_id"].Computed)
+
+			client, err := cloudflare.NewWithAPIToken("token", cloudflare.UsingAccount(tc.clientAccountID))
+			assert.NoError(t, err)
+
+			d := schema.TestResourceDataRaw(t, r.Schema, tc.config)
+			diags := r.CreateContext(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -39,3 +39,12 @@ func (c *Config) Client(ctx context.Context) (*cloudflare.API, error) {
 	tflog.Info(ctx, fmt.Sprintf("cloudflare Client configured for user: %s", c.Email))
 	return client, nil
 }
+
+// quietClient returns a copy of client with the request and response dumps of
+// debug mode disabled. Use it for calls whose request or response contains a
+// secret so that the secret never ends up in the debug logs.
+func quietClient(client *cloudflare.API) *cloudflare.API {
+	quiet := *client
+	quiet.Debug = false
+	return &quiet
+}
@@ -0,0 +1,62 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contentDiffSuppression gates the diff suppression of large content
+// attributes, such as Worker scripts, so that it can be turned off for a
+// provider instance with exact_content_diffs.
+type contentDiffSuppression struct {
+	exact bool
+}
+
+// wrap replaces the DiffSuppressFunc of s with one that reports every
+// difference once exact content diffs have been requested.
+func (c *contentDiffSuppression) wrap(s *schema.Schema) {
+	suppress := s.DiffSuppressFunc
+	if suppress == nil {
+		return
+	}
+
+	s.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		if c.exact {
+			return false
+		}
+		return suppress(k, old, new, d)
+	}
+}
+
+// exactContentDiffsEnabled returns whether exact_content_diffs is enabled in
+// the provider configuration or the environment. A value in the
+// configuration, including false, takes precedence over the environment.
+func exactContentDiffsEnabled(d *schema.ResourceData) (bool, error) {
+	if v, ok := d.GetOkExists(consts.ExactContentDiffsSchemaKey); ok {
+		return v.(bool), nil
+	}
+
+	v := utils.GetDefaultFromEnv(consts.ExactContentDiffsEnvVarKey, "")
+	if v == "" {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %s: %w", v, consts.ExactContentDiffsEnvVarKey, err)
+	}
+
+	return enabled, nil
+}
+
+// suppressTrailingWhitespaceDiff ignores differences in the whitespace at the
+// end of content, such as a missing final newline.
+func suppressTrailingWhitespaceDiff(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimRightFunc(old, unicode.IsSpace) == strings.TrimRightFunc(new, unicode.IsSpace)
+}
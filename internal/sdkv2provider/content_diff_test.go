@@ -0,0 +1,74 @@
+package sdkv2provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentDiffSuppression(t *testing.T) {
+	testCases := map[string]struct {
+		exact    bool
+		resource string
+		key      string
+		old      string
+		new      string
+		expected bool
+	}{
+		"script trailing newline":        {resource: "cloudflare_worker_script", key: "content", old: "export default {}\n", new: "export default {}", expected: true},
+		"script trailing whitespace":     {resource: "cloudflare_worker_script", key: "content", old: "export default {}  \n\n", new: "export default {}\n", expected: true},
+		"script leading whitespace":      {resource: "cloudflare_worker_script", key: "content", old: "export default {}", new: "  export default {}", expected: false},
+		"script changed":                 {resource: "cloudflare_worker_script", key: "content", old: "export default {}", new: "export default { fetch() {} }", expected: false},
+		"script exact":                   {exact: true, resource: "cloudflare_worker_script", key: "content", old: "export default {}\n", new: "export default {}", expected: false},
+		"kv JSON formatting":             {resource: "cloudflare_workers_kv", key: "value", old: `{"a":1,"b":[1,2]}`, new: "{\n  \"b\": [1, 2],\n  \"a\": 1\n}", expected: true},
+		"kv JSON changed":                {resource: "cloudflare_workers_kv", key: "value", old: `{"a":1}`, new: `{"a":2}`, expected: false},
+		"kv plain text":                  {resource: "cloudflare_workers_kv", key: "value", old: "value ", new: "value", expected: false},
+		"kv JSON formatting exact":       {exact: true, resource: "cloudflare_workers_kv", key: "value", old: `{"a":1}`, new: `{ "a": 1 }`, expected: false},
+		"kv JSON formatting on creation": {resource: "cloudflare_workers_kv", key: "value", old: "", new: `{"a":1}`, expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			p := New("dev")()
+			s := p.ResourcesMap[tc.resource].Schema[tc.key]
+
+			contentDiffs := &contentDiffSuppression{exact: tc.exact}
+			contentDiffs.wrap(s)
+
+			assert.Equal(t, tc.expected, s.DiffSuppressFunc(tc.key, tc.old, tc.new, nil))
+		})
+	}
+}
+
+func TestExactContentDiffsEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		config   map[string]interface{}
+		env      string
+		expected bool
+		err      bool
+	}{
+		"unset":            {config: map[string]interface{}{}, expected: false},
+		"provider setting": {config: map[string]interface{}{"exact_content_diffs": true}, expected: true},
+		"environment":      {config: map[string]interface{}{}, env: "true", expected: true},
+		"setting over env": {config: map[string]interface{}{"exact_content_diffs": false}, env: "true", expected: false},
+		"invalid env":      {config: map[string]interface{}{}, env: "always", err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("CLOUDFLARE_EXACT_CONTENT_DIFFS", tc.env)
+
+			d := schema.TestResourceDataRaw(t, New("dev")().Schema, tc.config)
+
+			enabled, err := exactContentDiffsEnabled(d)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, enabled)
+		})
+	}
+}
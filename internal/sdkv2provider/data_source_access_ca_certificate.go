@@ -0,0 +1,50 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessCACertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccessCACertificateSchema(),
+		ReadContext: dataSourceCloudflareAccessCACertificateRead,
+		Description: heredoc.Doc(`
+			Use this data source to lookup the short-lived certificate
+			authority of an Access Application. The public key can be
+			distributed to servers to trust the SSH certificates issued to
+			users based on their Access login.
+		`),
+	}
+}
+
+func dataSourceCloudflareAccessCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	applicationID := d.Get("application_id").(string)
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var accessCACert cloudflare.AccessCACertificate
+	if identifier.Type == AccountType {
+		accessCACert, err = client.AccessCACertificate(ctx, identifier.Value, applicationID)
+	} else {
+		accessCACert, err = client.ZoneLevelAccessCACertificate(ctx, identifier.Value, applicationID)
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Access CA Certificate for application %q: %w", applicationID, err))
+	}
+
+	d.SetId(accessCACert.ID)
+	d.Set("aud", accessCACert.Aud)
+	d.Set("public_key", accessCACert.PublicKey)
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAccessCACertificateDataSource_PreventZoneIdAndAccountIdConflicts(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareAccessCACertificateDataSourceConflictingFields(rnd),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("only one of `account_id,zone_id` can be specified")),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessCACertificateDataSourceConflictingFields(rnd string) string {
+	return fmt.Sprintf(`
+data "cloudflare_access_ca_certificate" "%[1]s" {
+  account_id     = "123abc"
+  zone_id        = "abc123"
+  application_id = "foo"
+}
+`, rnd)
+}
+
+func TestAccCloudflareAccessCACertificateDataSource_AccountLevel(t *testing.T) {
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_ca_certificate.%s", rnd)
+	resourceName := fmt.Sprintf("cloudflare_access_ca_certificate.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessCACertificateDataSourceConfig(rnd, domain, AccessIdentifier{Type: AccountType, Value: accountID}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrPair(name, "application_id", resourceName, "application_id"),
+					resource.TestCheckResourceAttrPair(name, "aud", resourceName, "aud"),
+					resource.TestCheckResourceAttrPair(name, "public_key", resourceName, "public_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessCACertificateDataSource_ZoneLevel(t *testing.T) {
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_ca_certificate.%s", rnd)
+	resourceName := fmt.Sprintf("cloudflare_access_ca_certificate.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessCACertificateDataSourceConfig(rnd, domain, AccessIdentifier{Type: ZoneType, Value: zoneID}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttrPair(name, "application_id", resourceName, "application_id"),
+					resource.TestCheckResourceAttrPair(name, "aud", resourceName, "aud"),
+					resource.TestCheckResourceAttrPair(name, "public_key", resourceName, "public_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAccessCACertificateDataSourceConfig(resourceName, domain string, identifier AccessIdentifier) string {
+	return testAccCloudflareAccessCACertificateBasic(resourceName, domain, identifier) + fmt.Sprintf(`
+
+data "cloudflare_access_ca_certificate" "%[1]s" {
+  %[2]s_id       = "%[3]s"
+  application_id = cloudflare_access_ca_certificate.%[1]s.application_id
+}`, resourceName, identifier.Type, identifier.Value)
+}
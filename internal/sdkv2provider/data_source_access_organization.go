@@ -0,0 +1,70 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessOrganization is an Access organization along with its session
+// duration, which cloudflare.AccessOrganization doesn't include.
+type accessOrganization struct {
+	cloudflare.AccessOrganization
+	SessionDuration string `json:"session_duration,omitempty"`
+}
+
+func dataSourceCloudflareAccessOrganization() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccessOrganizationSchema(),
+		ReadContext: dataSourceCloudflareAccessOrganizationRead,
+		Description: heredoc.Doc(`
+			Use this data source to lookup the Access organization of an
+			account or zone, such as its authentication domain to reference
+			when building Access Applications.
+		`),
+	}
+}
+
+func dataSourceCloudflareAccessOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/%ss/%s/access/organizations", identifier.Type, identifier.Value), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error fetching access organization: %w", err))
+	}
+
+	var organization accessOrganization
+	if err := json.Unmarshal(res, &organization); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal access organization: %w", err))
+	}
+
+	d.SetId(identifier.Value)
+	d.Set("name", organization.Name)
+	d.Set("auth_domain", organization.AuthDomain)
+	d.Set("is_ui_read_only", cloudflare.Bool(organization.IsUIReadOnly))
+	d.Set("user_seat_expiration_inactive_time", organization.UserSeatExpirationInactiveTime)
+	d.Set("session_duration", organization.SessionDuration)
+
+	loginDesign := []map[string]interface{}{{
+		"background_color": organization.LoginDesign.BackgroundColor,
+		"text_color":       organization.LoginDesign.TextColor,
+		"logo_path":        organization.LoginDesign.LogoPath,
+		"header_text":      organization.LoginDesign.HeaderText,
+		"footer_text":      organization.LoginDesign.FooterText,
+	}}
+	if err := d.Set("login_design", loginDesign); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Access Organization Login Design configuration: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAccessOrganizationDataSource_AccountLevel(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_access_organization.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessOrganizationDataSourceConfig(rnd, AccessIdentifier{Type: AccountType, Value: accountID}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrSet(name, "name"),
+					resource.TestCheckResourceAttrSet(name, "auth_domain"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareAccessOrganizationRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/access/organizations", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`{
+			"name": "widget-corps",
+			"auth_domain": "widget-corps.cloudflareaccess.com",
+			"is_ui_read_only": true,
+			"user_seat_expiration_inactive_time": "720h",
+			"session_duration": "24h",
+			"login_design": {
+				"background_color": "#c5ed1b",
+				"text_color": "#c5ed1b",
+				"logo_path": "https://example.com/logo.png",
+				"header_text": "Widget Corp",
+				"footer_text": "Powered by Widget Corp"
+			}
+		}`))
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessOrganizationSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+	})
+
+	diags := dataSourceCloudflareAccessOrganizationRead(context.Background(), d, client)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "widget-corps.cloudflareaccess.com", d.Get("auth_domain"))
+	assert.Equal(t, true, d.Get("is_ui_read_only"))
+	assert.Equal(t, "720h", d.Get("user_seat_expiration_inactive_time"))
+	assert.Equal(t, "24h", d.Get("session_duration"))
+	assert.Equal(t, "Widget Corp", d.Get("login_design.0.header_text"))
+}
+
+func testAccCloudflareAccessOrganizationDataSourceConfig(rnd string, identifier AccessIdentifier) string {
+	return fmt.Sprintf(`
+data "cloudflare_access_organization" "%[1]s" {
+  %[2]s_id = "%[3]s"
+}`, rnd, identifier.Type, identifier.Value)
+}
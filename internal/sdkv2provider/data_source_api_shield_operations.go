@@ -0,0 +1,54 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAPIShieldOperationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	filters := url.Values{}
+	if filter, ok := d.GetOk("filter"); ok {
+		f := filter.([]interface{})[0].(map[string]interface{})
+		for _, key := range []string{"method", "host", "endpoint"} {
+			if value, ok := f[key].(string); ok && value != "" {
+				filters.Set(key, value)
+			}
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading API Shield operations"))
+	operations, err := listAPIShieldOperations(ctx, client, zoneID, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing API Shield operations: %w", err))
+	}
+
+	operationIDs := make([]string, 0)
+	operationDetails := make([]interface{}, 0)
+
+	for _, o := range operations {
+		operationDetails = append(operationDetails, map[string]interface{}{
+			"operation_id": o.ID,
+			"method":       o.Method,
+			"host":         o.Host,
+			"endpoint":     o.Endpoint,
+		})
+		operationIDs = append(operationIDs, o.ID)
+	}
+
+	if err := d.Set("operations", operationDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting operations: %w", err))
+	}
+
+	d.SetId(stringListChecksum(operationIDs))
+	return nil
+}
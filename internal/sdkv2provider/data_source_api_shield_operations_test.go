@@ -0,0 +1,60 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareAPIShieldOperationsDataSource(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the API token
+	// endpoint does not yet support the API tokens without an explicit scope.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_api_shield_operations.%s", rnd)
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldOperationsDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "operations.#", "1"),
+					resource.TestCheckResourceAttr(name, "operations.0.method", "POST"),
+					resource.TestCheckResourceAttr(name, "operations.0.host", domain),
+					resource.TestCheckResourceAttr(name, "operations.0.endpoint", fmt.Sprintf("/%s", rnd)),
+					resource.TestCheckResourceAttrPair(name, "operations.0.operation_id", "cloudflare_api_shield_operation."+rnd, "operation_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPIShieldOperationsDataSourceConfig(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_api_shield_operation" "%[1]s" {
+	zone_id  = "%[2]s"
+	method   = "POST"
+	host     = "%[3]s"
+	endpoint = "/%[1]s"
+}
+
+data "cloudflare_api_shield_operations" "%[1]s" {
+	zone_id = "%[2]s"
+
+	filter {
+		method   = "POST"
+		host     = "%[3]s"
+		endpoint = cloudflare_api_shield_operation.%[1]s.endpoint
+	}
+}
+`, rnd, zoneID, domain)
+}
@@ -0,0 +1,68 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareD1Databases() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareD1DatabasesRead,
+		Schema:      dataSourceCloudflareD1DatabasesSchema(),
+		Description: "Use this data source to look up [D1 databases](https://developers.cloudflare.com/d1/) in an account.",
+	}
+}
+
+func dataSourceCloudflareD1DatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading D1 databases for account %s", accountID))
+
+	filters := url.Values{}
+	if name != "" {
+		filters.Set("name", name)
+	}
+
+	var databases []d1Database
+	err := listAllPages(ctx, client, d1DatabaseURI(accountID, ""), filters, func(page json.RawMessage) (int, error) {
+		var pageDatabases []d1Database
+		if err := json.Unmarshal(page, &pageDatabases); err != nil {
+			return 0, err
+		}
+		databases = append(databases, pageDatabases...)
+		return len(pageDatabases), nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing D1 databases: %w", err))
+	}
+
+	databaseIDs := make([]string, 0, len(databases))
+	databaseDetails := make([]interface{}, 0, len(databases))
+
+	for _, database := range databases {
+		databaseDetails = append(databaseDetails, map[string]interface{}{
+			"uuid":       database.UUID,
+			"name":       database.Name,
+			"version":    database.Version,
+			"created_at": database.CreatedAt,
+		})
+		databaseIDs = append(databaseIDs, database.UUID)
+	}
+
+	if err := d.Set("databases", databaseDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting databases: %w", err))
+	}
+
+	d.SetId(stringListChecksum(databaseIDs))
+	return nil
+}
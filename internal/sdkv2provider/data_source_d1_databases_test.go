@@ -0,0 +1,48 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareD1DatabasesDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_d1_databases.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareD1DatabasesDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "databases.#", "1"),
+					resource.TestCheckResourceAttr(name, "databases.0.name", rnd),
+					resource.TestCheckResourceAttrPair(name, "databases.0.uuid", "cloudflare_d1_database."+rnd, "uuid"),
+					resource.TestCheckResourceAttrPair(name, "databases.0.version", "cloudflare_d1_database."+rnd, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareD1DatabasesDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+data "cloudflare_d1_databases" "%[1]s" {
+  account_id = "%[2]s"
+  name       = cloudflare_d1_database.%[1]s.name
+}
+`, rnd, accountID)
+}
@@ -0,0 +1,66 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareFirewallRules() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareFirewallRulesRead,
+		Schema:      dataSourceCloudflareFirewallRulesSchema(),
+		Description: heredoc.Doc(`
+			Use this data source to look up all firewall rules of a zone along
+			with their filters, such as to generate import blocks for existing
+			rules.
+		`),
+	}
+}
+
+func dataSourceCloudflareFirewallRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Firewall Rules for zone %s", zoneID))
+
+	firewallRules, _, err := client.FirewallRules(ctx, cloudflare.ZoneIdentifier(zoneID), cloudflare.FirewallRuleListParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Firewall Rules: %w", err))
+	}
+
+	ruleIDs := make([]string, 0, len(firewallRules))
+	ruleDetails := make([]interface{}, 0, len(firewallRules))
+
+	for _, rule := range firewallRules {
+		priority := 0
+		if p, ok := rule.Priority.(float64); ok {
+			priority = int(p)
+		}
+
+		ruleDetails = append(ruleDetails, map[string]interface{}{
+			"id":          rule.ID,
+			"description": rule.Description,
+			"action":      rule.Action,
+			"paused":      rule.Paused,
+			"priority":    priority,
+			"products":    rule.Products,
+			"filter_id":   rule.Filter.ID,
+			"expression":  rule.Filter.Expression,
+		})
+		ruleIDs = append(ruleIDs, rule.ID)
+	}
+
+	if err := d.Set("rules", ruleDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting firewall rules: %w", err))
+	}
+
+	d.SetId(stringListChecksum(ruleIDs))
+	return nil
+}
@@ -0,0 +1,118 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareFirewallRulesDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("data.cloudflare_firewall_rules.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareFirewallRulesDataSourceConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "rules.*", map[string]string{
+						"description": rnd,
+						"action":      "block",
+						"paused":      "false",
+						"expression":  "(ip.src eq 192.0.2.1)",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(name, "rules.*.id", "cloudflare_firewall_rule."+rnd, "id"),
+					resource.TestCheckTypeSetElemAttrPair(name, "rules.*.filter_id", "cloudflare_filter."+rnd, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareFirewallRulesReadPaginates(t *testing.T) {
+	var requestedPages []string
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/0da42c8d2132a9ddaf714f9e7c920711/firewall/rules", r.URL.Path)
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		if page == 0 {
+			page = 1
+		}
+		requestedPages = append(requestedPages, strconv.Itoa(page))
+
+		rule := map[string]interface{}{
+			"id":          fmt.Sprintf("rule-%d", page),
+			"description": fmt.Sprintf("rule %d", page),
+			"action":      "block",
+			"paused":      page == 2,
+			"filter": map[string]interface{}{
+				"id":         fmt.Sprintf("filter-%d", page),
+				"expression": fmt.Sprintf("(http.request.uri.path eq \"/%d\")", page),
+			},
+		}
+		if page == 1 {
+			rule["priority"] = 10
+		}
+
+		testclient.WriteResultWithInfo(w, []interface{}{rule}, cloudflare.ResultInfo{Page: page, PerPage: 1, Count: 1, Total: 2, TotalPages: 2})
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareFirewallRulesSchema(), map[string]interface{}{
+		consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+	})
+
+	diags := dataSourceCloudflareFirewallRulesRead(context.Background(), d, client)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"1", "2"}, requestedPages)
+	assert.Equal(t, 2, d.Get("rules.#"))
+	assert.Equal(t, "rule-1", d.Get("rules.0.id"))
+	assert.Equal(t, "filter-1", d.Get("rules.0.filter_id"))
+	assert.Equal(t, `(http.request.uri.path eq "/1")`, d.Get("rules.0.expression"))
+	assert.Equal(t, 10, d.Get("rules.0.priority"))
+	assert.Equal(t, false, d.Get("rules.0.paused"))
+	assert.Equal(t, "rule-2", d.Get("rules.1.id"))
+	assert.Equal(t, "filter-2", d.Get("rules.1.filter_id"))
+	assert.Equal(t, 0, d.Get("rules.1.priority"))
+	assert.Equal(t, true, d.Get("rules.1.paused"))
+}
+
+func testAccCloudflareFirewallRulesDataSourceConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_filter" "%[1]s" {
+  zone_id     = "%[2]s"
+  description = "%[1]s"
+  expression  = "(ip.src eq 192.0.2.1)"
+}
+
+resource "cloudflare_firewall_rule" "%[1]s" {
+  zone_id     = "%[2]s"
+  description = "%[1]s"
+  filter_id   = cloudflare_filter.%[1]s.id
+  action      = "block"
+}
+
+data "cloudflare_firewall_rules" "%[1]s" {
+  zone_id = "%[2]s"
+
+  depends_on = [cloudflare_firewall_rule.%[1]s]
+}
+`, rnd, zoneID)
+}
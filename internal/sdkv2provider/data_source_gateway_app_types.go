@@ -0,0 +1,82 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// gatewayAppType is an entry of the Gateway application catalog. Entries are
+// either applications, which reference their application type, or
+// application types.
+type gatewayAppType struct {
+	ID                int    `json:"id"`
+	Name              string `json:"name"`
+	ApplicationTypeID int    `json:"application_type_id"`
+	Description       string `json:"description"`
+}
+
+func dataSourceCloudflareGatewayAppTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareGatewayAppTypesRead,
+		Schema:      dataSourceCloudflareGatewayAppTypesSchema(),
+		Description: heredoc.Doc(`
+			Use this data source to look up the applications and application
+			types Gateway can match on, so that Gateway rules can reference
+			them by name instead of by identifier.
+		`),
+	}
+}
+
+func dataSourceCloudflareGatewayAppTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Gateway app types for account %s", accountID))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/app_types", accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Gateway app types: %w", err))
+	}
+
+	var appTypes []gatewayAppType
+	if err := json.Unmarshal(res, &appTypes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Gateway app types: %w", err))
+	}
+
+	name, filterByName := d.GetOk("name")
+
+	appTypeIDs := make([]string, 0, len(appTypes))
+	appTypeDetails := make([]interface{}, 0, len(appTypes))
+
+	for _, appType := range appTypes {
+		if filterByName && !strings.EqualFold(appType.Name, name.(string)) {
+			continue
+		}
+
+		appTypeDetails = append(appTypeDetails, map[string]interface{}{
+			"id":                  appType.ID,
+			"name":                appType.Name,
+			"application_type_id": appType.ApplicationTypeID,
+			"description":         appType.Description,
+		})
+		appTypeIDs = append(appTypeIDs, strconv.Itoa(appType.ID))
+	}
+
+	if err := d.Set("app_types", appTypeDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Gateway app types: %w", err))
+	}
+
+	d.SetId(stringListChecksum(appTypeIDs))
+	return nil
+}
@@ -0,0 +1,102 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareGatewayAppTypesDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_gateway_app_types.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareGatewayAppTypesDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "app_types.#", "1"),
+					resource.TestCheckResourceAttr(name, "app_types.0.name", "Slack"),
+					resource.TestCheckResourceAttrSet(name, "app_types.0.id"),
+					resource.TestCheckResourceAttrSet(name, "app_types.0.application_type_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareGatewayAppTypesRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/gateway/app_types", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"id": 16, "name": "Instant Messaging", "description": "Applications used for instant messaging", "created_at": "2023-01-01T00:00:00Z"},
+			{"id": 1002, "name": "Slack", "application_type_id": 16, "created_at": "2023-01-01T00:00:00Z"},
+			{"id": 1003, "name": "Microsoft Teams", "application_type_id": 16, "created_at": "2023-01-01T00:00:00Z"}
+		]`))
+	}))
+
+	testCases := map[string]struct {
+		config   map[string]interface{}
+		expected []interface{}
+		ids      []string
+	}{
+		"all": {
+			config: map[string]interface{}{},
+			expected: []interface{}{
+				map[string]interface{}{"id": 16, "name": "Instant Messaging", "application_type_id": 0, "description": "Applications used for instant messaging"},
+				map[string]interface{}{"id": 1002, "name": "Slack", "application_type_id": 16, "description": ""},
+				map[string]interface{}{"id": 1003, "name": "Microsoft Teams", "application_type_id": 16, "description": ""},
+			},
+			ids: []string{"16", "1002", "1003"},
+		},
+		"filtered by name": {
+			config: map[string]interface{}{"name": "slack"},
+			expected: []interface{}{
+				map[string]interface{}{"id": 1002, "name": "Slack", "application_type_id": 16, "description": ""},
+			},
+			ids: []string{"1002"},
+		},
+		"no match": {
+			config:   map[string]interface{}{"name": "Zoom"},
+			expected: []interface{}{},
+			ids:      []string{},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			tc.config[consts.AccountIDSchemaKey] = "f037e56e89293a057740de681ac9abbe"
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareGatewayAppTypesSchema(), tc.config)
+
+			diags := dataSourceCloudflareGatewayAppTypesRead(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+
+			assert.Equal(t, tc.expected, d.Get("app_types"))
+			assert.Equal(t, stringListChecksum(tc.ids), d.Id())
+		})
+	}
+}
+
+func testAccCloudflareGatewayAppTypesDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_gateway_app_types" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "Slack"
+}
+`, rnd, accountID)
+}
@@ -0,0 +1,91 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// tunnelHealthCheck is the health check configuration reported for Magic
+// Transit tunnels, including the check rate which cloudflare-go does not
+// model.
+type tunnelHealthCheck struct {
+	Enabled bool   `json:"enabled"`
+	Target  string `json:"target,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Rate    string `json:"rate,omitempty"`
+}
+
+type greTunnelWithHealthCheck struct {
+	cloudflare.MagicTransitGRETunnel
+	HealthCheck *tunnelHealthCheck `json:"health_check,omitempty"`
+}
+
+func dataSourceCloudflareGRETunnel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareGRETunnelRead,
+		Schema:      dataSourceCloudflareGRETunnelSchema(),
+		Description: "Use this data source to look up a Magic Transit GRE tunnel and its health check configuration.",
+	}
+}
+
+func dataSourceCloudflareGRETunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading GRE tunnel %q", name))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/gre_tunnels", accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing GRE tunnels: %w", err))
+	}
+
+	var result struct {
+		GRETunnels []greTunnelWithHealthCheck `json:"gre_tunnels"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal GRE tunnels: %w", err))
+	}
+
+	for _, tunnel := range result.GRETunnels {
+		if tunnel.Name != name {
+			continue
+		}
+
+		d.SetId(tunnel.ID)
+		d.Set("customer_gre_endpoint", tunnel.CustomerGREEndpoint)
+		d.Set("cloudflare_gre_endpoint", tunnel.CloudflareGREEndpoint)
+		d.Set("interface_address", tunnel.InterfaceAddress)
+		d.Set("description", tunnel.Description)
+		d.Set("ttl", int(tunnel.TTL))
+		d.Set("mtu", int(tunnel.MTU))
+		setTunnelHealthCheck(ctx, d, tunnel.HealthCheck)
+
+		return nil
+	}
+
+	return diag.Errorf("no GRE tunnel named %q found in account %q", name, accountID)
+}
+
+// setTunnelHealthCheck sets the health check attributes of a tunnel data
+// source. Tunnels that do not report any health check data are surfaced as
+// having health checks disabled rather than failing the read.
+func setTunnelHealthCheck(ctx context.Context, d *schema.ResourceData, healthCheck *tunnelHealthCheck) {
+	if healthCheck == nil {
+		tflog.Debug(ctx, fmt.Sprintf("tunnel %s has no health check data", d.Id()))
+		healthCheck = &tunnelHealthCheck{}
+	}
+
+	d.Set("health_check_enabled", healthCheck.Enabled)
+	d.Set("health_check_target", healthCheck.Target)
+	d.Set("health_check_type", healthCheck.Type)
+	d.Set("tunnel_health_check_rate", healthCheck.Rate)
+}
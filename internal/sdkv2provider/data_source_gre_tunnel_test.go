@@ -0,0 +1,115 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareGRETunnelDataSource(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_gre_tunnel.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareGRETunnelSimple(rnd, rnd, rnd, accountID) + testAccCloudflareGRETunnelDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_gre_tunnel."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "customer_gre_endpoint", "203.0.113.1"),
+					resource.TestCheckResourceAttr(name, "cloudflare_gre_endpoint", "162.159.64.41"),
+					resource.TestCheckResourceAttr(name, "health_check_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.1"),
+					resource.TestCheckResourceAttr(name, "health_check_type", "request"),
+					resource.TestCheckResourceAttrSet(name, "tunnel_health_check_rate"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudflareGRETunnelDataSourceHealthCheck(t *testing.T) {
+	testCases := map[string]struct {
+		healthCheck     string
+		expectedEnabled bool
+		expectedTarget  string
+		expectedType    string
+		expectedRate    string
+	}{
+		"with health check": {
+			healthCheck:     `,"health_check":{"enabled":true,"target":"203.0.113.1","type":"reply","rate":"mid"}`,
+			expectedEnabled: true,
+			expectedTarget:  "203.0.113.1",
+			expectedType:    "reply",
+			expectedRate:    "mid",
+		},
+		"without health check": {
+			healthCheck: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testTunnelDataSourceClient(t, "/accounts/f037e56e89293a057740de681ac9abbe/magic/gre_tunnels",
+				fmt.Sprintf(`{"gre_tunnels":[{"id":"c4a7362d577a6c3019a474fd6f485821","name":"tunnel","customer_gre_endpoint":"203.0.113.1","cloudflare_gre_endpoint":"162.159.64.41","interface_address":"10.212.0.9/31","ttl":64,"mtu":1476%s}]}`, tc.healthCheck))
+
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareGRETunnelSchema(), map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"name":       "tunnel",
+			})
+
+			diags := dataSourceCloudflareGRETunnelRead(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, "c4a7362d577a6c3019a474fd6f485821", d.Id())
+			assert.Equal(t, 1476, d.Get("mtu"))
+			assert.Equal(t, tc.expectedEnabled, d.Get("health_check_enabled"))
+			assert.Equal(t, tc.expectedTarget, d.Get("health_check_target"))
+			assert.Equal(t, tc.expectedType, d.Get("health_check_type"))
+			assert.Equal(t, tc.expectedRate, d.Get("tunnel_health_check_rate"))
+		})
+	}
+}
+
+func TestCloudflareGRETunnelDataSourceNotFound(t *testing.T) {
+	client := testTunnelDataSourceClient(t, "/accounts/f037e56e89293a057740de681ac9abbe/magic/gre_tunnels", `{"gre_tunnels":[]}`)
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareGRETunnelSchema(), map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"name":       "tunnel",
+	})
+
+	diags := dataSourceCloudflareGRETunnelRead(context.Background(), d, client)
+	assert.True(t, diags.HasError())
+}
+
+// testTunnelDataSourceClient returns an API client backed by a server that
+// responds to path with result.
+func testTunnelDataSourceClient(t *testing.T, path, result string) *cloudflare.API {
+	return testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, path, r.URL.Path)
+		testclient.WriteResult(w, json.RawMessage(result))
+	}))
+}
+
+func testAccCloudflareGRETunnelDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+
+  data "cloudflare_gre_tunnel" "%[1]s" {
+    account_id = "%[2]s"
+    name       = cloudflare_gre_tunnel.%[1]s.name
+  }`, rnd, accountID)
+}
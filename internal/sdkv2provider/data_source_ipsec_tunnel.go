@@ -0,0 +1,65 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ipsecTunnelWithHealthCheck struct {
+	cloudflare.MagicTransitIPsecTunnel
+	HealthCheck *tunnelHealthCheck `json:"health_check,omitempty"`
+}
+
+func dataSourceCloudflareIPsecTunnel() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareIPsecTunnelRead,
+		Schema:      dataSourceCloudflareIPsecTunnelSchema(),
+		Description: "Use this data source to look up a Magic Transit IPsec tunnel and its health check configuration.",
+	}
+}
+
+func dataSourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading IPsec tunnel %q", name))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/magic/ipsec_tunnels", accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing IPsec tunnels: %w", err))
+	}
+
+	var result struct {
+		IPsecTunnels []ipsecTunnelWithHealthCheck `json:"ipsec_tunnels"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal IPsec tunnels: %w", err))
+	}
+
+	for _, tunnel := range result.IPsecTunnels {
+		if tunnel.Name != name {
+			continue
+		}
+
+		d.SetId(tunnel.ID)
+		d.Set("customer_endpoint", tunnel.CustomerEndpoint)
+		d.Set("cloudflare_endpoint", tunnel.CloudflareEndpoint)
+		d.Set("interface_address", tunnel.InterfaceAddress)
+		d.Set("description", tunnel.Description)
+		d.Set("allow_null_cipher", tunnel.AllowNullCipher)
+		setTunnelHealthCheck(ctx, d, tunnel.HealthCheck)
+
+		return nil
+	}
+
+	return diag.Errorf("no IPsec tunnel named %q found in account %q", name, accountID)
+}
@@ -0,0 +1,88 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareIPsecTunnelDataSource(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("data.cloudflare_ipsec_tunnel.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	psk := "asdf1234"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareIPsecTunnelSimple(rnd, rnd, accountID, psk) + testAccCloudflareIPsecTunnelDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_ipsec_tunnel."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "customer_endpoint", "203.0.113.1"),
+					resource.TestCheckResourceAttr(name, "cloudflare_endpoint", "162.159.64.41"),
+					resource.TestCheckResourceAttr(name, "allow_null_cipher", "false"),
+					resource.TestCheckResourceAttr(name, "health_check_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.1"),
+					resource.TestCheckResourceAttr(name, "health_check_type", "request"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudflareIPsecTunnelDataSourceHealthCheck(t *testing.T) {
+	testCases := map[string]struct {
+		healthCheck     string
+		expectedEnabled bool
+		expectedTarget  string
+		expectedRate    string
+	}{
+		"with health check": {
+			healthCheck:     `,"health_check":{"enabled":true,"target":"203.0.113.1","type":"request","rate":"high"}`,
+			expectedEnabled: true,
+			expectedTarget:  "203.0.113.1",
+			expectedRate:    "high",
+		},
+		"without health check": {
+			healthCheck: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testTunnelDataSourceClient(t, "/accounts/f037e56e89293a057740de681ac9abbe/magic/ipsec_tunnels",
+				fmt.Sprintf(`{"ipsec_tunnels":[{"id":"c4a7362d577a6c3019a474fd6f485821","name":"tunnel","customer_endpoint":"203.0.113.1","cloudflare_endpoint":"162.159.64.41","interface_address":"10.212.0.9/31","allow_null_cipher":false%s}]}`, tc.healthCheck))
+
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareIPsecTunnelSchema(), map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"name":       "tunnel",
+			})
+
+			diags := dataSourceCloudflareIPsecTunnelRead(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, "c4a7362d577a6c3019a474fd6f485821", d.Id())
+			assert.Equal(t, "203.0.113.1", d.Get("customer_endpoint"))
+			assert.Equal(t, tc.expectedEnabled, d.Get("health_check_enabled"))
+			assert.Equal(t, tc.expectedTarget, d.Get("health_check_target"))
+			assert.Equal(t, tc.expectedRate, d.Get("tunnel_health_check_rate"))
+		})
+	}
+}
+
+func testAccCloudflareIPsecTunnelDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+
+  data "cloudflare_ipsec_tunnel" "%[1]s" {
+    account_id = "%[2]s"
+    name       = cloudflare_ipsec_tunnel.%[1]s.name
+  }`, rnd, accountID)
+}
@@ -0,0 +1,48 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareList() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareListRead,
+		Schema:      dataSourceCloudflareListSchema(),
+		Description: "Use this data source to look up a List by name, such as to reference it from a ruleset expression.",
+	}
+}
+
+func dataSourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading List %q", name))
+
+	lists, err := client.ListLists(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListListsParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Lists: %w", err))
+	}
+
+	for _, list := range lists {
+		if list.Name != name {
+			continue
+		}
+
+		d.SetId(list.ID)
+		d.Set("description", list.Description)
+		d.Set("kind", list.Kind)
+		d.Set("num_items", list.NumItems)
+
+		return nil
+	}
+
+	return diag.Errorf("no List named %q found in account %q", name, accountID)
+}
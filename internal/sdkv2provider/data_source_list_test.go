@@ -0,0 +1,97 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareListDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_list.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareListDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_list."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "kind", "ip"),
+					resource.TestCheckResourceAttr(name, "description", "list named "+rnd),
+					resource.TestCheckResourceAttr(name, "num_items", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareListRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/rules/lists", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "allowed_ips", "description": "Allowed IPs", "kind": "ip", "num_items": 10},
+			{"id": "3d1fc9fa937b11eaa1b71c4d701ab86e", "name": "redirects", "description": "", "kind": "redirect", "num_items": 2}
+		]`))
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareListSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+		"name":                    "allowed_ips",
+	})
+
+	diags := dataSourceCloudflareListRead(context.Background(), d, client)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "2c0fc9fa937b11eaa1b71c4d701ab86e", d.Id())
+	assert.Equal(t, "Allowed IPs", d.Get("description"))
+	assert.Equal(t, "ip", d.Get("kind"))
+	assert.Equal(t, 10, d.Get("num_items"))
+
+	d = schema.TestResourceDataRaw(t, dataSourceCloudflareListSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+		"name":                    "missing",
+	})
+
+	diags = dataSourceCloudflareListRead(context.Background(), d, client)
+
+	assert.True(t, diags.HasError())
+	assert.Equal(t, `no List named "missing" found in account "f037e56e89293a057740de681ac9abbe"`, diags[0].Summary)
+}
+
+func testAccCloudflareListDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_list" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "list named %[1]s"
+  kind        = "ip"
+
+  item {
+    value {
+      ip = "192.0.2.1"
+    }
+  }
+}
+
+data "cloudflare_list" "%[1]s" {
+  account_id = "%[2]s"
+  name       = cloudflare_list.%[1]s.name
+}
+`, rnd, accountID)
+}
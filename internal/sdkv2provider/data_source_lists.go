@@ -0,0 +1,58 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareLists() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareListsRead,
+		Schema:      dataSourceCloudflareListsSchema(),
+		Description: "Use this data source to look up the Lists of an account, optionally filtered by kind.",
+	}
+}
+
+func dataSourceCloudflareListsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	kind := d.Get("kind").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Lists for account %s", accountID))
+
+	lists, err := client.ListLists(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListListsParams{})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Lists: %w", err))
+	}
+
+	listIDs := make([]string, 0)
+	listDetails := make([]interface{}, 0)
+
+	for _, list := range lists {
+		if kind != "" && list.Kind != kind {
+			continue
+		}
+
+		listDetails = append(listDetails, map[string]interface{}{
+			"id":          list.ID,
+			"name":        list.Name,
+			"description": list.Description,
+			"kind":        list.Kind,
+			"num_items":   list.NumItems,
+		})
+		listIDs = append(listIDs, list.ID)
+	}
+
+	if err := d.Set("lists", listDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lists: %w", err))
+	}
+
+	d.SetId(stringListChecksum(listIDs))
+	return nil
+}
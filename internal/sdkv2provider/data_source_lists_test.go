@@ -0,0 +1,99 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareListsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_lists.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareListsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "lists.*", map[string]string{
+						"name": rnd,
+						"kind": "redirect",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(name, "lists.*.id", "cloudflare_list."+rnd, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareListsReadFiltersKind(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/rules/lists", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "allowed_ips", "description": "Allowed IPs", "kind": "ip", "num_items": 10},
+			{"id": "3d1fc9fa937b11eaa1b71c4d701ab86e", "name": "redirects", "description": "", "kind": "redirect", "num_items": 2}
+		]`))
+	}))
+
+	testCases := map[string]struct {
+		kind     string
+		expected []string
+	}{
+		"all lists":      {expected: []string{"allowed_ips", "redirects"}},
+		"ip lists":       {kind: "ip", expected: []string{"allowed_ips"}},
+		"redirect lists": {kind: "redirect", expected: []string{"redirects"}},
+		"no match":       {kind: "hostname", expected: []string{}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareListsSchema(), map[string]interface{}{
+				consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+				"kind":                    tc.kind,
+			})
+
+			diags := dataSourceCloudflareListsRead(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+
+			names := []string{}
+			for _, list := range d.Get("lists").([]interface{}) {
+				names = append(names, list.(map[string]interface{})["name"].(string))
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func testAccCloudflareListsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_list" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  kind       = "redirect"
+}
+
+data "cloudflare_lists" "%[1]s" {
+  account_id = "%[2]s"
+  kind       = "redirect"
+
+  depends_on = [cloudflare_list.%[1]s]
+}
+`, rnd, accountID)
+}
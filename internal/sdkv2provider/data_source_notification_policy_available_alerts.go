@@ -0,0 +1,108 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// notificationAvailableAlert extends the available alert returned by
+// cloudflare-go with the filter options the API reports for it.
+type notificationAvailableAlert struct {
+	cloudflare.NotificationAlertWithDescription
+	FilterOptions []struct {
+		Key string `json:"Key"`
+	} `json:"filter_options"`
+}
+
+func dataSourceCloudflareNotificationPolicyAvailableAlerts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareNotificationPolicyAvailableAlertsRead,
+		Schema:      dataSourceCloudflareNotificationPolicyAvailableAlertsSchema(),
+		Description: "Use this data source to look up the alert types and delivery mechanisms available for [notification policies](https://developers.cloudflare.com/notifications/) in an account.",
+	}
+}
+
+func dataSourceCloudflareNotificationPolicyAvailableAlertsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading available notification alerts for account %s", accountID))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/alerting/v3/available_alerts", accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing available notification alerts: %w", err))
+	}
+
+	var alertsByProduct map[string][]notificationAvailableAlert
+	if err := json.Unmarshal(res, &alertsByProduct); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal available notification alerts: %w", err))
+	}
+
+	eligibility, err := client.GetEligibleNotificationDestinations(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing eligible notification mechanisms: %w", err))
+	}
+
+	products := make([]string, 0, len(alertsByProduct))
+	for product := range alertsByProduct {
+		products = append(products, product)
+	}
+	sort.Strings(products)
+
+	alertTypes := make([]string, 0)
+	alertDetails := make([]interface{}, 0)
+
+	for _, product := range products {
+		for _, alert := range alertsByProduct[product] {
+			filters := make([]string, 0, len(alert.FilterOptions))
+			for _, option := range alert.FilterOptions {
+				filters = append(filters, option.Key)
+			}
+
+			alertDetails = append(alertDetails, map[string]interface{}{
+				"product":      product,
+				"type":         alert.Type,
+				"display_name": alert.DisplayName,
+				"description":  alert.Description,
+				"filters":      filters,
+			})
+			alertTypes = append(alertTypes, alert.Type)
+		}
+	}
+
+	if err := d.Set("alerts", alertDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting alerts: %w", err))
+	}
+
+	if err := d.Set("mechanisms", flattenEligibleNotificationMechanisms(eligibility.Result)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting mechanisms: %w", err))
+	}
+
+	d.SetId(stringListChecksum(alertTypes))
+	return nil
+}
+
+func flattenEligibleNotificationMechanisms(mechanisms cloudflare.NotificationMechanisms) []string {
+	eligible := make([]string, 0)
+	for name, mechanism := range map[string]cloudflare.NotificationMechanismMetaData{
+		"email":     mechanisms.Email,
+		"pagerduty": mechanisms.PagerDuty,
+		"webhooks":  mechanisms.Webhooks,
+	} {
+		if mechanism.Eligible {
+			eligible = append(eligible, name)
+		}
+	}
+	sort.Strings(eligible)
+
+	return eligible
+}
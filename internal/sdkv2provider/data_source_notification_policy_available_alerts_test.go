@@ -0,0 +1,86 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareNotificationPolicyAvailableAlertsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_notification_policy_available_alerts.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareNotificationPolicyAvailableAlertsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "alerts.#"),
+					resource.TestCheckResourceAttrSet(name, "alerts.0.type"),
+					resource.TestCheckResourceAttrSet(name, "alerts.0.display_name"),
+					resource.TestCheckTypeSetElemAttr(name, "mechanisms.*", "email"),
+				),
+			},
+		},
+	})
+}
+
+func TestCloudflareNotificationPolicyAvailableAlertsDataSourceRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/accounts/f037e56e89293a057740de681ac9abbe/alerting/v3/available_alerts":
+			testclient.WriteResult(w, json.RawMessage(`{
+				"Origin Monitoring":[{"display_name":"Passive Origin Monitoring","type":"real_origin_monitoring","description":"Origin is unreachable."}],
+				"Health Checks":[{"display_name":"Health Checks status notification","type":"health_check_status_notification","description":"Health check status changed.","filter_options":[{"Key":"health_check_id"},{"Key":"status"}]}]
+			}`))
+		case "/accounts/f037e56e89293a057740de681ac9abbe/alerting/v3/destinations/eligible":
+			testclient.WriteResult(w, json.RawMessage(`{
+				"email":{"eligible":true,"ready":true,"type":"email"},
+				"pagerduty":{"eligible":false,"ready":false,"type":"pagerduty"},
+				"webhooks":{"eligible":true,"ready":false,"type":"webhooks"}
+			}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareNotificationPolicyAvailableAlertsSchema(), map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+	})
+
+	diags := dataSourceCloudflareNotificationPolicyAvailableAlertsRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, 2, d.Get("alerts.#"))
+	assert.Equal(t, "Health Checks", d.Get("alerts.0.product"))
+	assert.Equal(t, "health_check_status_notification", d.Get("alerts.0.type"))
+	assert.Equal(t, "Health Checks status notification", d.Get("alerts.0.display_name"))
+	assert.Equal(t, []interface{}{"health_check_id", "status"}, d.Get("alerts.0.filters"))
+	assert.Equal(t, "Origin Monitoring", d.Get("alerts.1.product"))
+	assert.Equal(t, "Origin is unreachable.", d.Get("alerts.1.description"))
+	assert.Equal(t, 0, d.Get("alerts.1.filters.#"))
+	assert.Equal(t, []interface{}{"email", "webhooks"}, d.Get("mechanisms"))
+	assert.NotEmpty(t, d.Id())
+}
+
+func testAccCloudflareNotificationPolicyAvailableAlertsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_notification_policy_available_alerts" "%[1]s" {
+  account_id = "%[2]s"
+}
+`, rnd, accountID)
+}
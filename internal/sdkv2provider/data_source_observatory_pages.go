@@ -0,0 +1,68 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// observatoryPage is a page of a zone with Observatory test results.
+type observatoryPage struct {
+	URL    string `json:"url"`
+	Region struct {
+		Value string `json:"value"`
+		Label string `json:"label"`
+	} `json:"region"`
+	ScheduleFrequency string `json:"scheduleFrequency"`
+}
+
+func dataSourceCloudflareObservatoryPages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareObservatoryPagesRead,
+		Schema:      dataSourceCloudflareObservatoryPagesSchema(),
+		Description: "Use this data source to look up the pages of a zone tested by Observatory.",
+	}
+}
+
+func dataSourceCloudflareObservatoryPagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Observatory pages for zone %s", zoneID))
+
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/zones/%s/speed_api/pages", zoneID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Observatory pages: %w", err))
+	}
+
+	var pages []observatoryPage
+	if err := json.Unmarshal(res, &pages); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Observatory pages: %w", err))
+	}
+
+	pageURLs := make([]string, 0)
+	pageDetails := make([]interface{}, 0)
+
+	for _, page := range pages {
+		pageDetails = append(pageDetails, map[string]interface{}{
+			"url":                page.URL,
+			"region":             page.Region.Value,
+			"schedule_frequency": page.ScheduleFrequency,
+		})
+		pageURLs = append(pageURLs, page.URL)
+	}
+
+	if err := d.Set("pages", pageDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting pages: %w", err))
+	}
+
+	d.SetId(stringListChecksum(pageURLs))
+	return nil
+}
@@ -0,0 +1,92 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareObservatoryPagesDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := fmt.Sprintf("data.cloudflare_observatory_pages.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareObservatoryPagesDataSourceConfig(rnd, zoneID, domain+"/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "pages.*", map[string]string{
+						"url":                domain + "/",
+						"region":             "us-central1",
+						"schedule_frequency": "DAILY",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareObservatoryPagesRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/023e105f4ecef8ad9ca31a8372d0c353/speed_api/pages", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"url": "example.com/", "region": {"value": "us-central1", "label": "Iowa, USA"}, "scheduleFrequency": "DAILY", "tests": []},
+			{"url": "example.com/blog", "region": {"value": "europe-west1", "label": "Belgium"}, "tests": []}
+		]`))
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareObservatoryPagesSchema(), map[string]interface{}{
+		consts.ZoneIDSchemaKey: "023e105f4ecef8ad9ca31a8372d0c353",
+	})
+
+	diags := dataSourceCloudflareObservatoryPagesRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"url":                "example.com/",
+			"region":             "us-central1",
+			"schedule_frequency": "DAILY",
+		},
+		map[string]interface{}{
+			"url":                "example.com/blog",
+			"region":             "europe-west1",
+			"schedule_frequency": "",
+		},
+	}, d.Get("pages"))
+	assert.Equal(t, stringListChecksum([]string{"example.com/", "example.com/blog"}), d.Id())
+}
+
+func testAccCloudflareObservatoryPagesDataSourceConfig(rnd, zoneID, pageURL string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_observatory_scheduled_test" "%[1]s" {
+  zone_id   = "%[2]s"
+  url       = "%[3]s"
+  frequency = "DAILY"
+  region    = "us-central1"
+}
+
+data "cloudflare_observatory_pages" "%[1]s" {
+  zone_id = "%[2]s"
+
+  depends_on = [cloudflare_observatory_scheduled_test.%[1]s]
+}
+`, rnd, zoneID, pageURL)
+}
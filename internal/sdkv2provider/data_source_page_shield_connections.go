@@ -0,0 +1,21 @@
+package sdkv2provider
+
+import (
+	"context"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflarePageShieldConnections() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflarePageShieldConnectionsRead,
+		Schema:      dataSourceCloudflarePageShieldConnectionsSchema(),
+		Description: "Use this data source to look up the connections discovered by [Page Shield](https://developers.cloudflare.com/page-shield/) in a zone.",
+	}
+}
+
+func dataSourceCloudflarePageShieldConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readPageShieldResources(ctx, d, meta.(*cloudflare.API), "connections")
+}
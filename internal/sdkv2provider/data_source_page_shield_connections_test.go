@@ -0,0 +1,38 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflarePageShieldConnectionsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("data.cloudflare_page_shield_connections.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePageShieldConnectionsDataSourceConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "connections.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflarePageShieldConnectionsDataSourceConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_page_shield_connections" "%[1]s" {
+  zone_id = "%[2]s"
+  status  = "active"
+}
+`, rnd, zoneID)
+}
@@ -0,0 +1,101 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pageShieldResource is a script or connection discovered by Page Shield.
+type pageShieldResource struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	Host        string   `json:"host"`
+	FirstSeenAt string   `json:"first_seen_at"`
+	LastSeenAt  string   `json:"last_seen_at"`
+	PageURLs    []string `json:"page_urls"`
+}
+
+func dataSourceCloudflarePageShieldScripts() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflarePageShieldScriptsRead,
+		Schema:      dataSourceCloudflarePageShieldScriptsSchema(),
+		Description: "Use this data source to look up the scripts discovered by [Page Shield](https://developers.cloudflare.com/page-shield/) in a zone.",
+	}
+}
+
+func dataSourceCloudflarePageShieldScriptsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return readPageShieldResources(ctx, d, meta.(*cloudflare.API), "scripts")
+}
+
+// readPageShieldResources lists every Page Shield resource of the given kind
+// matching the filters of d and sets them on the attribute of the same name.
+func readPageShieldResources(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, kind string) diag.Diagnostics {
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Page Shield %s for zone %s", kind, zoneID))
+
+	filters := url.Values{}
+	if status, ok := d.GetOk("status"); ok {
+		filters.Set("status", status.(string))
+	}
+	if host, ok := d.GetOk("host"); ok {
+		filters.Set("hosts", host.(string))
+	}
+	if direction, ok := d.GetOk("direction"); ok {
+		filters.Set("direction", direction.(string))
+	}
+
+	resources, err := listPageShieldResources(ctx, client, zoneID, kind, filters)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Page Shield %s: %w", kind, err))
+	}
+
+	ids := make([]string, 0, len(resources))
+	details := make([]interface{}, 0, len(resources))
+
+	for _, r := range resources {
+		details = append(details, map[string]interface{}{
+			"id":            r.ID,
+			"url":           r.URL,
+			"host":          r.Host,
+			"first_seen_at": r.FirstSeenAt,
+			"last_seen_at":  r.LastSeenAt,
+			"page_urls":     r.PageURLs,
+		})
+		ids = append(ids, r.ID)
+	}
+
+	if err := d.Set(kind, details); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting %s: %w", kind, err))
+	}
+
+	d.SetId(stringListChecksum(ids))
+	return nil
+}
+
+// listPageShieldResources requests every page of the Page Shield resources
+// of the given kind in a zone.
+func listPageShieldResources(ctx context.Context, client *cloudflare.API, zoneID, kind string, filters url.Values) ([]pageShieldResource, error) {
+	var resources []pageShieldResource
+	err := listAllPages(ctx, client, fmt.Sprintf("/zones/%s/page_shield/%s", zoneID, kind), filters, func(page json.RawMessage) (int, error) {
+		var pageResources []pageShieldResource
+		if err := json.Unmarshal(page, &pageResources); err != nil {
+			return 0, err
+		}
+		resources = append(resources, pageResources...)
+		return len(pageResources), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resources, nil
+}
@@ -0,0 +1,75 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflarePageShieldScriptsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("data.cloudflare_page_shield_scripts.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePageShieldScriptsDataSourceConfig(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttrSet(name, "scripts.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestListPageShieldResources(t *testing.T) {
+	total := listAllPagesPerPage + 3
+	var requestedPages []string
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/zone/page_shield/scripts", r.URL.Path)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		assert.Equal(t, "cdn.example.com", r.URL.Query().Get("hosts"))
+		assert.Equal(t, strconv.Itoa(listAllPagesPerPage), r.URL.Query().Get("per_page"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+		var scripts []pageShieldResource
+		for i := (page - 1) * listAllPagesPerPage; i < total && i < page*listAllPagesPerPage; i++ {
+			scripts = append(scripts, pageShieldResource{ID: strconv.Itoa(i), URL: fmt.Sprintf("https://cdn.example.com/%d.js", i)})
+		}
+
+		testclient.WriteResult(w, scripts)
+	}))
+
+	scripts, err := listPageShieldResources(context.Background(), client, "zone", "scripts", url.Values{
+		"status": {"active"},
+		"hosts":  {"cdn.example.com"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, scripts, total)
+	assert.Equal(t, []string{"1", "2", "3"}, requestedPages)
+}
+
+func testAccCloudflarePageShieldScriptsDataSourceConfig(rnd, zoneID string) string {
+	return fmt.Sprintf(`
+data "cloudflare_page_shield_scripts" "%[1]s" {
+  zone_id   = "%[2]s"
+  direction = "desc"
+}
+`, rnd, zoneID)
+}
@@ -3,6 +3,9 @@ package sdkv2provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
@@ -101,6 +104,14 @@ func dataSourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData,
 		if priority, ok := d.GetOkExists("priority"); ok {
 			p = uint16(priority.(int))
 		}
+
+		// Multiple records may share the same priority so order them by
+		// their identity to consistently select the same record between runs.
+		sort.SliceStable(records, func(i, j int) bool {
+			return dnsRecordHash(records[i].Name, records[i].Type, records[i].Content, records[i].Priority) <
+				dnsRecordHash(records[j].Name, records[j].Type, records[j].Content, records[j].Priority)
+		})
+
 		for _, record := range records {
 			if cloudflare.Uint16(record.Priority) == p {
 				records = []cloudflare.DNSRecord{record}
@@ -128,3 +139,26 @@ func dataSourceCloudflareRecordRead(ctx context.Context, d *schema.ResourceData,
 
 	return nil
 }
+
+// recordTypesWithPriority are the DNS record types which set their priority
+// with the top-level `priority` attribute. SRV records set it in `data`.
+var recordTypesWithPriority = []string{"MX", "URI"}
+
+// dnsRecordHash returns a stable identity for a DNS record based on the
+// attributes which make it unique within a zone. Records sharing the same
+// name, type and priority (such as multiple MX records) are distinguished by
+// their content.
+func dnsRecordHash(name, recordType, content string, priority *uint16) string {
+	return hashCodeStrings([]string{
+		strings.ToLower(strings.TrimSuffix(name, ".")),
+		strings.ToUpper(recordType),
+		strings.TrimSuffix(content, "."),
+		strconv.Itoa(int(cloudflare.Uint16(priority))),
+	})
+}
+
+// suppressPriority ignores the top-level priority of record types which don't
+// use it, such as SRV records which set it in data.
+func suppressPriority(k, old, new string, d *schema.ResourceData) bool {
+	return !contains(recordTypesWithPriority, d.Get("type").(string))
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareRecordDataSource(t *testing.T) {
@@ -15,8 +16,8 @@ func TestAccCloudflareRecordDataSource(t *testing.T) {
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudflareRecordDataSourceConfig(rnd, zoneID, domain),
@@ -42,8 +43,8 @@ func TestAccCloudflareRecordDataSourceTXT(t *testing.T) {
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudflareRecordDataSourceConfigTXT(rnd, zoneID, domain),
@@ -69,8 +70,8 @@ func TestAccCloudflareRecordDataSourceMX(t *testing.T) {
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCloudflareRecordDataSourceConfigMX(rnd, zoneID, domain),
@@ -90,6 +91,30 @@ func TestAccCloudflareRecordDataSourceMX(t *testing.T) {
 	})
 }
 
+func TestDNSRecordHash(t *testing.T) {
+	t.Parallel()
+
+	p10 := uint16(10)
+	p20 := uint16(20)
+
+	assert.Equal(t,
+		dnsRecordHash("example.com", "MX", "mx1.example.com", &p10),
+		dnsRecordHash("Example.com.", "mx", "mx1.example.com.", &p10),
+	)
+	assert.NotEqual(t,
+		dnsRecordHash("example.com", "MX", "mx1.example.com", &p10),
+		dnsRecordHash("example.com", "MX", "mx2.example.com", &p10),
+	)
+	assert.NotEqual(t,
+		dnsRecordHash("example.com", "MX", "mx1.example.com", &p10),
+		dnsRecordHash("example.com", "MX", "mx1.example.com", &p20),
+	)
+	assert.Equal(t,
+		dnsRecordHash("example.com", "A", "192.0.2.1", nil),
+		dnsRecordHash("example.com", "A", "192.0.2.1", nil),
+	)
+}
+
 func testAccCloudflareRecordDataSourceConfig(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 data "cloudflare_record" "%[1]s" {
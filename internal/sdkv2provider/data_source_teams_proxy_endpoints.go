@@ -0,0 +1,52 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTeamsProxyEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareTeamsProxyEndpointsRead,
+		Schema:      dataSourceCloudflareTeamsProxyEndpointsSchema(),
+		Description: "Use this data source to look up the Teams Proxy Endpoints of an account.",
+	}
+}
+
+func dataSourceCloudflareTeamsProxyEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Teams Proxy Endpoints for account %s", accountID))
+
+	endpoints, _, err := client.TeamsProxyEndpoints(ctx, accountID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Teams Proxy Endpoints: %w", err))
+	}
+
+	endpointIDs := make([]string, 0)
+	endpointDetails := make([]interface{}, 0)
+
+	for _, endpoint := range endpoints {
+		endpointDetails = append(endpointDetails, map[string]interface{}{
+			"id":        endpoint.ID,
+			"name":      endpoint.Name,
+			"subdomain": endpoint.Subdomain,
+			"ips":       endpoint.IPs,
+		})
+		endpointIDs = append(endpointIDs, endpoint.ID)
+	}
+
+	if err := d.Set("endpoints", endpointDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting endpoints: %w", err))
+	}
+
+	d.SetId(stringListChecksum(endpointIDs))
+	return nil
+}
@@ -0,0 +1,95 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareTeamsProxyEndpointsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_teams_proxy_endpoints.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsProxyEndpointsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "endpoints.*", map[string]string{
+						"name":  rnd,
+						"ips.#": "1",
+						"ips.0": "104.16.132.229/32",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(name, "endpoints.*.id", "cloudflare_teams_proxy_endpoint."+rnd, "id"),
+					resource.TestCheckTypeSetElemAttrPair(name, "endpoints.*.subdomain", "cloudflare_teams_proxy_endpoint."+rnd, "subdomain"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareTeamsProxyEndpointsRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/gateway/proxy_endpoints", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"id": "ed35569b41ce4d1facfe683550f54086", "name": "office", "subdomain": "oli3n9zkz5.proxy.cloudflare-gateway.com", "ips": ["192.0.2.0/24", "198.51.100.1/32"]},
+			{"id": "5f1b3c2a9e8d4f7a8b6c1d2e3f4a5b6c", "name": "datacenter", "subdomain": "x7kq2m4p9r.proxy.cloudflare-gateway.com", "ips": ["203.0.113.0/24"]}
+		]`))
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareTeamsProxyEndpointsSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+	})
+
+	diags := dataSourceCloudflareTeamsProxyEndpointsRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id":        "ed35569b41ce4d1facfe683550f54086",
+			"name":      "office",
+			"subdomain": "oli3n9zkz5.proxy.cloudflare-gateway.com",
+			"ips":       []interface{}{"192.0.2.0/24", "198.51.100.1/32"},
+		},
+		map[string]interface{}{
+			"id":        "5f1b3c2a9e8d4f7a8b6c1d2e3f4a5b6c",
+			"name":      "datacenter",
+			"subdomain": "x7kq2m4p9r.proxy.cloudflare-gateway.com",
+			"ips":       []interface{}{"203.0.113.0/24"},
+		},
+	}, d.Get("endpoints"))
+	assert.Equal(t, stringListChecksum([]string{"ed35569b41ce4d1facfe683550f54086", "5f1b3c2a9e8d4f7a8b6c1d2e3f4a5b6c"}), d.Id())
+}
+
+func testAccCloudflareTeamsProxyEndpointsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_proxy_endpoint" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  ips        = ["104.16.132.229/32"]
+}
+
+data "cloudflare_teams_proxy_endpoints" "%[1]s" {
+  account_id = "%[2]s"
+
+  depends_on = [cloudflare_teams_proxy_endpoint.%[1]s]
+}
+`, rnd, accountID)
+}
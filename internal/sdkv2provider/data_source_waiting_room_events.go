@@ -0,0 +1,71 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWaitingRoomEvents() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareWaitingRoomEventsRead,
+		Schema:      dataSourceCloudflareWaitingRoomEventsSchema(),
+		Description: "Use this data source to look up all [Waiting Room Events](https://developers.cloudflare.com/waiting-room/additional-options/waiting-room-events/) of a waiting room.",
+	}
+}
+
+func dataSourceCloudflareWaitingRoomEventsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	waitingRoomID := d.Get("waiting_room_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Waiting Room Events for waiting room %s", waitingRoomID))
+
+	var waitingRoomEvents []cloudflare.WaitingRoomEvent
+	err := listAllPages(ctx, client, fmt.Sprintf("/zones/%s/waiting_rooms/%s/events", zoneID, waitingRoomID), nil, func(page json.RawMessage) (int, error) {
+		var events []cloudflare.WaitingRoomEvent
+		if err := json.Unmarshal(page, &events); err != nil {
+			return 0, err
+		}
+		waitingRoomEvents = append(waitingRoomEvents, events...)
+		return len(events), nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Waiting Room Events: %w", err))
+	}
+
+	eventIDs := make([]string, 0, len(waitingRoomEvents))
+	eventDetails := make([]interface{}, 0, len(waitingRoomEvents))
+
+	for _, event := range waitingRoomEvents {
+		prequeueStartTime := ""
+		if event.PrequeueStartTime != nil {
+			prequeueStartTime = event.PrequeueStartTime.Format(time.RFC3339)
+		}
+
+		eventDetails = append(eventDetails, map[string]interface{}{
+			"id":                  event.ID,
+			"name":                event.Name,
+			"description":         event.Description,
+			"event_start_time":    event.EventStartTime.Format(time.RFC3339),
+			"event_end_time":      event.EventEndTime.Format(time.RFC3339),
+			"prequeue_start_time": prequeueStartTime,
+			"suspended":           event.Suspended,
+		})
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	if err := d.Set("events", eventDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting waiting room events: %w", err))
+	}
+
+	d.SetId(stringListChecksum(append([]string{waitingRoomID}, eventIDs...)))
+	return nil
+}
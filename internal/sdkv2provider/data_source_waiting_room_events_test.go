@@ -0,0 +1,66 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccCloudflareWaitingRoomEventsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := fmt.Sprintf("data.cloudflare_waiting_room_events.%s", rnd)
+	eventStartTime := time.Now().UTC().Add(time.Hour).Truncate(time.Minute)
+	eventEndTime := eventStartTime.Add(30 * time.Minute)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWaitingRoomEventsDataSourceConfig(rnd, zoneID, domain, eventStartTime, eventEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "waiting_room_id", "cloudflare_waiting_room."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "events.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "events.0.id", "cloudflare_waiting_room_event."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "events.0.name", "waiting_room_event_"+rnd),
+					resource.TestCheckResourceAttr(name, "events.0.event_start_time", eventStartTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(name, "events.0.event_end_time", eventEndTime.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(name, "events.0.suspended", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWaitingRoomEventsDataSourceConfig(rnd, zoneID, domain string, startTime, endTime time.Time) string {
+	return fmt.Sprintf(`
+resource "cloudflare_waiting_room" "%[1]s" {
+  name                 = "waiting_room_%[1]s"
+  zone_id              = "%[2]s"
+  host                 = "www.%[3]s"
+  path                 = "/%[1]s"
+  new_users_per_minute = 400
+  total_active_users   = 405
+}
+
+resource "cloudflare_waiting_room_event" "%[1]s" {
+  name             = "waiting_room_event_%[1]s"
+  zone_id          = "%[2]s"
+  waiting_room_id  = cloudflare_waiting_room.%[1]s.id
+  event_start_time = "%[4]s"
+  event_end_time   = "%[5]s"
+}
+
+data "cloudflare_waiting_room_events" "%[1]s" {
+  zone_id         = "%[2]s"
+  waiting_room_id = cloudflare_waiting_room.%[1]s.id
+
+  depends_on = [cloudflare_waiting_room_event.%[1]s]
+}
+`, rnd, zoneID, domain, startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
+}
@@ -0,0 +1,73 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWaitingRooms() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareWaitingRoomsRead,
+		Schema:      dataSourceCloudflareWaitingRoomsSchema(),
+		Description: "Use this data source to look up all [Waiting Rooms](https://developers.cloudflare.com/waiting-room/) in a zone.",
+	}
+}
+
+func dataSourceCloudflareWaitingRoomsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Waiting Rooms for zone %s", zoneID))
+
+	var waitingRooms []cloudflare.WaitingRoom
+	err := listAllPages(ctx, client, fmt.Sprintf("/zones/%s/waiting_rooms", zoneID), nil, func(page json.RawMessage) (int, error) {
+		var rooms []cloudflare.WaitingRoom
+		if err := json.Unmarshal(page, &rooms); err != nil {
+			return 0, err
+		}
+		waitingRooms = append(waitingRooms, rooms...)
+		return len(rooms), nil
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Waiting Rooms: %w", err))
+	}
+
+	includeStatus := d.Get("include_status").(bool)
+	roomIDs := make([]string, 0, len(waitingRooms))
+	roomDetails := make([]interface{}, 0, len(waitingRooms))
+
+	for _, room := range waitingRooms {
+		// The status isn't part of the listing and costs a request per room.
+		var status string
+		if includeStatus {
+			roomStatus, err := client.WaitingRoomStatus(ctx, zoneID, room.ID)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error fetching status of Waiting Room %q: %w", room.ID, err))
+			}
+			status = roomStatus.Status
+		}
+
+		roomDetails = append(roomDetails, map[string]interface{}{
+			"id":     room.ID,
+			"name":   room.Name,
+			"host":   room.Host,
+			"path":   room.Path,
+			"status": status,
+		})
+		roomIDs = append(roomIDs, room.ID)
+	}
+
+	if err := d.Set("waiting_rooms", roomDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting waiting rooms: %w", err))
+	}
+
+	d.SetId(stringListChecksum(roomIDs))
+	return nil
+}
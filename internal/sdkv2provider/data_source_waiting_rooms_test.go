@@ -0,0 +1,107 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareWaitingRoomsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := fmt.Sprintf("data.cloudflare_waiting_rooms.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWaitingRoomsDataSourceConfig(rnd, zoneID, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "waiting_rooms.*", map[string]string{
+						"name": "waiting_room_" + rnd,
+						"host": "www." + domain,
+						"path": "/" + rnd,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(name, "waiting_rooms.*.id", "cloudflare_waiting_room."+rnd, "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareWaitingRoomsReadStatus(t *testing.T) {
+	for name, includeStatus := range map[string]bool{"without status": false, "with status": true} {
+		t.Run(name, func(t *testing.T) {
+			var statusRequests int
+
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/zones/0da42c8d2132a9ddaf714f9e7c920711/waiting_rooms":
+					if r.URL.Query().Get("page") != "1" {
+						testclient.WriteResult(w, []cloudflare.WaitingRoom{})
+						return
+					}
+					testclient.WriteResult(w, []cloudflare.WaitingRoom{
+						{ID: "699d98642c564d2e855e9661899b7252", Name: "shop", Host: "shop.example.com", Path: "/"},
+						{ID: "0c8d2a1e5f3b4a6c9d7e8f1a2b3c4d5e", Name: "tickets", Host: "tickets.example.com", Path: "/sale"},
+					})
+				case "/zones/0da42c8d2132a9ddaf714f9e7c920711/waiting_rooms/699d98642c564d2e855e9661899b7252/status",
+					"/zones/0da42c8d2132a9ddaf714f9e7c920711/waiting_rooms/0c8d2a1e5f3b4a6c9d7e8f1a2b3c4d5e/status":
+					statusRequests++
+					testclient.WriteResult(w, cloudflare.WaitingRoomStatus{Status: "queueing"})
+				default:
+					t.Errorf("unexpected request to %s", r.URL.Path)
+				}
+			}))
+
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareWaitingRoomsSchema(), map[string]interface{}{
+				consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+				"include_status":       includeStatus,
+			})
+
+			diags := dataSourceCloudflareWaitingRoomsRead(context.Background(), d, client)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, 2, d.Get("waiting_rooms.#"))
+			assert.Equal(t, "tickets.example.com", d.Get("waiting_rooms.1.host"))
+			if includeStatus {
+				assert.Equal(t, 2, statusRequests)
+				assert.Equal(t, "queueing", d.Get("waiting_rooms.0.status"))
+			} else {
+				assert.Zero(t, statusRequests)
+				assert.Empty(t, d.Get("waiting_rooms.0.status"))
+			}
+		})
+	}
+}
+
+func testAccCloudflareWaitingRoomsDataSourceConfig(rnd, zoneID, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_waiting_room" "%[1]s" {
+  name                 = "waiting_room_%[1]s"
+  zone_id              = "%[2]s"
+  host                 = "www.%[3]s"
+  path                 = "/%[1]s"
+  new_users_per_minute = 400
+  total_active_users   = 405
+}
+
+data "cloudflare_waiting_rooms" "%[1]s" {
+  zone_id = "%[2]s"
+
+  depends_on = [cloudflare_waiting_room.%[1]s]
+}
+`, rnd, zoneID, domain)
+}
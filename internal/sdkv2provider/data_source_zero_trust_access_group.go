@@ -0,0 +1,78 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessGroup() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccessGroupSchema(),
+		ReadContext: dataSourceCloudflareAccessGroupRead,
+		Description: heredoc.Doc(`
+			Use this data source to look up a single Access group of an
+			account or zone by name, such as to reference it by ID in Access
+			policies.
+		`),
+	}
+}
+
+func dataSourceCloudflareAccessGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Looking up Access group %q for %s %s", name, identifier.Type, identifier.Value))
+
+	accessGroups, err := listAccessGroups(ctx, client, identifier)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Access groups: %w", err))
+	}
+
+	var matches []cloudflare.AccessGroup
+	for _, group := range accessGroups {
+		if group.Name == name {
+			matches = append(matches, group)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.Errorf("no Access group named %q found in %s %s", name, identifier.Type, identifier.Value)
+	case 1:
+	default:
+		groupIDs := make([]string, 0, len(matches))
+		for _, group := range matches {
+			groupIDs = append(groupIDs, group.ID)
+		}
+		return diag.Errorf("found %d Access groups named %q (%s), the name must match exactly one group", len(matches), name, strings.Join(groupIDs, ", "))
+	}
+
+	group := matches[0]
+
+	if err := d.Set("include", TransformAccessGroupForSchema(ctx, group.Include)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set include attribute: %w", err))
+	}
+
+	if err := d.Set("exclude", TransformAccessGroupForSchema(ctx, group.Exclude)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set exclude attribute: %w", err))
+	}
+
+	if err := d.Set("require", TransformAccessGroupForSchema(ctx, group.Require)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set require attribute: %w", err))
+	}
+
+	d.SetId(group.ID)
+	return nil
+}
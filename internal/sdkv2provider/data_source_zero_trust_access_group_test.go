@@ -0,0 +1,109 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAccessGroupDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_zero_trust_access_group.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "id", "cloudflare_access_group."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "include.0.email.0", "test@example.com"),
+					resource.TestCheckResourceAttr(name, "require.0.email_domain.0", "example.com"),
+					resource.TestCheckResourceAttr(name, "exclude.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareAccessGroupRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/0da42c8d2132a9ddaf714f9e7c920711/access/groups", r.URL.Path)
+
+		testclient.WriteResultWithInfo(w, json.RawMessage(`[
+			{"id": "699d98642c564d2e855e9661899b7252", "name": "engineering", "include": [{"email": {"email": "test@example.com"}}], "require": [{"email_domain": {"domain": "example.com"}}]},
+			{"id": "3ac4b5e6d9ef4c7fb6b1a0ce1e4b2a13", "name": "duplicate", "include": [{"everyone": {}}]},
+			{"id": "58c1a4a7e04a4a2c8bb4e6f4a0f2d9b1", "name": "duplicate", "include": [{"everyone": {}}]}
+		]`), cloudflare.ResultInfo{Page: 1, PerPage: 50, TotalPages: 1, Count: 3, Total: 3})
+	}))
+
+	testCases := map[string]struct {
+		name string
+		err  string
+	}{
+		"match":     {name: "engineering"},
+		"not found": {name: "missing", err: `no Access group named "missing" found in zone 0da42c8d2132a9ddaf714f9e7c920711`},
+		"ambiguous": {name: "duplicate", err: `found 2 Access groups named "duplicate" (3ac4b5e6d9ef4c7fb6b1a0ce1e4b2a13, 58c1a4a7e04a4a2c8bb4e6f4a0f2d9b1), the name must match exactly one group`},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessGroupSchema(), map[string]interface{}{
+				consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+				"name":                 tc.name,
+			})
+
+			diags := dataSourceCloudflareAccessGroupRead(context.Background(), d, client)
+
+			if tc.err != "" {
+				assert.True(t, diags.HasError())
+				assert.Equal(t, tc.err, diags[0].Summary)
+				return
+			}
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, "699d98642c564d2e855e9661899b7252", d.Id())
+			assert.Equal(t, "test@example.com", d.Get("include.0.email.0"))
+			assert.Equal(t, "example.com", d.Get("require.0.email_domain.0"))
+			assert.Equal(t, 0, d.Get("exclude.#"))
+		})
+	}
+}
+
+func testAccCloudflareAccessGroupDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_group" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+
+  include {
+    email = ["test@example.com"]
+  }
+
+  require {
+    email_domain = ["example.com"]
+  }
+}
+
+data "cloudflare_zero_trust_access_group" "%[1]s" {
+  account_id = "%[2]s"
+  name       = cloudflare_access_group.%[1]s.name
+}
+`, rnd, accountID)
+}
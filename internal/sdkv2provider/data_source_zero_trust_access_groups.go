@@ -0,0 +1,102 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessGroupsPerPage is the page size used when listing Access groups.
+const accessGroupsPerPage = 50
+
+func dataSourceCloudflareAccessGroups() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccessGroupsSchema(),
+		ReadContext: dataSourceCloudflareAccessGroupsRead,
+		Description: heredoc.Doc(`
+			Use this data source to look up Access groups of an account or
+			zone, such as to reference an existing group by name in Access
+			policies.
+		`),
+	}
+}
+
+func dataSourceCloudflareAccessGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Access groups for %s %s", identifier.Type, identifier.Value))
+
+	accessGroups, err := listAccessGroups(ctx, client, identifier)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Access groups: %w", err))
+	}
+
+	name, filterByName := d.GetOk("name")
+
+	groupIDs := make([]string, 0, len(accessGroups))
+	groupDetails := make([]interface{}, 0, len(accessGroups))
+
+	for _, group := range accessGroups {
+		if filterByName && group.Name != name.(string) {
+			continue
+		}
+
+		groupDetails = append(groupDetails, map[string]interface{}{
+			"id":      group.ID,
+			"name":    group.Name,
+			"include": TransformAccessGroupForSchema(ctx, group.Include),
+			"exclude": TransformAccessGroupForSchema(ctx, group.Exclude),
+			"require": TransformAccessGroupForSchema(ctx, group.Require),
+		})
+		groupIDs = append(groupIDs, group.ID)
+	}
+
+	if filterByName && len(groupIDs) > 1 {
+		return diag.Errorf("found %d Access groups named %q (%s), the name must match exactly one group", len(groupIDs), name, strings.Join(groupIDs, ", "))
+	}
+
+	if err := d.Set("groups", groupDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting Access groups: %w", err))
+	}
+
+	d.SetId(stringListChecksum(groupIDs))
+	return nil
+}
+
+// listAccessGroups requests every page of the Access groups of an account or
+// zone.
+func listAccessGroups(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier) ([]cloudflare.AccessGroup, error) {
+	var accessGroups []cloudflare.AccessGroup
+
+	for page := 1; ; page++ {
+		pageOpts := cloudflare.PaginationOptions{Page: page, PerPage: accessGroupsPerPage}
+
+		var groups []cloudflare.AccessGroup
+		var resultInfo cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			groups, resultInfo, err = client.AccessGroups(ctx, identifier.Value, pageOpts)
+		} else {
+			groups, resultInfo, err = client.ZoneLevelAccessGroups(ctx, identifier.Value, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		accessGroups = append(accessGroups, groups...)
+
+		if page >= resultInfo.TotalPages {
+			return accessGroups, nil
+		}
+	}
+}
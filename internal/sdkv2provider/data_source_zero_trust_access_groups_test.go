@@ -0,0 +1,127 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAccessGroupsDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_zero_trust_access_groups.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessGroupsDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "groups.#", "1"),
+					resource.TestCheckResourceAttrPair(name, "groups.0.id", "cloudflare_access_group."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "groups.0.name", rnd),
+					resource.TestCheckResourceAttr(name, "groups.0.include.0.email.0", "test@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareAccessGroupsRead(t *testing.T) {
+	total := accessGroupsPerPage + 2
+	var requestedPages []string
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/access/groups", r.URL.Path)
+		assert.Equal(t, strconv.Itoa(accessGroupsPerPage), r.URL.Query().Get("per_page"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+		var groups []map[string]interface{}
+		for i := (page - 1) * accessGroupsPerPage; i < total && i < page*accessGroupsPerPage; i++ {
+			name := fmt.Sprintf("group-%d", i)
+			if i >= accessGroupsPerPage {
+				name = "duplicate"
+			}
+			groups = append(groups, map[string]interface{}{
+				"id":      strconv.Itoa(i),
+				"name":    name,
+				"include": []interface{}{map[string]interface{}{"email": map[string]interface{}{"email": "test@example.com"}}},
+			})
+		}
+
+		testclient.WriteResultWithInfo(w, groups, cloudflare.ResultInfo{Page: page, PerPage: accessGroupsPerPage, TotalPages: 2, Count: len(groups), Total: total})
+	}))
+
+	t.Run("all groups", func(t *testing.T) {
+		requestedPages = nil
+		d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessGroupsSchema(), map[string]interface{}{
+			consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+		})
+
+		diags := dataSourceCloudflareAccessGroupsRead(context.Background(), d, client)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, []string{"1", "2"}, requestedPages)
+		assert.Equal(t, total, d.Get("groups.#"))
+		assert.Equal(t, "test@example.com", d.Get("groups.0.include.0.email.0"))
+	})
+
+	t.Run("by name", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessGroupsSchema(), map[string]interface{}{
+			consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+			"name":                    "group-3",
+		})
+
+		diags := dataSourceCloudflareAccessGroupsRead(context.Background(), d, client)
+
+		assert.False(t, diags.HasError())
+		assert.Equal(t, 1, d.Get("groups.#"))
+		assert.Equal(t, "3", d.Get("groups.0.id"))
+	})
+
+	t.Run("ambiguous name", func(t *testing.T) {
+		d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessGroupsSchema(), map[string]interface{}{
+			consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+			"name":                    "duplicate",
+		})
+
+		diags := dataSourceCloudflareAccessGroupsRead(context.Background(), d, client)
+
+		assert.True(t, diags.HasError())
+		assert.Contains(t, diags[0].Summary, `found 2 Access groups named "duplicate"`)
+	})
+}
+
+func testAccCloudflareAccessGroupsDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_group" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+
+  include {
+    email = ["test@example.com"]
+  }
+}
+
+data "cloudflare_zero_trust_access_groups" "%[1]s" {
+  account_id = "%[2]s"
+  name       = cloudflare_access_group.%[1]s.name
+}
+`, rnd, accountID)
+}
@@ -0,0 +1,93 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// accessServiceToken is an Access service token along with its duration,
+// which cloudflare.AccessServiceToken doesn't include.
+type accessServiceToken struct {
+	cloudflare.AccessServiceToken
+	Duration string `json:"duration,omitempty"`
+}
+
+func dataSourceCloudflareAccessServiceTokens() *schema.Resource {
+	return &schema.Resource{
+		Schema:      dataSourceCloudflareAccessServiceTokensSchema(),
+		ReadContext: dataSourceCloudflareAccessServiceTokensRead,
+		Description: heredoc.Doc(`
+			Use this data source to look up all Access service tokens of an
+			account or zone, such as to audit them or to reference them in
+			Access policies. Client secrets are never returned.
+		`),
+	}
+}
+
+func dataSourceCloudflareAccessServiceTokensRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	identifier, err := initIdentifier(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Access service tokens for %s %s", identifier.Type, identifier.Value))
+
+	tokens, err := listAccessServiceTokens(ctx, client, identifier)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Access service tokens: %w", err))
+	}
+
+	tokenIDs := make([]string, 0, len(tokens))
+	tokenDetails := make([]interface{}, 0, len(tokens))
+
+	for _, token := range tokens {
+		expiresAt := ""
+		if token.ExpiresAt != nil {
+			expiresAt = token.ExpiresAt.Format(time.RFC3339)
+		}
+
+		tokenDetails = append(tokenDetails, map[string]interface{}{
+			"id":         token.ID,
+			"name":       token.Name,
+			"client_id":  token.ClientID,
+			"expires_at": expiresAt,
+			"duration":   token.Duration,
+		})
+		tokenIDs = append(tokenIDs, token.ID)
+	}
+
+	if err := d.Set("service_tokens", tokenDetails); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting service tokens: %w", err))
+	}
+
+	d.SetId(stringListChecksum(tokenIDs))
+	return nil
+}
+
+// listAccessServiceTokens requests every page of the Access service tokens
+// of an account or zone.
+func listAccessServiceTokens(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier) ([]accessServiceToken, error) {
+	var tokens []accessServiceToken
+	err := listAllPages(ctx, client, fmt.Sprintf("/%ss/%s/access/service_tokens", identifier.Type, identifier.Value), nil, func(page json.RawMessage) (int, error) {
+		var pageTokens []accessServiceToken
+		if err := json.Unmarshal(page, &pageTokens); err != nil {
+			return 0, err
+		}
+		tokens = append(tokens, pageTokens...)
+		return len(pageTokens), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
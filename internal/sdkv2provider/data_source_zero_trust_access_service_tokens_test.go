@@ -0,0 +1,98 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAccessServiceTokensDataSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("data.cloudflare_zero_trust_access_service_tokens.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessServiceTokensDataSourceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "service_tokens.*", map[string]string{
+						"name": rnd,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(name, "service_tokens.*.id", "cloudflare_access_service_token."+rnd, "id"),
+					resource.TestCheckTypeSetElemAttrPair(name, "service_tokens.*.client_id", "cloudflare_access_service_token."+rnd, "client_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceCloudflareAccessServiceTokensReadPaginates(t *testing.T) {
+	total := listAllPagesPerPage + 2
+	var requestedPages []string
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/access/service_tokens", r.URL.Path)
+		assert.Equal(t, strconv.Itoa(listAllPagesPerPage), r.URL.Query().Get("per_page"))
+
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+
+		var tokens []map[string]interface{}
+		for i := (page - 1) * listAllPagesPerPage; i < total && i < page*listAllPagesPerPage; i++ {
+			tokens = append(tokens, map[string]interface{}{
+				"id":         strconv.Itoa(i),
+				"name":       fmt.Sprintf("token-%d", i),
+				"client_id":  fmt.Sprintf("%d.access", i),
+				"expires_at": "2030-01-01T00:00:00Z",
+				"duration":   "8760h",
+			})
+		}
+
+		testclient.WriteResult(w, tokens)
+	}))
+
+	d := schema.TestResourceDataRaw(t, dataSourceCloudflareAccessServiceTokensSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+	})
+
+	diags := dataSourceCloudflareAccessServiceTokensRead(context.Background(), d, client)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{"1", "2", "3"}, requestedPages)
+	assert.Equal(t, total, d.Get("service_tokens.#"))
+	assert.Equal(t, fmt.Sprintf("token-%d", total-1), d.Get(fmt.Sprintf("service_tokens.%d.name", total-1)))
+	assert.Equal(t, "0.access", d.Get("service_tokens.0.client_id"))
+	assert.Equal(t, "2030-01-01T00:00:00Z", d.Get("service_tokens.0.expires_at"))
+	assert.Equal(t, "8760h", d.Get("service_tokens.0.duration"))
+}
+
+func testAccCloudflareAccessServiceTokensDataSourceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_service_token" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+
+data "cloudflare_zero_trust_access_service_tokens" "%[1]s" {
+  account_id = "%[2]s"
+
+  depends_on = [cloudflare_access_service_token.%[1]s]
+}
+`, rnd, accountID)
+}
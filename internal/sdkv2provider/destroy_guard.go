@@ -0,0 +1,65 @@
+package sdkv2provider
+
+import (
+	"context"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// destroyGuard refuses to delete resources once the provider has been
+// configured with prevent_destroy_override. It gates every resource of a
+// provider instance, unlike the per-resource prevent_destroy lifecycle
+// argument.
+type destroyGuard struct {
+	enabled bool
+}
+
+// wrap replaces the delete function of r with one that consults the guard
+// before deleting anything.
+func (g *destroyGuard) wrap(name string, r *schema.Resource) {
+	if r.DeleteContext != nil {
+		r.DeleteContext = g.guardContext(name, r.DeleteContext)
+	}
+
+	if r.DeleteWithoutTimeout != nil {
+		r.DeleteWithoutTimeout = g.guardContext(name, r.DeleteWithoutTimeout)
+	}
+
+	if r.Delete != nil {
+		del := r.Delete
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			if g.enabled {
+				return g.error(name, d)
+			}
+			return del(d, meta)
+		}
+	}
+}
+
+func (g *destroyGuard) guardContext(name string, del schema.DeleteContextFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		if g.enabled {
+			return diag.FromErr(g.error(name, d))
+		}
+		return del(ctx, d, meta)
+	}
+}
+
+func (g *destroyGuard) error(name string, d *schema.ResourceData) error {
+	return utils.PreventDestroyError(name, d.Id())
+}
+
+// preventDestroyOverrideEnabled returns whether prevent_destroy_override is
+// enabled in the provider configuration or the environment. A value in the
+// configuration, including false, takes precedence over the environment, the
+// same as in the framework provider.
+func preventDestroyOverrideEnabled(d *schema.ResourceData) (bool, error) {
+	if v, ok := d.GetOkExists(consts.PreventDestroyOverrideSchemaKey); ok {
+		return v.(bool), nil
+	}
+
+	return utils.PreventDestroyOverrideFromEnv()
+}
@@ -0,0 +1,78 @@
+package sdkv2provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDestroyGuard(t *testing.T) {
+	testCases := map[string]struct {
+		enabled         bool
+		expectedDeleted bool
+	}{
+		"disabled deletes": {enabled: false, expectedDeleted: true},
+		"enabled refuses":  {enabled: true, expectedDeleted: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			deleted := false
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+				DeleteContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+					deleted = true
+					return nil
+				},
+			}
+
+			guard := &destroyGuard{enabled: tc.enabled}
+			guard.wrap("cloudflare_zone", r)
+
+			d := r.TestResourceData()
+			d.SetId("0da42c8d2132a9ddaf714f9e7c920711")
+
+			diags := r.DeleteContext(context.Background(), d, nil)
+			assert.Equal(t, tc.enabled, diags.HasError())
+			assert.Equal(t, tc.expectedDeleted, deleted)
+			if tc.enabled {
+				assert.Equal(t, `refusing to delete cloudflare_zone "0da42c8d2132a9ddaf714f9e7c920711": prevent_destroy_override is enabled in the provider configuration`, diags[0].Summary)
+			}
+		})
+	}
+}
+
+func TestPreventDestroyOverrideEnabled(t *testing.T) {
+	testCases := map[string]struct {
+		config   map[string]interface{}
+		env      string
+		expected bool
+		err      bool
+	}{
+		"unset":            {config: map[string]interface{}{}, expected: false},
+		"provider setting": {config: map[string]interface{}{"prevent_destroy_override": true}, expected: true},
+		"environment":      {config: map[string]interface{}{}, env: "true", expected: true},
+		"setting over env": {config: map[string]interface{}{"prevent_destroy_override": false}, env: "true", expected: false},
+		"invalid env":      {config: map[string]interface{}{}, env: "yes please", err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("CLOUDFLARE_PREVENT_DESTROY_OVERRIDE", tc.env)
+
+			d := schema.TestResourceDataRaw(t, New("dev")().Schema, tc.config)
+
+			enabled, err := preventDestroyOverrideEnabled(d)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, enabled)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/utils"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// cfDiagFromErr is a drop-in replacement for diag.FromErr that keeps the
+// details of Cloudflare API errors found anywhere in the chain of err. The
+// error codes, error type and ray ID end up in the diagnostic detail, see
+// utils.APIErrorDetail.
+func cfDiagFromErr(err error) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+
+	detail := utils.APIErrorDetail(err)
+	if detail == "" {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  err.Error(),
+			Detail:   detail,
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCfDiagFromErr(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("cf-ray", "7f1234567890abcd-LHR")
+		testclient.WriteErrors(w, http.StatusBadRequest,
+			cloudflare.ResponseInfo{Code: 81057, Message: "Record already exists."},
+			cloudflare.ResponseInfo{Code: 1004, Message: "DNS Validation Error"},
+		)
+	}))
+
+	_, err := client.Raw(context.Background(), http.MethodPost, "/zones/zone/dns_records", nil, nil)
+	assert.Error(t, err)
+
+	diags := cfDiagFromErr(fmt.Errorf("error creating DNS record: %w", err))
+	assert.Len(t, diags, 1)
+	assert.Equal(t, diag.Error, diags[0].Severity)
+	assert.Equal(t, "error creating DNS record: Record already exists. (81057), DNS Validation Error (1004)", diags[0].Summary)
+	assert.Equal(t, "Cloudflare error codes: 81057, 1004\nCloudflare error type: request\nCloudflare ray ID: 7f1234567890abcd-LHR", diags[0].Detail)
+}
+
+func TestCfDiagFromErrWithoutAPIError(t *testing.T) {
+	assert.Nil(t, cfDiagFromErr(nil))
+	assert.Equal(t, diag.FromErr(errors.New("boom")), cfDiagFromErr(errors.New("boom")))
+}
@@ -13,8 +13,8 @@ func TestAccCloudflareRecord_ImportBasic(t *testing.T) {
 	rnd := generateRandomResourceName()
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, rnd, rnd),
@@ -37,8 +37,8 @@ func TestAccCloudflareRecord_ImportSRV(t *testing.T) {
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigSRV(zoneID, rnd, domain),
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -143,7 +144,7 @@ func New(version string) func() *schema.Provider {
 				consts.AccountIDSchemaKey: {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Description: fmt.Sprintf("Configure API client to always use a specific account. Alternatively, can be configured using the `%s` environment variable.", consts.AccountIDEnvVarKey),
+					Description: fmt.Sprintf("Configure API client to always use a specific account. Account level resources that don't configure their own `account_id` use this account. Alternatively, can be configured using the `%s` environment variable.", consts.AccountIDEnvVarKey),
 					Deprecated:  "Use resource specific `account_id` attributes instead.",
 				},
 
@@ -158,124 +159,240 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					Description: fmt.Sprintf("Configure the base path used by the API client. Alternatively, can be configured using the `%s` environment variable.", consts.APIBasePathEnvVarKey),
 				},
+
+				consts.APIBaseURLSchemaKey: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: fmt.Sprintf("Configure the full base URL used by the API client, for example `https://api.cloudflare.com/client/v4`. Takes precedence over `%s` and `%s`. Alternatively, can be configured using the `%s` environment variable.", consts.APIHostnameSchemaKey, consts.APIBasePathSchemaKey, consts.APIBaseURLEnvVarKey),
+				},
+
+				consts.UserAgentOperatorSuffixSchemaKey: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  fmt.Sprintf("A value to append to the HTTP User Agent for all API calls. Useful for identifying the team or product making the requests when raising Cloudflare support tickets. Must only contain printable ASCII characters. Alternatively, can be configured using the `%s` environment variable.", consts.UserAgentOperatorSuffixEnvVarKey),
+					ValidateFunc: validation.StringMatch(utils.UserAgentOperatorSuffixRegexp, "user agent operator suffix must only contain printable ASCII characters"),
+				},
+
+				consts.NonRetryableErrorCodesSchemaKey: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeInt},
+					Description: fmt.Sprintf("List of Cloudflare API error codes that should not be retried. By default, requests that fail with a server error (5xx) are retried according to `retries`, `min_backoff` and `max_backoff`; server errors containing any of these codes fail immediately instead. Rate limited requests are always retried. Alternatively, can be configured using the `%s` environment variable as a comma separated list.", consts.NonRetryableErrorCodesEnvVarKey),
+				},
+
+				consts.PreventDestroyOverrideSchemaKey: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: fmt.Sprintf("Whether to refuse to delete any resource managed by the provider, including resources that would be replaced. Deleting a resource returns an error instead of calling the API, protecting against a runaway `terraform destroy`. Alternatively, can be configured using the `%s` environment variable.", consts.PreventDestroyOverrideEnvVarKey),
+				},
+
+				consts.ExactContentDiffsSchemaKey: {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: fmt.Sprintf("Whether to compare Worker script content and Workers KV values byte for byte. By default, differences in the trailing whitespace of Worker scripts and in the formatting of JSON encoded Workers KV values are ignored. Alternatively, can be configured using the `%s` environment variable.", consts.ExactContentDiffsEnvVarKey),
+				},
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
-				"cloudflare_access_identity_provider":    dataSourceCloudflareAccessIdentityProvider(),
-				"cloudflare_account_roles":               dataSourceCloudflareAccountRoles(),
-				"cloudflare_accounts":                    dataSourceCloudflareAccounts(),
-				"cloudflare_api_token_permission_groups": dataSourceCloudflareApiTokenPermissionGroups(),
-				"cloudflare_devices":                     dataSourceCloudflareDevices(),
-				"cloudflare_ip_ranges":                   dataSourceCloudflareIPRanges(),
-				"cloudflare_load_balancer_pools":         dataSourceCloudflareLoadBalancerPools(),
-				"cloudflare_origin_ca_root_certificate":  dataSourceCloudflareOriginCARootCertificate(),
-				"cloudflare_record":                      dataSourceCloudflareRecord(),
-				"cloudflare_waf_groups":                  dataSourceCloudflareWAFGroups(),
-				"cloudflare_waf_packages":                dataSourceCloudflareWAFPackages(),
-				"cloudflare_waf_rules":                   dataSourceCloudflareWAFRules(),
-				"cloudflare_zone_dnssec":                 dataSourceCloudflareZoneDNSSEC(),
-				"cloudflare_zone":                        dataSourceCloudflareZone(),
-				"cloudflare_zones":                       dataSourceCloudflareZones(),
+				"cloudflare_access_ca_certificate":                dataSourceCloudflareAccessCACertificate(),
+				"cloudflare_access_identity_provider":             dataSourceCloudflareAccessIdentityProvider(),
+				"cloudflare_access_organization":                  dataSourceCloudflareAccessOrganization(),
+				"cloudflare_account_roles":                        dataSourceCloudflareAccountRoles(),
+				"cloudflare_accounts":                             dataSourceCloudflareAccounts(),
+				"cloudflare_api_shield_operations":                dataSourceCloudflareAPIShieldOperations(),
+				"cloudflare_api_token_permission_groups":          dataSourceCloudflareApiTokenPermissionGroups(),
+				"cloudflare_d1_databases":                         dataSourceCloudflareD1Databases(),
+				"cloudflare_devices":                              dataSourceCloudflareDevices(),
+				"cloudflare_firewall_rules":                       dataSourceCloudflareFirewallRules(),
+				"cloudflare_gateway_app_types":                    dataSourceCloudflareGatewayAppTypes(),
+				"cloudflare_gre_tunnel":                           dataSourceCloudflareGRETunnel(),
+				"cloudflare_ip_ranges":                            dataSourceCloudflareIPRanges(),
+				"cloudflare_ipsec_tunnel":                         dataSourceCloudflareIPsecTunnel(),
+				"cloudflare_list":                                 dataSourceCloudflareList(),
+				"cloudflare_lists":                                dataSourceCloudflareLists(),
+				"cloudflare_load_balancer_pools":                  dataSourceCloudflareLoadBalancerPools(),
+				"cloudflare_notification_policy_available_alerts": dataSourceCloudflareNotificationPolicyAvailableAlerts(),
+				"cloudflare_observatory_pages":                    dataSourceCloudflareObservatoryPages(),
+				"cloudflare_origin_ca_root_certificate":           dataSourceCloudflareOriginCARootCertificate(),
+				"cloudflare_page_shield_connections":              dataSourceCloudflarePageShieldConnections(),
+				"cloudflare_page_shield_scripts":                  dataSourceCloudflarePageShieldScripts(),
+				"cloudflare_record":                               dataSourceCloudflareRecord(),
+				"cloudflare_teams_proxy_endpoints":                dataSourceCloudflareTeamsProxyEndpoints(),
+				"cloudflare_waf_groups":                           dataSourceCloudflareWAFGroups(),
+				"cloudflare_waf_packages":                         dataSourceCloudflareWAFPackages(),
+				"cloudflare_waf_rules":                            dataSourceCloudflareWAFRules(),
+				"cloudflare_waiting_room_events":                  dataSourceCloudflareWaitingRoomEvents(),
+				"cloudflare_waiting_rooms":                        dataSourceCloudflareWaitingRooms(),
+				"cloudflare_zero_trust_access_group":              dataSourceCloudflareAccessGroup(),
+				"cloudflare_zero_trust_access_groups":             dataSourceCloudflareAccessGroups(),
+				"cloudflare_zero_trust_access_service_tokens":     dataSourceCloudflareAccessServiceTokens(),
+				"cloudflare_zone_dnssec":                          dataSourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone":                                 dataSourceCloudflareZone(),
+				"cloudflare_zones":                                dataSourceCloudflareZones(),
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
-				"cloudflare_access_application":                     resourceCloudflareAccessApplication(),
-				"cloudflare_access_bookmark":                        resourceCloudflareAccessBookmark(),
-				"cloudflare_access_ca_certificate":                  resourceCloudflareAccessCACertificate(),
-				"cloudflare_access_group":                           resourceCloudflareAccessGroup(),
-				"cloudflare_access_identity_provider":               resourceCloudflareAccessIdentityProvider(),
-				"cloudflare_access_keys_configuration":              resourceCloudflareAccessKeysConfiguration(),
-				"cloudflare_access_mutual_tls_certificate":          resourceCloudflareAccessMutualTLSCertificate(),
-				"cloudflare_access_organization":                    resourceCloudflareAccessOrganization(),
-				"cloudflare_access_policy":                          resourceCloudflareAccessPolicy(),
-				"cloudflare_access_rule":                            resourceCloudflareAccessRule(),
-				"cloudflare_access_service_token":                   resourceCloudflareAccessServiceToken(),
-				"cloudflare_account_member":                         resourceCloudflareAccountMember(),
-				"cloudflare_account":                                resourceCloudflareAccount(),
-				"cloudflare_api_shield":                             resourceCloudflareAPIShield(),
-				"cloudflare_api_token":                              resourceCloudflareApiToken(),
-				"cloudflare_argo_tunnel":                            resourceCloudflareArgoTunnel(),
-				"cloudflare_argo":                                   resourceCloudflareArgo(),
-				"cloudflare_authenticated_origin_pulls_certificate": resourceCloudflareAuthenticatedOriginPullsCertificate(),
-				"cloudflare_authenticated_origin_pulls":             resourceCloudflareAuthenticatedOriginPulls(),
-				"cloudflare_byo_ip_prefix":                          resourceCloudflareBYOIPPrefix(),
-				"cloudflare_certificate_pack":                       resourceCloudflareCertificatePack(),
-				"cloudflare_custom_hostname_fallback_origin":        resourceCloudflareCustomHostnameFallbackOrigin(),
-				"cloudflare_custom_hostname":                        resourceCloudflareCustomHostname(),
-				"cloudflare_custom_pages":                           resourceCloudflareCustomPages(),
-				"cloudflare_custom_ssl":                             resourceCloudflareCustomSsl(),
-				"cloudflare_device_settings_policy":                 resourceCloudflareDeviceSettingsPolicy(),
-				"cloudflare_device_policy_certificates":             resourceCloudflareDevicePolicyCertificates(),
-				"cloudflare_device_posture_integration":             resourceCloudflareDevicePostureIntegration(),
-				"cloudflare_device_posture_rule":                    resourceCloudflareDevicePostureRule(),
-				"cloudflare_device_managed_networks":                resourceCloudflareDeviceManagedNetworks(),
-				"cloudflare_dlp_profile":                            resourceCloudflareDLPProfile(),
-				"cloudflare_email_routing_address":                  resourceCloudflareEmailRoutingAddress(),
-				"cloudflare_email_routing_catch_all":                resourceCloudflareEmailRoutingCatchAll(),
-				"cloudflare_email_routing_rule":                     resourceCloudflareEmailRoutingRule(),
-				"cloudflare_email_routing_settings":                 resourceCloudflareEmailRoutingSettings(),
-				"cloudflare_fallback_domain":                        resourceCloudflareFallbackDomain(),
-				"cloudflare_filter":                                 resourceCloudflareFilter(),
-				"cloudflare_firewall_rule":                          resourceCloudflareFirewallRule(),
-				"cloudflare_gre_tunnel":                             resourceCloudflareGRETunnel(),
-				"cloudflare_healthcheck":                            resourceCloudflareHealthcheck(),
-				"cloudflare_ip_list":                                resourceCloudflareIPList(),
-				"cloudflare_ipsec_tunnel":                           resourceCloudflareIPsecTunnel(),
-				"cloudflare_list":                                   resourceCloudflareList(),
-				"cloudflare_load_balancer_monitor":                  resourceCloudflareLoadBalancerMonitor(),
-				"cloudflare_load_balancer_pool":                     resourceCloudflareLoadBalancerPool(),
-				"cloudflare_load_balancer":                          resourceCloudflareLoadBalancer(),
-				"cloudflare_logpull_retention":                      resourceCloudflareLogpullRetention(),
-				"cloudflare_logpush_job":                            resourceCloudflareLogpushJob(),
-				"cloudflare_logpush_ownership_challenge":            resourceCloudflareLogpushOwnershipChallenge(),
-				"cloudflare_magic_firewall_ruleset":                 resourceCloudflareMagicFirewallRuleset(),
-				"cloudflare_managed_headers":                        resourceCloudflareManagedHeaders(),
-				"cloudflare_notification_policy_webhooks":           resourceCloudflareNotificationPolicyWebhook(),
-				"cloudflare_notification_policy":                    resourceCloudflareNotificationPolicy(),
-				"cloudflare_origin_ca_certificate":                  resourceCloudflareOriginCACertificate(),
-				"cloudflare_page_rule":                              resourceCloudflarePageRule(),
-				"cloudflare_pages_domain":                           resourceCloudflarePagesDomain(),
-				"cloudflare_pages_project":                          resourceCloudflarePagesProject(),
-				"cloudflare_rate_limit":                             resourceCloudflareRateLimit(),
-				"cloudflare_record":                                 resourceCloudflareRecord(),
-				"cloudflare_ruleset":                                resourceCloudflareRuleset(),
-				"cloudflare_spectrum_application":                   resourceCloudflareSpectrumApplication(),
-				"cloudflare_split_tunnel":                           resourceCloudflareSplitTunnel(),
-				"cloudflare_static_route":                           resourceCloudflareStaticRoute(),
-				"cloudflare_teams_account":                          resourceCloudflareTeamsAccount(),
-				"cloudflare_teams_list":                             resourceCloudflareTeamsList(),
-				"cloudflare_teams_location":                         resourceCloudflareTeamsLocation(),
-				"cloudflare_teams_proxy_endpoint":                   resourceCloudflareTeamsProxyEndpoint(),
-				"cloudflare_tiered_cache":                           resourceCloudflareTieredCache(),
-				"cloudflare_tunnel_config":                          resourceCloudflareTunnelConfig(),
-				"cloudflare_teams_rule":                             resourceCloudflareTeamsRule(),
-				"cloudflare_total_tls":                              resourceCloudflareTotalTLS(),
-				"cloudflare_tunnel_route":                           resourceCloudflareTunnelRoute(),
-				"cloudflare_tunnel_virtual_network":                 resourceCloudflareTunnelVirtualNetwork(),
-				"cloudflare_url_normalization_settings":             resourceCloudflareURLNormalizationSettings(),
-				"cloudflare_user_agent_blocking_rule":               resourceCloudflareUserAgentBlockingRules(),
-				"cloudflare_waf_group":                              resourceCloudflareWAFGroup(),
-				"cloudflare_waf_override":                           resourceCloudflareWAFOverride(),
-				"cloudflare_waf_package":                            resourceCloudflareWAFPackage(),
-				"cloudflare_waf_rule":                               resourceCloudflareWAFRule(),
-				"cloudflare_waiting_room_event":                     resourceCloudflareWaitingRoomEvent(),
-				"cloudflare_waiting_room_rules":                     resourceCloudflareWaitingRoomRules(),
-				"cloudflare_waiting_room":                           resourceCloudflareWaitingRoom(),
-				"cloudflare_web3_hostname":                          resourceCloudflareWeb3Hostname(),
-				"cloudflare_worker_cron_trigger":                    resourceCloudflareWorkerCronTrigger(),
-				"cloudflare_worker_route":                           resourceCloudflareWorkerRoute(),
-				"cloudflare_worker_script":                          resourceCloudflareWorkerScript(),
-				"cloudflare_workers_kv_namespace":                   resourceCloudflareWorkersKVNamespace(),
-				"cloudflare_workers_kv":                             resourceCloudflareWorkerKV(),
-				"cloudflare_zone_cache_variants":                    resourceCloudflareZoneCacheVariants(),
-				"cloudflare_zone_dnssec":                            resourceCloudflareZoneDNSSEC(),
-				"cloudflare_zone_lockdown":                          resourceCloudflareZoneLockdown(),
-				"cloudflare_zone_settings_override":                 resourceCloudflareZoneSettingsOverride(),
-				"cloudflare_zone":                                   resourceCloudflareZone(),
+				"cloudflare_access_application":                                     resourceCloudflareAccessApplication(),
+				"cloudflare_access_bookmark":                                        resourceCloudflareAccessBookmark(),
+				"cloudflare_access_ca_certificate":                                  resourceCloudflareAccessCACertificate(),
+				"cloudflare_access_group":                                           resourceCloudflareAccessGroup(),
+				"cloudflare_access_identity_provider":                               resourceCloudflareAccessIdentityProvider(),
+				"cloudflare_access_keys_configuration":                              resourceCloudflareAccessKeysConfiguration(),
+				"cloudflare_access_mutual_tls_certificate":                          resourceCloudflareAccessMutualTLSCertificate(),
+				"cloudflare_access_organization":                                    resourceCloudflareAccessOrganization(),
+				"cloudflare_access_policy":                                          resourceCloudflareAccessPolicy(),
+				"cloudflare_access_rule":                                            resourceCloudflareAccessRule(),
+				"cloudflare_access_service_token":                                   resourceCloudflareAccessServiceToken(),
+				"cloudflare_account_member":                                         resourceCloudflareAccountMember(),
+				"cloudflare_account":                                                resourceCloudflareAccount(),
+				"cloudflare_address_map":                                            resourceCloudflareAddressMap(),
+				"cloudflare_api_shield":                                             resourceCloudflareAPIShield(),
+				"cloudflare_api_shield_operation":                                   resourceCloudflareAPIShieldOperation(),
+				"cloudflare_api_shield_schema":                                      resourceCloudflareAPIShieldSchema(),
+				"cloudflare_api_token":                                              resourceCloudflareApiToken(),
+				"cloudflare_argo_tunnel":                                            resourceCloudflareArgoTunnel(),
+				"cloudflare_argo":                                                   resourceCloudflareArgo(),
+				"cloudflare_authenticated_origin_pulls_certificate":                 resourceCloudflareAuthenticatedOriginPullsCertificate(),
+				"cloudflare_authenticated_origin_pulls":                             resourceCloudflareAuthenticatedOriginPulls(),
+				"cloudflare_bot_management":                                         resourceCloudflareBotManagement(),
+				"cloudflare_byo_ip_prefix":                                          resourceCloudflareBYOIPPrefix(),
+				"cloudflare_byo_ip_prefix_delegation":                               resourceCloudflareBYOIPPrefixDelegation(),
+				"cloudflare_certificate_pack":                                       resourceCloudflareCertificatePack(),
+				"cloudflare_cloud_connector_rules":                                  resourceCloudflareCloudConnectorRules(),
+				"cloudflare_content_scanning":                                       resourceCloudflareContentScanning(),
+				"cloudflare_content_scanning_expression":                            resourceCloudflareContentScanningExpression(),
+				"cloudflare_custom_hostname_fallback_origin":                        resourceCloudflareCustomHostnameFallbackOrigin(),
+				"cloudflare_custom_hostname":                                        resourceCloudflareCustomHostname(),
+				"cloudflare_custom_pages":                                           resourceCloudflareCustomPages(),
+				"cloudflare_custom_ssl":                                             resourceCloudflareCustomSsl(),
+				"cloudflare_d1_database":                                            resourceCloudflareD1Database(),
+				"cloudflare_device_settings_policy":                                 resourceCloudflareDeviceSettingsPolicy(),
+				"cloudflare_device_policy_certificates":                             resourceCloudflareDevicePolicyCertificates(),
+				"cloudflare_device_posture_integration":                             resourceCloudflareDevicePostureIntegration(),
+				"cloudflare_device_posture_rule":                                    resourceCloudflareDevicePostureRule(),
+				"cloudflare_device_managed_networks":                                resourceCloudflareDeviceManagedNetworks(),
+				"cloudflare_email_routing_address":                                  resourceCloudflareEmailRoutingAddress(),
+				"cloudflare_email_routing_catch_all":                                resourceCloudflareEmailRoutingCatchAll(),
+				"cloudflare_email_routing_rule":                                     resourceCloudflareEmailRoutingRule(),
+				"cloudflare_email_routing_settings":                                 resourceCloudflareEmailRoutingSettings(),
+				"cloudflare_fallback_domain":                                        resourceCloudflareFallbackDomain(),
+				"cloudflare_filter":                                                 resourceCloudflareFilter(),
+				"cloudflare_firewall_rule":                                          resourceCloudflareFirewallRule(),
+				"cloudflare_gateway_certificate":                                    resourceCloudflareGatewayCertificate(),
+				"cloudflare_gre_tunnel":                                             resourceCloudflareGRETunnel(),
+				"cloudflare_healthcheck":                                            resourceCloudflareHealthcheck(),
+				"cloudflare_hyperdrive_config":                                      resourceCloudflareHyperdriveConfig(),
+				"cloudflare_ip_list":                                                resourceCloudflareIPList(),
+				"cloudflare_ipsec_tunnel":                                           resourceCloudflareIPsecTunnel(),
+				"cloudflare_leaked_credential_check":                                resourceCloudflareLeakedCredentialCheck(),
+				"cloudflare_leaked_credential_check_rule":                           resourceCloudflareLeakedCredentialCheckRule(),
+				"cloudflare_list":                                                   resourceCloudflareList(),
+				"cloudflare_load_balancer_monitor":                                  resourceCloudflareLoadBalancerMonitor(),
+				"cloudflare_load_balancer_pool":                                     resourceCloudflareLoadBalancerPool(),
+				"cloudflare_load_balancer":                                          resourceCloudflareLoadBalancer(),
+				"cloudflare_logpull_retention":                                      resourceCloudflareLogpullRetention(),
+				"cloudflare_logpush_job":                                            resourceCloudflareLogpushJob(),
+				"cloudflare_logpush_ownership_challenge":                            resourceCloudflareLogpushOwnershipChallenge(),
+				"cloudflare_magic_firewall_ruleset":                                 resourceCloudflareMagicFirewallRuleset(),
+				"cloudflare_magic_network_monitoring_configuration":                 resourceCloudflareMagicNetworkMonitoringConfiguration(),
+				"cloudflare_magic_network_monitoring_rule":                          resourceCloudflareMagicNetworkMonitoringRule(),
+				"cloudflare_managed_headers":                                        resourceCloudflareManagedHeaders(),
+				"cloudflare_mtls_certificate":                                       resourceCloudflareMTLSCertificate(),
+				"cloudflare_notification_policy_webhooks":                           resourceCloudflareNotificationPolicyWebhook(),
+				"cloudflare_notification_policy":                                    resourceCloudflareNotificationPolicy(),
+				"cloudflare_observatory_scheduled_test":                             resourceCloudflareObservatoryScheduledTest(),
+				"cloudflare_origin_ca_certificate":                                  resourceCloudflareOriginCACertificate(),
+				"cloudflare_page_rule":                                              resourceCloudflarePageRule(),
+				"cloudflare_page_shield_settings":                                   resourceCloudflarePageShieldSettings(),
+				"cloudflare_pages_domain":                                           resourceCloudflarePagesDomain(),
+				"cloudflare_pages_project":                                          resourceCloudflarePagesProject(),
+				"cloudflare_rate_limit":                                             resourceCloudflareRateLimit(),
+				"cloudflare_ruleset":                                                resourceCloudflareRuleset(),
+				"cloudflare_spectrum_application":                                   resourceCloudflareSpectrumApplication(),
+				"cloudflare_split_tunnel":                                           resourceCloudflareSplitTunnel(),
+				"cloudflare_static_route":                                           resourceCloudflareStaticRoute(),
+				"cloudflare_stream_live_input":                                      resourceCloudflareStreamLiveInput(),
+				"cloudflare_teams_account":                                          resourceCloudflareTeamsAccount(),
+				"cloudflare_teams_list":                                             resourceCloudflareTeamsList(),
+				"cloudflare_teams_location":                                         resourceCloudflareTeamsLocation(),
+				"cloudflare_teams_proxy_endpoint":                                   resourceCloudflareTeamsProxyEndpoint(),
+				"cloudflare_tiered_cache":                                           resourceCloudflareTieredCache(),
+				"cloudflare_tunnel_config":                                          resourceCloudflareTunnelConfig(),
+				"cloudflare_teams_rule":                                             resourceCloudflareTeamsRule(),
+				"cloudflare_total_tls":                                              resourceCloudflareTotalTLS(),
+				"cloudflare_tunnel_route":                                           resourceCloudflareTunnelRoute(),
+				"cloudflare_tunnel_virtual_network":                                 resourceCloudflareTunnelVirtualNetwork(),
+				"cloudflare_url_normalization_settings":                             resourceCloudflareURLNormalizationSettings(),
+				"cloudflare_user_agent_blocking_rule":                               resourceCloudflareUserAgentBlockingRules(),
+				"cloudflare_waf_group":                                              resourceCloudflareWAFGroup(),
+				"cloudflare_waf_override":                                           resourceCloudflareWAFOverride(),
+				"cloudflare_waf_package":                                            resourceCloudflareWAFPackage(),
+				"cloudflare_waf_rule":                                               resourceCloudflareWAFRule(),
+				"cloudflare_waiting_room_event":                                     resourceCloudflareWaitingRoomEvent(),
+				"cloudflare_waiting_room_rules":                                     resourceCloudflareWaitingRoomRules(),
+				"cloudflare_waiting_room":                                           resourceCloudflareWaitingRoom(),
+				"cloudflare_web3_hostname":                                          resourceCloudflareWeb3Hostname(),
+				"cloudflare_web_analytics_rule":                                     resourceCloudflareWebAnalyticsRule(),
+				"cloudflare_web_analytics_site":                                     resourceCloudflareWebAnalyticsSite(),
+				"cloudflare_worker_cron_trigger":                                    resourceCloudflareWorkerCronTrigger(),
+				"cloudflare_worker_route":                                           resourceCloudflareWorkerRoute(),
+				"cloudflare_worker_script":                                          resourceCloudflareWorkerScript(),
+				"cloudflare_worker_secret":                                          resourceCloudflareWorkerSecret(),
+				"cloudflare_workers_for_platforms_dispatch_namespace":               resourceCloudflareWorkersForPlatformsDispatchNamespace(),
+				"cloudflare_workers_kv_namespace":                                   resourceCloudflareWorkersKVNamespace(),
+				"cloudflare_workers_kv":                                             resourceCloudflareWorkerKV(),
+				"cloudflare_zero_trust_device_custom_profile":                       resourceCloudflareDeviceCustomProfile(),
+				"cloudflare_zero_trust_device_custom_profile_local_domain_fallback": resourceCloudflareDeviceCustomProfileLocalDomainFallback(),
+				"cloudflare_zero_trust_device_settings":                             resourceCloudflareZeroTrustDeviceSettings(),
+				"cloudflare_zero_trust_dex_test":                                    resourceCloudflareZeroTrustDexTest(),
+				"cloudflare_zero_trust_gateway_policy":                              resourceCloudflareZeroTrustGatewayPolicy(),
+				"cloudflare_zero_trust_tunnel_cloudflared":                          resourceCloudflareZeroTrustTunnelCloudflared(),
+				"cloudflare_zero_trust_tunnel_cloudflared_config":                   resourceCloudflareTunnelConfig(),
+				"cloudflare_zone_cache_reserve":                                     resourceCloudflareZoneCacheReserve(),
+				"cloudflare_zone_cache_variants":                                    resourceCloudflareZoneCacheVariants(),
+				"cloudflare_zone_dnssec":                                            resourceCloudflareZoneDNSSEC(),
+				"cloudflare_zone_lockdown":                                          resourceCloudflareZoneLockdown(),
+				"cloudflare_zone_setting":                                           resourceCloudflareZoneSetting(),
+				"cloudflare_zone_settings_override":                                 resourceCloudflareZoneSettingsOverride(),
+				"cloudflare_zone":                                                   resourceCloudflareZone(),
 			},
 		}
 
-		p.ConfigureContextFunc = configure(version, p)
+		guard := &destroyGuard{}
+		fallback := &accountIDFallback{}
+		for name, r := range p.ResourcesMap {
+			guard.wrap(name, r)
+			fallback.wrap(name, r)
+		}
+
+		contentDiffs := &contentDiffSuppression{}
+		contentDiffs.wrap(p.ResourcesMap["cloudflare_worker_script"].Schema["content"])
+		contentDiffs.wrap(p.ResourcesMap["cloudflare_workers_kv"].Schema["value"])
+
+		configureClient := configure(version, p)
+		p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+			enabled, err := preventDestroyOverrideEnabled(d)
+			if err != nil {
+				return nil, diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%q is not set correctly", consts.PreventDestroyOverrideSchemaKey),
+					Detail:   err.Error(),
+				}}
+			}
+			guard.enabled = enabled
+
+			exact, err := exactContentDiffsEnabled(d)
+			if err != nil {
+				return nil, diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%q is not set correctly", consts.ExactContentDiffsSchemaKey),
+					Detail:   err.Error(),
+				}}
+			}
+			contentDiffs.exact = exact
+
+			return configureClient(ctx, d)
+		}
 
 		return p
 	}
@@ -297,6 +414,7 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			accountID         string
 			baseHostname      string
 			basePath          string
+			apiBaseURL        string
 		)
 
 		if d.Get(consts.APIHostnameSchemaKey).(string) != "" {
@@ -310,7 +428,24 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		} else {
 			basePath = utils.GetDefaultFromEnv(consts.APIBasePathEnvVarKey, consts.APIBasePathDefault)
 		}
-		baseURL := cloudflare.BaseURL(fmt.Sprintf("https://%s%s", baseHostname, basePath))
+		apiBaseURL = fmt.Sprintf("https://%s%s", baseHostname, basePath)
+
+		if v, ok := d.GetOk(consts.APIBaseURLSchemaKey); ok {
+			apiBaseURL = v.(string)
+		} else if v := utils.GetDefaultFromEnv(consts.APIBaseURLEnvVarKey, ""); v != "" {
+			apiBaseURL = v
+		}
+
+		if err := utils.ValidateAPIBaseURL(apiBaseURL); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%q is not set correctly", consts.APIBaseURLSchemaKey),
+				Detail:   err.Error(),
+			})
+
+			return nil, diags
+		}
+		baseURL := cloudflare.BaseURL(apiBaseURL)
 
 		if _, ok := d.GetOk(consts.RPSSchemaKey); ok {
 			rps = int64(d.Get(consts.RPSSchemaKey).(int))
@@ -373,9 +508,49 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 
 		options = append(options, cloudflare.Debug(logging.IsDebugOrHigher()))
 
-		ua := fmt.Sprintf(consts.UserAgentDefault, p.TerraformVersion, meta.SDKVersionString(), version)
+		var uaSuffix string
+		if v, ok := d.GetOk(consts.UserAgentOperatorSuffixSchemaKey); ok {
+			uaSuffix = v.(string)
+		} else {
+			uaSuffix = utils.GetDefaultFromEnv(consts.UserAgentOperatorSuffixEnvVarKey, "")
+		}
+
+		ua, err := utils.BuildUserAgent(fmt.Sprintf(consts.UserAgentDefault, p.TerraformVersion, meta.SDKVersionString(), version), uaSuffix)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%q is not set correctly", consts.UserAgentOperatorSuffixSchemaKey),
+				Detail:   err.Error(),
+			})
+
+			return nil, diags
+		}
 		options = append(options, cloudflare.UserAgent(ua))
 
+		var nonRetryableErrorCodes []int
+		if v, ok := d.GetOk(consts.NonRetryableErrorCodesSchemaKey); ok {
+			for _, code := range v.([]interface{}) {
+				nonRetryableErrorCodes = append(nonRetryableErrorCodes, code.(int))
+			}
+		} else {
+			nonRetryableErrorCodes, err = utils.ParseErrorCodes(utils.GetDefaultFromEnv(consts.NonRetryableErrorCodesEnvVarKey, ""))
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%q is not set correctly", consts.NonRetryableErrorCodesSchemaKey),
+					Detail:   err.Error(),
+				})
+
+				return nil, diags
+			}
+		}
+
+		if len(nonRetryableErrorCodes) > 0 {
+			options = append(options, cloudflare.HTTPClient(&http.Client{
+				Transport: utils.NewNonRetryableErrorCodesTransport(nonRetryableErrorCodes, http.DefaultTransport),
+			}))
+		}
+
 		config := Config{Options: options}
 
 		if v, ok := d.GetOk(consts.APITokenSchemaKey); ok {
@@ -442,6 +617,14 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 		}
 
 		if accountID != "" {
+			if err := utils.ValidateAccountID(accountID); err != nil {
+				return nil, diag.Diagnostics{{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("%q is not set correctly", consts.AccountIDSchemaKey),
+					Detail:   err.Error(),
+				}}
+			}
+
 			tflog.Info(ctx, fmt.Sprintf("using specified account id %s in Cloudflare provider", accountID))
 			options = append(options, cloudflare.UsingAccount(accountID))
 		}
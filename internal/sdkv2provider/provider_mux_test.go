@@ -0,0 +1,10 @@
+package sdkv2provider_test
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/framework/provider"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/sdkv2provider"
+)
+
+func init() {
+	sdkv2provider.SetTestAccProtoV6ProviderFactories(provider.TestAccProtoV6ProviderFactories)
+}
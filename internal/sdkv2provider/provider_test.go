@@ -3,8 +3,10 @@ package sdkv2provider
 import (
 	"context"
 	"os"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -30,6 +32,12 @@ var (
 	// reattach.
 	providerFactories map[string]func() (*schema.Provider, error)
 
+	// testAccProtoV6ProviderFactories serve this provider muxed with the
+	// plugin framework provider, for configurations using resources which
+	// are served by the plugin framework such as cloudflare_record. The muxed
+	// server imports this package so it is set by provider_mux_test.go.
+	testAccProtoV6ProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
 	// Integration test account ID.
 	testAccCloudflareAccountID string = "f037e56e89293a057740de681ac9abbe"
 
@@ -52,6 +60,13 @@ func init() {
 		},
 	}
 }
+
+// SetTestAccProtoV6ProviderFactories sets the muxed provider factories used by
+// acceptance tests. It is only exported to the external test package.
+func SetTestAccProtoV6ProviderFactories(factories map[string]func() (tfprotov6.ProviderServer, error)) {
+	testAccProtoV6ProviderFactories = factories
+}
+
 func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = New("dev")()
 }
@@ -62,6 +77,33 @@ func TestProvider(t *testing.T) {
 	}
 }
 
+func TestProviderZeroTrustTunnelAliases(t *testing.T) {
+	p := New("dev")()
+
+	aliases := map[string]string{
+		"cloudflare_zero_trust_tunnel_cloudflared_config": "cloudflare_tunnel_config",
+	}
+
+	for alias, original := range aliases {
+		t.Run(alias, func(t *testing.T) {
+			a, o := p.ResourcesMap[alias], p.ResourcesMap[original]
+			if a == nil || o == nil {
+				t.Fatalf("both %s and %s must be registered", alias, original)
+			}
+
+			if !reflect.DeepEqual(a.CoreConfigSchema(), o.CoreConfigSchema()) {
+				t.Errorf("schema of %s differs from %s", alias, original)
+			}
+			if a.Description != o.Description || a.SchemaVersion != o.SchemaVersion {
+				t.Errorf("%s is not described the same as %s", alias, original)
+			}
+			if (a.Importer == nil) != (o.Importer == nil) || (a.CustomizeDiff == nil) != (o.CustomizeDiff == nil) {
+				t.Errorf("%s does not behave the same as %s", alias, original)
+			}
+		})
+	}
+}
+
 type preCheckFunc = func(*testing.T)
 
 func testAccPreCheck(t *testing.T) {
@@ -171,6 +213,32 @@ func testAccPreCheckBYOIPPrefix(t *testing.T) {
 	}
 }
 
+func testAccPreCheckBYOIPPrefixDelegation(t *testing.T) {
+	testAccPreCheckBYOIPPrefix(t)
+
+	for _, env := range []string{"CLOUDFLARE_BYO_IP_PREFIX_DELEGATION_CIDR", "CLOUDFLARE_BYO_IP_PREFIX_DELEGATED_ACCOUNT_ID"} {
+		if v := os.Getenv(env); v == "" {
+			t.Skipf("Skipping acceptance test as %s is not set", env)
+		}
+	}
+}
+
+func testAccPreCheckHyperdrive(t *testing.T) {
+	testAccPreCheckAccount(t)
+
+	for _, v := range []string{
+		"CLOUDFLARE_HYPERDRIVE_DATABASE_HOSTNAME",
+		"CLOUDFLARE_HYPERDRIVE_DATABASE_PORT",
+		"CLOUDFLARE_HYPERDRIVE_DATABASE_NAME",
+		"CLOUDFLARE_HYPERDRIVE_DATABASE_USER",
+		"CLOUDFLARE_HYPERDRIVE_DATABASE_PASSWORD",
+	} {
+		if os.Getenv(v) == "" {
+			t.Skipf("Skipping acceptance test as %s is not set", v)
+		}
+	}
+}
+
 func generateRandomResourceName() string {
 	return acctest.RandStringFromCharSet(10, acctest.CharSetAlpha)
 }
@@ -2,8 +2,10 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -76,6 +78,12 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 		return diag.FromErr(err)
 	}
 
+	if d.Get("fail_on_domain_conflict").(bool) && newAccessApplication.Domain != "" {
+		if err := checkAccessApplicationDomainConflict(ctx, client, identifier, newAccessApplication.Domain); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var accessApplication cloudflare.AccessApplication
 	if identifier.Type == AccountType {
 		accessApplication, err = client.CreateAccessApplication(ctx, identifier.Value, newAccessApplication)
@@ -88,6 +96,14 @@ func resourceCloudflareAccessApplicationCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(accessApplication.ID)
 
+	if _, ok := d.GetOk("policies"); ok {
+		policies := convertAccessApplicationPoliciesSchemaToStruct(d)
+		newAccessApplication.ID = accessApplication.ID
+		if err := updateAccessApplicationPolicies(ctx, client, identifier, newAccessApplication, policies); err != nil {
+			return diag.FromErr(fmt.Errorf("error attaching policies to Access Application %q: %w", accessApplication.ID, err))
+		}
+	}
+
 	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
@@ -143,6 +159,15 @@ func resourceCloudflareAccessApplicationRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("error setting Access Application SaaS app configuration: %w", saasConfigErr))
 	}
 
+	policies, err := accessApplicationPolicies(ctx, client, identifier, d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding policies for Access Application %q: %w", d.Id(), err))
+	}
+
+	if policiesErr := d.Set("policies", convertAccessApplicationPoliciesStructToSchema(policies)); policiesErr != nil {
+		return diag.FromErr(fmt.Errorf("error setting Access Application policies: %w", policiesErr))
+	}
+
 	return nil
 }
 
@@ -211,6 +236,13 @@ func resourceCloudflareAccessApplicationUpdate(ctx context.Context, d *schema.Re
 		return diag.FromErr(fmt.Errorf("failed to find Access Application ID in update response; resource was empty"))
 	}
 
+	if d.HasChange("policies") {
+		policies := convertAccessApplicationPoliciesSchemaToStruct(d)
+		if err := updateAccessApplicationPolicies(ctx, client, identifier, updatedAccessApplication, policies); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating policies for Access Application %q: %w", accessApplication.ID, err))
+		}
+	}
+
 	return resourceCloudflareAccessApplicationRead(ctx, d, meta)
 }
 
@@ -254,6 +286,7 @@ func resourceCloudflareAccessApplicationImport(ctx context.Context, d *schema.Re
 	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Access Application: id %s for account %s", accessApplicationID, accountID))
 
 	d.Set("account_id", accountID)
+	d.Set("fail_on_domain_conflict", false)
 	d.SetId(accessApplicationID)
 
 	readErr := resourceCloudflareAccessApplicationRead(ctx, d, meta)
@@ -263,3 +296,118 @@ func resourceCloudflareAccessApplicationImport(ctx context.Context, d *schema.Re
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// accessApplicationsPerPage is the page size used when listing Access
+// applications.
+const accessApplicationsPerPage = 50
+
+// listAccessApplications requests every page of the Access applications of an
+// account or zone.
+func listAccessApplications(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier) ([]cloudflare.AccessApplication, error) {
+	var accessApplications []cloudflare.AccessApplication
+
+	for page := 1; ; page++ {
+		pageOpts := cloudflare.PaginationOptions{Page: page, PerPage: accessApplicationsPerPage}
+
+		var apps []cloudflare.AccessApplication
+		var resultInfo cloudflare.ResultInfo
+		var err error
+		if identifier.Type == AccountType {
+			apps, resultInfo, err = client.AccessApplications(ctx, identifier.Value, pageOpts)
+		} else {
+			apps, resultInfo, err = client.ZoneLevelAccessApplications(ctx, identifier.Value, pageOpts)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		accessApplications = append(accessApplications, apps...)
+
+		if page >= resultInfo.TotalPages {
+			return accessApplications, nil
+		}
+	}
+}
+
+// checkAccessApplicationDomainConflict returns an error naming the existing
+// Access application that already protects domain. The API accepts
+// applications sharing a domain but only one of them ends up being enforced.
+func checkAccessApplicationDomainConflict(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, domain string) error {
+	apps, err := listAccessApplications(ctx, client, identifier)
+	if err != nil {
+		return fmt.Errorf("error listing Access Applications for %s %q: %w", identifier.Type, identifier.Value, err)
+	}
+
+	for _, app := range apps {
+		if strings.EqualFold(strings.TrimSuffix(app.Domain, "/"), strings.TrimSuffix(domain, "/")) {
+			return fmt.Errorf("domain %q is already used by Access Application %q (%s); import it or remove it before creating a new application for the domain", domain, app.Name, app.ID)
+		}
+	}
+
+	return nil
+}
+
+// accessApplicationPolicy is a reusable Access policy attached to an Access
+// Application along with its order of evaluation.
+type accessApplicationPolicy struct {
+	ID         string `json:"id"`
+	Precedence int    `json:"precedence,omitempty"`
+	Reusable   *bool  `json:"reusable,omitempty"`
+}
+
+func accessApplicationURI(identifier *AccessIdentifier, applicationID string) string {
+	return fmt.Sprintf("/%ss/%s/access/apps/%s", identifier.Type, identifier.Value, applicationID)
+}
+
+// accessApplicationPolicies fetches the policies attached to an Access
+// Application.
+func accessApplicationPolicies(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, applicationID string) ([]accessApplicationPolicy, error) {
+	res, err := client.Raw(ctx, http.MethodGet, accessApplicationURI(identifier, applicationID), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var app struct {
+		Policies []accessApplicationPolicy `json:"policies"`
+	}
+	if err := json.Unmarshal(res, &app); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal Access Application policies: %w", err)
+	}
+
+	return app.Policies, nil
+}
+
+// accessApplicationPoliciesUpdate is the payload used to replace the policies
+// attached to an Access Application. The application attributes are taken
+// from the configuration rather than echoed back from the API so read-only
+// fields are never sent.
+type accessApplicationPoliciesUpdate struct {
+	cloudflare.AccessApplication
+	Policies []accessApplicationPolicy `json:"policies"`
+}
+
+// updateAccessApplicationPolicies replaces the reusable policies attached to
+// an Access Application. Policies scoped to the application alone are managed
+// by `cloudflare_access_policy` and are kept attached as they are.
+func updateAccessApplicationPolicies(ctx context.Context, client *cloudflare.API, identifier *AccessIdentifier, app cloudflare.AccessApplication, policies []accessApplicationPolicy) error {
+	current, err := accessApplicationPolicies(ctx, client, identifier, app.ID)
+	if err != nil {
+		return err
+	}
+
+	payload := accessApplicationPoliciesUpdate{
+		AccessApplication: app,
+		Policies:          policies,
+	}
+	for _, policy := range current {
+		if policy.Reusable != nil && !*policy.Reusable {
+			payload.Policies = append(payload.Policies, accessApplicationPolicy{
+				ID:         policy.ID,
+				Precedence: policy.Precedence,
+			})
+		}
+	}
+
+	_, err = client.Raw(ctx, http.MethodPut, accessApplicationURI(identifier, app.ID), payload, nil)
+	return err
+}
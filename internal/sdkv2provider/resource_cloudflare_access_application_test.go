@@ -2,17 +2,22 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -131,6 +136,113 @@ func TestAccCloudflareAccessApplication_BasicAccount(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessApplication_DomainConflict(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigBasic(rnd, domain, AccessIdentifier{Type: AccountType, Value: accountID}),
+			},
+			{
+				Config:      testAccCloudflareAccessApplicationConfigDomainConflict(rnd, domain, accountID),
+				ExpectError: regexp.MustCompile(fmt.Sprintf(`domain "%s.%s" is already used by Access Application "%s"`, rnd, regexp.QuoteMeta(domain), rnd)),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareAccessApplicationCreateDomainConflict(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "the application should not be created")
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/access/apps", r.URL.Path)
+
+		switch r.URL.Query().Get("page") {
+		case "1":
+			testclient.WriteResultWithInfo(w, json.RawMessage(`[{"id":"e8d6b8f1-5fb5-4b0a-8c3b-7c4e0a8e5a11","name":"wiki","domain":"wiki.example.com"}]`), cloudflare.ResultInfo{Page: 1, PerPage: 1, Count: 1, Total: 2, TotalPages: 2})
+		default:
+			testclient.WriteResultWithInfo(w, json.RawMessage(`[{"id":"480f4f69-1a28-4fdd-9240-1ed29f0ac1db","name":"intranet","domain":"Intranet.example.com/"}]`), cloudflare.ResultInfo{Page: 2, PerPage: 1, Count: 1, Total: 2, TotalPages: 2})
+		}
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAccessApplicationSchema(), map[string]interface{}{
+		"account_id":              "f037e56e89293a057740de681ac9abbe",
+		"name":                    "intranet-v2",
+		"domain":                  "intranet.example.com",
+		"type":                    "self_hosted",
+		"fail_on_domain_conflict": true,
+	})
+
+	diags := resourceCloudflareAccessApplicationCreate(context.Background(), d, client)
+	if assert.True(t, diags.HasError()) {
+		assert.Equal(t, `domain "intranet.example.com" is already used by Access Application "intranet" (480f4f69-1a28-4fdd-9240-1ed29f0ac1db); import it or remove it before creating a new application for the domain`, diags[0].Summary)
+	}
+	assert.Empty(t, d.Id())
+}
+
+func TestUpdateAccessApplicationPolicies(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/access/apps/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", r.URL.Path)
+
+		switch r.Method {
+		case http.MethodGet:
+			testclient.WriteResult(w, json.RawMessage(`{
+				"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+				"aud": "737646a56ab1df6ec9bddc7e5ca84eaf3b0768850f3ffb5d74f1534911fe3893",
+				"created_at": "2023-01-01T00:00:00Z",
+				"name": "intranet",
+				"domain": "intranet.example.com",
+				"policies": [
+					{"id": "0b2d36ba-8bd6-4d8a-a1f9-3f2e1e0a7a11", "precedence": 1, "reusable": true},
+					{"id": "6e0e6d6c-3d1a-4d4c-9b76-6c2d1b0c9f22", "precedence": 2, "reusable": false}
+				]
+			}`))
+		case http.MethodPut:
+			var body map[string]interface{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.NotContains(t, body, "aud")
+			assert.NotContains(t, body, "created_at")
+			assert.Equal(t, "intranet", body["name"])
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"id": "9f1a3c2e-7c55-4e0e-8f43-2a9c8d1e4b33", "precedence": float64(1)},
+				map[string]interface{}{"id": "6e0e6d6c-3d1a-4d4c-9b76-6c2d1b0c9f22", "precedence": float64(2)},
+			}, body["policies"])
+			testclient.WriteResult(w, json.RawMessage(`{}`))
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	}))
+
+	app := cloudflare.AccessApplication{
+		ID:     "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Name:   "intranet",
+		Domain: "intranet.example.com",
+	}
+	identifier := &AccessIdentifier{Type: AccountType, Value: "f037e56e89293a057740de681ac9abbe"}
+	policies := []accessApplicationPolicy{{ID: "9f1a3c2e-7c55-4e0e-8f43-2a9c8d1e4b33", Precedence: 1}}
+
+	assert.NoError(t, updateAccessApplicationPolicies(context.Background(), client, identifier, app, policies))
+}
+
+func TestConvertAccessApplicationPoliciesStructToSchemaReusableOnly(t *testing.T) {
+	reusable, scoped := true, false
+	policies := []accessApplicationPolicy{
+		{ID: "legacy", Precedence: 1},
+		{ID: "scoped", Precedence: 2, Reusable: &scoped},
+		{ID: "reusable", Precedence: 3, Reusable: &reusable},
+	}
+
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"id": "reusable", "precedence": 3},
+	}, convertAccessApplicationPoliciesStructToSchema(policies))
+}
+
 func TestAccCloudflareAccessApplication_WithCORS(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
@@ -475,6 +587,44 @@ func TestAccCloudflareAccessApplication_WithAppLauncherVisible(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAccessApplication_WithReusablePolicies(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_access_application.%s", rnd)
+	policyA := fmt.Sprintf("cloudflare_access_policy.%s_a", rnd)
+	policyB := fmt.Sprintf("cloudflare_access_policy.%s_b", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAccessApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithPolicies(rnd, domain, accountID, "a", "b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "policies.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "policies.0.id", policyA, "id"),
+					resource.TestCheckResourceAttr(name, "policies.0.precedence", "1"),
+					resource.TestCheckResourceAttrPair(name, "policies.1.id", policyB, "id"),
+					resource.TestCheckResourceAttr(name, "policies.1.precedence", "2"),
+				),
+			},
+			{
+				Config: testAccCloudflareAccessApplicationConfigWithPolicies(rnd, domain, accountID, "b", "a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "policies.#", "2"),
+					resource.TestCheckResourceAttrPair(name, "policies.0.id", policyB, "id"),
+					resource.TestCheckResourceAttr(name, "policies.0.precedence", "1"),
+					resource.TestCheckResourceAttrPair(name, "policies.1.id", policyA, "id"),
+					resource.TestCheckResourceAttr(name, "policies.1.precedence", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareAccessApplicationConfigBasic(rnd string, domain string, identifier AccessIdentifier) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -488,6 +638,62 @@ resource "cloudflare_access_application" "%[1]s" {
 `, rnd, domain, identifier.Type, identifier.Value)
 }
 
+func testAccCloudflareAccessApplicationConfigDomainConflict(rnd, domain, accountID string) string {
+	return testAccCloudflareAccessApplicationConfigBasic(rnd, domain, AccessIdentifier{Type: AccountType, Value: accountID}) + fmt.Sprintf(`
+resource "cloudflare_access_application" "%[1]s_conflict" {
+  account_id              = "%[3]s"
+  name                    = "%[1]s-conflict"
+  domain                  = "%[1]s.%[2]s"
+  type                    = "self_hosted"
+  fail_on_domain_conflict = true
+
+  depends_on = [cloudflare_access_application.%[1]s]
+}
+`, rnd, domain, accountID)
+}
+
+func testAccCloudflareAccessApplicationConfigWithPolicies(rnd, domain, accountID, firstPolicy, secondPolicy string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_access_policy" "%[1]s_a" {
+  account_id = "%[3]s"
+  name       = "%[1]s-a"
+  decision   = "allow"
+  reusable   = true
+
+  include {
+    everyone = true
+  }
+}
+
+resource "cloudflare_access_policy" "%[1]s_b" {
+  account_id = "%[3]s"
+  name       = "%[1]s-b"
+  decision   = "allow"
+  reusable   = true
+
+  include {
+    everyone = true
+  }
+}
+
+resource "cloudflare_access_application" "%[1]s" {
+  account_id       = "%[3]s"
+  name             = "%[1]s"
+  domain           = "%[1]s.%[2]s"
+  type             = "self_hosted"
+  session_duration = "24h"
+
+  policies {
+    id = cloudflare_access_policy.%[1]s_%[4]s.id
+  }
+
+  policies {
+    id = cloudflare_access_policy.%[1]s_%[5]s.id
+  }
+}
+`, rnd, domain, accountID, firstPolicy, secondPolicy)
+}
+
 func testAccCloudflareAccessApplicationConfigWithCORS(rnd, zoneID, domain string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_access_application" "%[1]s" {
@@ -2,12 +2,15 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -68,9 +71,12 @@ func resourceCloudflareAccessPolicyRead(ctx context.Context, d *schema.ResourceD
 	}
 
 	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
+	switch {
+	case d.Get("reusable").(bool):
+		accessPolicy, err = reusableAccessPolicy(ctx, client, identifier.Value, d.Id())
+	case identifier.Type == AccountType:
 		accessPolicy, err = client.AccessPolicy(ctx, identifier.Value, appID, d.Id())
-	} else {
+	default:
 		accessPolicy, err = client.ZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
 	}
 	if err != nil {
@@ -142,10 +148,21 @@ func resourceCloudflareAccessPolicyCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	reusable := d.Get("reusable").(bool)
+	if reusable && identifier.Type != AccountType {
+		return diag.FromErr(fmt.Errorf("reusable Access Policies must be created with an account_id"))
+	}
+	if !reusable && appID == "" {
+		return diag.FromErr(fmt.Errorf("application_id is required unless the Access Policy is reusable"))
+	}
+
 	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
+	switch {
+	case reusable:
+		accessPolicy, err = createReusableAccessPolicy(ctx, client, identifier.Value, newAccessPolicy)
+	case identifier.Type == AccountType:
 		accessPolicy, err = client.CreateAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
-	} else {
+	default:
 		accessPolicy, err = client.CreateZoneLevelAccessPolicy(ctx, identifier.Value, appID, newAccessPolicy)
 	}
 	if err != nil {
@@ -177,9 +194,12 @@ func resourceCloudflareAccessPolicyUpdate(ctx context.Context, d *schema.Resourc
 	}
 
 	var accessPolicy cloudflare.AccessPolicy
-	if identifier.Type == AccountType {
+	switch {
+	case d.Get("reusable").(bool):
+		accessPolicy, err = updateReusableAccessPolicy(ctx, client, identifier.Value, updatedAccessPolicy)
+	case identifier.Type == AccountType:
 		accessPolicy, err = client.UpdateAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
-	} else {
+	default:
 		accessPolicy, err = client.UpdateZoneLevelAccessPolicy(ctx, identifier.Value, appID, updatedAccessPolicy)
 	}
 	if err != nil {
@@ -204,9 +224,12 @@ func resourceCloudflareAccessPolicyDelete(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	if identifier.Type == AccountType {
+	switch {
+	case d.Get("reusable").(bool):
+		_, err = client.Raw(ctx, http.MethodDelete, reusableAccessPolicyURI(identifier.Value, d.Id()), nil, nil)
+	case identifier.Type == AccountType:
 		err = client.DeleteAccessPolicy(ctx, identifier.Value, appID, d.Id())
-	} else {
+	default:
 		err = client.DeleteZoneLevelAccessPolicy(ctx, identifier.Value, appID, d.Id())
 	}
 	if err != nil {
@@ -221,12 +244,27 @@ func resourceCloudflareAccessPolicyDelete(ctx context.Context, d *schema.Resourc
 func resourceCloudflareAccessPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	attributes := strings.SplitN(d.Id(), "/", 4)
 
+	if len(attributes) == 3 && attributes[0] == string(AccountType) {
+		accountID, accessPolicyID := attributes[1], attributes[2]
+
+		tflog.Debug(ctx, fmt.Sprintf("Importing reusable Cloudflare Access Policy: account %q, accessPolicyID %q", accountID, accessPolicyID))
+
+		d.Set(consts.AccountIDSchemaKey, accountID)
+		d.Set("reusable", true)
+		d.SetId(accessPolicyID)
+
+		resourceCloudflareAccessPolicyRead(ctx, d, meta)
+
+		return []*schema.ResourceData{d}, nil
+	}
+
 	if len(attributes) != 4 {
 		return nil, fmt.Errorf(
-			"invalid id (%q) specified, should be in format %q or %q",
+			"invalid id (%q) specified, should be in format %q, %q or %q",
 			d.Id(),
 			"account/accountID/accessApplicationID/accessPolicyID",
 			"zone/zoneID/accessApplicationID/accessPolicyID",
+			"account/accountID/accessPolicyID",
 		)
 	}
 
@@ -287,3 +325,55 @@ func appendConditionalAccessPolicyFields(policy cloudflare.AccessPolicy, d *sche
 
 	return policy
 }
+
+// reusableAccessPolicyURI returns the endpoint for account level Access
+// Policies which are not bound to a single application.
+func reusableAccessPolicyURI(accountID, policyID string) string {
+	uri := fmt.Sprintf("/accounts/%s/access/policies", accountID)
+	if policyID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, policyID)
+	}
+	return uri
+}
+
+func reusableAccessPolicy(ctx context.Context, client *cloudflare.API, accountID, policyID string) (cloudflare.AccessPolicy, error) {
+	res, err := client.Raw(ctx, http.MethodGet, reusableAccessPolicyURI(accountID, policyID), nil, nil)
+	if err != nil {
+		return cloudflare.AccessPolicy{}, err
+	}
+
+	var policy cloudflare.AccessPolicy
+	if err := json.Unmarshal(res, &policy); err != nil {
+		return cloudflare.AccessPolicy{}, fmt.Errorf("failed to unmarshal reusable Access Policy: %w", err)
+	}
+
+	return policy, nil
+}
+
+func createReusableAccessPolicy(ctx context.Context, client *cloudflare.API, accountID string, policy cloudflare.AccessPolicy) (cloudflare.AccessPolicy, error) {
+	res, err := client.Raw(ctx, http.MethodPost, reusableAccessPolicyURI(accountID, ""), policy, nil)
+	if err != nil {
+		return cloudflare.AccessPolicy{}, err
+	}
+
+	var created cloudflare.AccessPolicy
+	if err := json.Unmarshal(res, &created); err != nil {
+		return cloudflare.AccessPolicy{}, fmt.Errorf("failed to unmarshal reusable Access Policy: %w", err)
+	}
+
+	return created, nil
+}
+
+func updateReusableAccessPolicy(ctx context.Context, client *cloudflare.API, accountID string, policy cloudflare.AccessPolicy) (cloudflare.AccessPolicy, error) {
+	res, err := client.Raw(ctx, http.MethodPut, reusableAccessPolicyURI(accountID, policy.ID), policy, nil)
+	if err != nil {
+		return cloudflare.AccessPolicy{}, err
+	}
+
+	var updated cloudflare.AccessPolicy
+	if err := json.Unmarshal(res, &updated); err != nil {
+		return cloudflare.AccessPolicy{}, fmt.Errorf("failed to unmarshal reusable Access Policy: %w", err)
+	}
+
+	return updated, nil
+}
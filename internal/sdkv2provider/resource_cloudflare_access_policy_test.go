@@ -3,6 +3,7 @@ package sdkv2provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -863,3 +864,87 @@ func testAccessPolicyExternalEvalautionConfig(resourceID, zone, accountID string
 
   `, resourceID, zone, accountID)
 }
+
+func TestAccCloudflareAccessPolicy_Reusable(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_access_policy." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccessPolicyReusableConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "reusable", "true"),
+					resource.TestCheckResourceAttr(name, "application_id", ""),
+					resource.TestCheckResourceAttr(name, "include.0.everyone", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("account/%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareAccessPolicy_ReusableWithApplicationID(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccessPolicyReusableWithApplicationIDConfig(rnd, accountID),
+				ExpectError: regexp.MustCompile(`"reusable": conflicts with application_id`),
+			},
+		},
+	})
+}
+
+func testAccessPolicyReusableConfig(resourceID, accountID string) string {
+	return fmt.Sprintf(`
+    resource "cloudflare_access_policy" "%[1]s" {
+      name       = "%[1]s"
+      account_id = "%[2]s"
+      decision   = "allow"
+      reusable   = true
+
+      include {
+        everyone = true
+      }
+    }
+
+  `, resourceID, accountID)
+}
+
+func testAccessPolicyReusableWithApplicationIDConfig(resourceID, accountID string) string {
+	return fmt.Sprintf(`
+    resource "cloudflare_access_policy" "%[1]s" {
+      application_id = "%[1]s"
+      name           = "%[1]s"
+      account_id     = "%[2]s"
+      decision       = "allow"
+      reusable       = true
+
+      include {
+        everyone = true
+      }
+    }
+
+  `, resourceID, accountID)
+}
@@ -2,11 +2,15 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -17,6 +21,17 @@ const (
 	accountTypeEnterprise = "enterprise"
 )
 
+// accountWithUnit extends cloudflare.Account with the tenant unit an
+// enterprise sub-account belongs to.
+type accountWithUnit struct {
+	cloudflare.Account
+	Unit *accountUnit `json:"unit,omitempty"`
+}
+
+type accountUnit struct {
+	ID string `json:"id"`
+}
+
 func resourceCloudflareAccount() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAccountSchema(),
@@ -42,19 +57,29 @@ func resourceCloudflareAccountCreate(ctx context.Context, d *schema.ResourceData
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Account: name %s", accountName))
 
-	account := cloudflare.Account{
-		Name: accountName,
-		Type: accountType,
-		Settings: &cloudflare.AccountSettings{
-			EnforceTwoFactor: twoFactor,
+	account := accountWithUnit{
+		Account: cloudflare.Account{
+			Name: accountName,
+			Type: accountType,
+			Settings: &cloudflare.AccountSettings{
+				EnforceTwoFactor: twoFactor,
+			},
 		},
 	}
-	acc, err := client.CreateAccount(ctx, account)
+	if unitID, ok := d.GetOk("unit.0.id"); ok {
+		account.Unit = &accountUnit{ID: unitID.(string)}
+	}
 
+	res, err := client.Raw(ctx, http.MethodPost, "/accounts", account, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating account %q: %w", accountName, err))
 	}
 
+	var acc accountWithUnit
+	if err := json.Unmarshal(res, &acc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal account %q: %w", accountName, err))
+	}
+
 	d.SetId(acc.ID)
 
 	return resourceCloudflareAccountRead(ctx, d, meta)
@@ -64,7 +89,7 @@ func resourceCloudflareAccountRead(ctx context.Context, d *schema.ResourceData,
 	client := meta.(*cloudflare.API)
 	accountID := d.Id()
 
-	foundAcc, _, err := client.Account(ctx, accountID)
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s", accountID), nil, nil)
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -75,11 +100,29 @@ func resourceCloudflareAccountRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error finding Account %q: %w", d.Id(), err))
 	}
 
+	var foundAcc accountWithUnit
+	if err := json.Unmarshal(res, &foundAcc); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Account %q: %w", d.Id(), err))
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("AccountDetails: %#v", foundAcc))
 
 	d.Set("name", foundAcc.Name)
 	d.Set("type", foundAcc.Type)
-	d.Set("enforce_twofactor", foundAcc.Settings.EnforceTwoFactor)
+	if foundAcc.Settings != nil {
+		d.Set("enforce_twofactor", foundAcc.Settings.EnforceTwoFactor)
+	}
+	if !foundAcc.CreatedOn.IsZero() {
+		d.Set("created_on", foundAcc.CreatedOn.Format(time.RFC3339Nano))
+	}
+
+	// The unit is not returned for every account so keep the one already in
+	// state when it is missing from the response.
+	if foundAcc.Unit != nil {
+		if err := d.Set("unit", []map[string]interface{}{{"id": foundAcc.Unit.ID}}); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to set unit: %w", err))
+		}
+	}
 
 	return nil
 }
@@ -88,6 +131,15 @@ func resourceCloudflareAccountUpdate(ctx context.Context, d *schema.ResourceData
 	client := meta.(*cloudflare.API)
 	accountID := d.Id()
 
+	if d.HasChange("unit") {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Account unit cannot be changed",
+			Detail:        fmt.Sprintf("Account %q was created in a tenant unit and cannot be moved to another one. Revert the change to `unit` or recreate the account.", accountID),
+			AttributePath: cty.GetAttrPath("unit"),
+		}}
+	}
+
 	accountName := d.Get("name").(string)
 	twoFactor := d.Get("enforce_twofactor").(bool)
 
@@ -99,10 +151,24 @@ func resourceCloudflareAccountUpdate(ctx context.Context, d *schema.ResourceData
 			EnforceTwoFactor: twoFactor,
 		},
 	}
+	if d.HasChange("type") {
+		updatedAcc.Type = d.Get("type").(string)
+	}
 
 	_, err := client.UpdateAccount(ctx, accountID, updatedAcc)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("%#v", err))
+
+		if d.HasChange("type") {
+			oldType, newType := d.GetChange("type")
+			return diag.Diagnostics{{
+				Severity:      diag.Error,
+				Summary:       "Account type cannot be changed",
+				Detail:        fmt.Sprintf("Changing the type of account %q from %q to %q was rejected by the API: %s. Account type changes are only permitted for some accounts; contact your account team or revert the change to `type`.", accountID, oldType, newType, err),
+				AttributePath: cty.GetAttrPath("type"),
+			}}
+		}
+
 		return diag.FromErr(fmt.Errorf("error updating Account %q: %w", d.Id(), err))
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -51,15 +52,10 @@ func resourceCloudflareAccountMemberRead(ctx context.Context, d *schema.Resource
 		return diag.FromErr(err)
 	}
 
-	var memberIDs []string
-	for _, role := range member.Roles {
-		memberIDs = append(memberIDs, role.ID)
-	}
-
 	d.Set("account_id", accountID)
 	d.Set("email_address", member.User.Email)
-	d.Set("role_ids", memberIDs)
 	d.Set("status", member.Status)
+	setAccountMemberAccess(d, member)
 	d.SetId(d.Id())
 
 	return nil
@@ -87,15 +83,9 @@ func resourceCloudflareAccountMemberDelete(ctx context.Context, d *schema.Resour
 
 func resourceCloudflareAccountMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	memberEmailAddress := d.Get("email_address").(string)
-	requestedMemberRoles := d.Get("role_ids").(*schema.Set).List()
 
 	client := meta.(*cloudflare.API)
 
-	var accountMemberRoleIDs []string
-	for _, roleID := range requestedMemberRoles {
-		accountMemberRoleIDs = append(accountMemberRoleIDs, roleID.(string))
-	}
-
 	var accountID string
 	if d.Get(consts.AccountIDSchemaKey).(string) != "" {
 		accountID = d.Get(consts.AccountIDSchemaKey).(string)
@@ -103,8 +93,26 @@ func resourceCloudflareAccountMemberCreate(ctx context.Context, d *schema.Resour
 		accountID = client.AccountID
 	}
 
-	status := d.Get("status").(string)
-	r, err := client.CreateAccountMemberWithStatus(ctx, accountID, memberEmailAddress, accountMemberRoleIDs, status)
+	params := cloudflare.CreateAccountMemberParams{
+		EmailAddress: memberEmailAddress,
+		Status:       d.Get("status").(string),
+	}
+
+	if roles, ok := d.GetOk("role_ids"); ok {
+		params.Roles = expandInterfaceToStringList(roles.(*schema.Set).List())
+	} else if roleNames, ok := d.GetOk("roles"); ok {
+		accountRoles, err := resolveAccountRoleNames(ctx, client, accountID, expandInterfaceToStringList(roleNames.(*schema.Set).List()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, role := range accountRoles {
+			params.Roles = append(params.Roles, role.ID)
+		}
+	} else {
+		params.Policies = expandAccountMemberPolicies(d.Get("policies").(*schema.Set).List())
+	}
+
+	r, err := client.CreateAccountMember(ctx, cloudflare.AccountIdentifier(accountID), params)
 
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Cloudflare account member: %w", err))
@@ -121,8 +129,6 @@ func resourceCloudflareAccountMemberCreate(ctx context.Context, d *schema.Resour
 
 func resourceCloudflareAccountMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
-	accountRoles := []cloudflare.AccountRole{}
-	memberRoles := d.Get("role_ids").(*schema.Set).List()
 
 	var accountID string
 	if d.Get(consts.AccountIDSchemaKey).(string) != "" {
@@ -131,12 +137,24 @@ func resourceCloudflareAccountMemberUpdate(ctx context.Context, d *schema.Resour
 		accountID = client.AccountID
 	}
 
-	for _, r := range memberRoles {
-		accountRole, _ := client.AccountRole(ctx, accountID, r.(string))
-		accountRoles = append(accountRoles, accountRole)
+	updatedAccountMember := cloudflare.AccountMember{}
+	if memberRoles, ok := d.GetOk("role_ids"); ok {
+		for _, r := range memberRoles.(*schema.Set).List() {
+			accountRole, err := client.AccountRole(ctx, accountID, r.(string))
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("failed to find account role %q: %w", r.(string), err))
+			}
+			updatedAccountMember.Roles = append(updatedAccountMember.Roles, accountRole)
+		}
+	} else if roleNames, ok := d.GetOk("roles"); ok {
+		accountRoles, err := resolveAccountRoleNames(ctx, client, accountID, expandInterfaceToStringList(roleNames.(*schema.Set).List()))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updatedAccountMember.Roles = accountRoles
+	} else {
+		updatedAccountMember.Policies = expandAccountMemberPolicies(d.Get("policies").(*schema.Set).List())
 	}
-
-	updatedAccountMember := cloudflare.AccountMember{Roles: accountRoles}
 	_, err := client.UpdateAccountMember(ctx, accountID, d.Id(), updatedAccountMember)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("failed to update Cloudflare account member: %w", err))
@@ -166,16 +184,125 @@ func resourceCloudflareAccountMemberImport(ctx context.Context, d *schema.Resour
 
 	tflog.Info(ctx, fmt.Sprintf("Found account member: %s", member.User.Email))
 
-	var memberIDs []string
-	for _, role := range member.Roles {
-		memberIDs = append(memberIDs, role.ID)
-	}
-
 	d.Set("account_id", accountID)
 	d.Set("email_address", member.User.Email)
-	d.Set("role_ids", memberIDs)
 	d.Set("status", member.Status)
+	setAccountMemberAccess(d, member)
 	d.SetId(accountMemberID)
 
 	return []*schema.ResourceData{d}, nil
 }
+
+// setAccountMemberAccess sets either the roles or the policies of the member
+// depending on which access model the member uses. Roles are stored by name
+// when the member was configured using `roles` and by ID otherwise.
+func setAccountMemberAccess(d *schema.ResourceData, member cloudflare.AccountMember) {
+	if len(member.Roles) > 0 {
+		if _, ok := d.GetOk("roles"); ok {
+			var memberRoleNames []string
+			for _, role := range member.Roles {
+				memberRoleNames = append(memberRoleNames, role.Name)
+			}
+			d.Set("roles", memberRoleNames)
+			d.Set("role_ids", nil)
+			d.Set("policies", nil)
+			return
+		}
+
+		var memberIDs []string
+		for _, role := range member.Roles {
+			memberIDs = append(memberIDs, role.ID)
+		}
+		d.Set("role_ids", memberIDs)
+		d.Set("roles", nil)
+		d.Set("policies", nil)
+		return
+	}
+
+	d.Set("role_ids", nil)
+	d.Set("roles", nil)
+	d.Set("policies", flattenAccountMemberPolicies(member.Policies))
+}
+
+// resolveAccountRoleNames looks up the account roles matching the provided
+// names, returning an error if any of the names do not exist in the account.
+func resolveAccountRoleNames(ctx context.Context, client *cloudflare.API, accountID string, names []string) ([]cloudflare.AccountRole, error) {
+	accountRoles, err := client.AccountRoles(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing account roles: %w", err)
+	}
+
+	return matchAccountRoleNames(accountRoles, names)
+}
+
+func matchAccountRoleNames(accountRoles []cloudflare.AccountRole, names []string) ([]cloudflare.AccountRole, error) {
+	rolesByName := make(map[string]cloudflare.AccountRole, len(accountRoles))
+	available := make([]string, 0, len(accountRoles))
+	for _, role := range accountRoles {
+		rolesByName[role.Name] = role
+		available = append(available, role.Name)
+	}
+	sort.Strings(available)
+	for i, name := range available {
+		available[i] = fmt.Sprintf("%q", name)
+	}
+
+	var result []cloudflare.AccountRole
+	for _, name := range names {
+		role, ok := rolesByName[name]
+		if !ok {
+			return nil, fmt.Errorf("account role %q not found, available roles are: %s", name, strings.Join(available, ", "))
+		}
+		result = append(result, role)
+	}
+
+	return result, nil
+}
+
+func expandAccountMemberPolicies(policies []interface{}) []cloudflare.Policy {
+	var result []cloudflare.Policy
+	for _, p := range policies {
+		policy := p.(map[string]interface{})
+
+		var permissionGroups []cloudflare.PermissionGroup
+		for _, id := range policy["permission_group_ids"].(*schema.Set).List() {
+			permissionGroups = append(permissionGroups, cloudflare.PermissionGroup{ID: id.(string)})
+		}
+
+		var resourceGroups []cloudflare.ResourceGroup
+		for _, id := range policy["resource_group_ids"].(*schema.Set).List() {
+			resourceGroups = append(resourceGroups, cloudflare.ResourceGroup{ID: id.(string)})
+		}
+
+		result = append(result, cloudflare.Policy{
+			Access:           policy["access"].(string),
+			PermissionGroups: permissionGroups,
+			ResourceGroups:   resourceGroups,
+		})
+	}
+
+	return result
+}
+
+func flattenAccountMemberPolicies(policies []cloudflare.Policy) []interface{} {
+	var result []interface{}
+	for _, policy := range policies {
+		var permissionGroupIDs []string
+		for _, pg := range policy.PermissionGroups {
+			permissionGroupIDs = append(permissionGroupIDs, pg.ID)
+		}
+
+		var resourceGroupIDs []string
+		for _, rg := range policy.ResourceGroups {
+			resourceGroupIDs = append(resourceGroupIDs, rg.ID)
+		}
+
+		result = append(result, map[string]interface{}{
+			"access":               policy.Access,
+			"permission_group_ids": permissionGroupIDs,
+			"resource_group_ids":   resourceGroupIDs,
+		})
+	}
+
+	return result
+}
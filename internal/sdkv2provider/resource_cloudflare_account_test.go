@@ -1,10 +1,16 @@
 package sdkv2provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareAccount_Basic(t *testing.T) {
@@ -92,6 +98,46 @@ func TestAccCloudflareAccount_2FAEnforced(t *testing.T) {
 	})
 }
 
+func TestAccountReadSetsTypeAndUnit(t *testing.T) {
+	testCases := map[string]struct {
+		unit         string
+		stateUnit    string
+		expectedUnit string
+	}{
+		"unit returned by the API": {
+			unit:         `,"unit":{"id":"c6c2e7bb8f1b4bd6a3e5a7a3e0a6b0e4"}`,
+			expectedUnit: "c6c2e7bb8f1b4bd6a3e5a7a3e0a6b0e4",
+		},
+		"unit kept from state": {
+			stateUnit:    "c6c2e7bb8f1b4bd6a3e5a7a3e0a6b0e4",
+			expectedUnit: "c6c2e7bb8f1b4bd6a3e5a7a3e0a6b0e4",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe", r.URL.Path)
+				testclient.WriteResult(w, json.RawMessage(fmt.Sprintf(`{"id":"f037e56e89293a057740de681ac9abbe","name":"example","type":"enterprise","created_on":"2023-01-02T03:04:05.123456Z","settings":{"enforce_twofactor":true}%s}`, tc.unit)))
+			}))
+
+			raw := map[string]interface{}{"name": "example"}
+			if tc.stateUnit != "" {
+				raw["unit"] = []interface{}{map[string]interface{}{"id": tc.stateUnit}}
+			}
+			d := schema.TestResourceDataRaw(t, resourceCloudflareAccountSchema(), raw)
+			d.SetId("f037e56e89293a057740de681ac9abbe")
+
+			diags := resourceCloudflareAccountRead(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, "enterprise", d.Get("type"))
+			assert.Equal(t, true, d.Get("enforce_twofactor"))
+			assert.Equal(t, "2023-01-02T03:04:05.123456Z", d.Get("created_on"))
+			assert.Equal(t, tc.expectedUnit, d.Get("unit.0.id"))
+		})
+	}
+}
+
 func testAccCheckCloudflareAccountWith2FA(rnd, name string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_account" "%[1]s" {
@@ -0,0 +1,278 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var addressMapMembershipKinds = []string{"account", "zone"}
+
+// addressMap is a BYOIP address map binding a set of IPs to the accounts and
+// zones that are members of it.
+type addressMap struct {
+	ID           string                 `json:"id,omitempty"`
+	Description  *string                `json:"description,omitempty"`
+	DefaultSNI   *string                `json:"default_sni,omitempty"`
+	Enabled      *bool                  `json:"enabled,omitempty"`
+	CanDelete    bool                   `json:"can_delete,omitempty"`
+	CanModifyIPs bool                   `json:"can_modify_ips,omitempty"`
+	IPs          []addressMapIP         `json:"ips,omitempty"`
+	Memberships  []addressMapMembership `json:"memberships,omitempty"`
+}
+
+type addressMapIP struct {
+	IP string `json:"ip"`
+}
+
+type addressMapMembership struct {
+	Identifier string `json:"identifier"`
+	Kind       string `json:"kind"`
+}
+
+// addressMapCreateRequest is the body used to create an address map. Unlike
+// the response, IPs are sent as plain strings.
+type addressMapCreateRequest struct {
+	Description *string                `json:"description,omitempty"`
+	DefaultSNI  *string                `json:"default_sni,omitempty"`
+	Enabled     *bool                  `json:"enabled"`
+	IPs         []string               `json:"ips,omitempty"`
+	Memberships []addressMapMembership `json:"memberships,omitempty"`
+}
+
+func resourceCloudflareAddressMap() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAddressMapSchema(),
+		CreateContext: resourceCloudflareAddressMapCreate,
+		ReadContext:   resourceCloudflareAddressMapRead,
+		UpdateContext: resourceCloudflareAddressMapUpdate,
+		DeleteContext: resourceCloudflareAddressMapDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAddressMapImport,
+		},
+		Description: heredoc.Doc(`
+			Provides the ability to manage BYOIP address maps. Address maps
+			bind IPs of your prefixes to the accounts and zones that are
+			allowed to use them.
+		`),
+	}
+}
+
+func resourceCloudflareAddressMapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	request := addressMapCreateRequest{
+		Enabled:     cloudflare.BoolPtr(d.Get("enabled").(bool)),
+		IPs:         expandAddressMapIPs(d.Get("ips").(*schema.Set)),
+		Memberships: expandAddressMapMemberships(d.Get("memberships").(*schema.Set)),
+	}
+	if description, ok := d.GetOk("description"); ok {
+		request.Description = cloudflare.StringPtr(description.(string))
+	}
+	if defaultSNI, ok := d.GetOk("default_sni"); ok {
+		request.DefaultSNI = cloudflare.StringPtr(defaultSNI.(string))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare address map for account %s", accountID))
+
+	res, err := client.Raw(ctx, http.MethodPost, addressMapURI(accountID, ""), request, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating address map for account %q: %w", accountID, err))
+	}
+
+	var created addressMap
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal address map: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareAddressMapRead(ctx, d, meta)
+}
+
+func resourceCloudflareAddressMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, addressMapURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Address map %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding address map %q: %w", d.Id(), err))
+	}
+
+	var addrMap addressMap
+	if err := json.Unmarshal(res, &addrMap); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal address map: %w", err))
+	}
+
+	d.Set("description", cloudflare.String(addrMap.Description))
+	d.Set("default_sni", cloudflare.String(addrMap.DefaultSNI))
+	d.Set("enabled", cloudflare.Bool(addrMap.Enabled))
+	d.Set("can_delete", addrMap.CanDelete)
+	d.Set("can_modify_ips", addrMap.CanModifyIPs)
+
+	if err := d.Set("ips", flattenAddressMapIPs(addrMap.IPs)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set ips: %w", err))
+	}
+
+	if err := d.Set("memberships", flattenAddressMapMemberships(addrMap.Memberships)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set memberships: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAddressMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare address map using ID: %s", d.Id()))
+
+	if d.HasChanges("description", "default_sni", "enabled") {
+		update := addressMap{
+			Description: cloudflare.StringPtr(d.Get("description").(string)),
+			DefaultSNI:  cloudflare.StringPtr(d.Get("default_sni").(string)),
+			Enabled:     cloudflare.BoolPtr(d.Get("enabled").(bool)),
+		}
+		if _, err := client.Raw(ctx, http.MethodPatch, addressMapURI(accountID, d.Id()), update, nil); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating address map %q: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("ips") {
+		o, n := d.GetChange("ips")
+		oldIPs, newIPs := o.(*schema.Set), n.(*schema.Set)
+
+		for _, ip := range expandAddressMapIPs(oldIPs.Difference(newIPs)) {
+			if _, err := client.Raw(ctx, http.MethodDelete, fmt.Sprintf("%s/ips/%s", addressMapURI(accountID, d.Id()), ip), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing IP %q from address map %q: %w", ip, d.Id(), err))
+			}
+		}
+
+		for _, ip := range expandAddressMapIPs(newIPs.Difference(oldIPs)) {
+			if _, err := client.Raw(ctx, http.MethodPut, fmt.Sprintf("%s/ips/%s", addressMapURI(accountID, d.Id()), ip), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding IP %q to address map %q: %w", ip, d.Id(), err))
+			}
+		}
+	}
+
+	if d.HasChange("memberships") {
+		o, n := d.GetChange("memberships")
+		oldMemberships, newMemberships := o.(*schema.Set), n.(*schema.Set)
+
+		for _, membership := range expandAddressMapMemberships(oldMemberships.Difference(newMemberships)) {
+			if _, err := client.Raw(ctx, http.MethodDelete, addressMapMembershipURI(accountID, d.Id(), membership), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error removing %s %q from address map %q: %w", membership.Kind, membership.Identifier, d.Id(), err))
+			}
+		}
+
+		for _, membership := range expandAddressMapMemberships(newMemberships.Difference(oldMemberships)) {
+			if _, err := client.Raw(ctx, http.MethodPut, addressMapMembershipURI(accountID, d.Id(), membership), nil, nil); err != nil {
+				return diag.FromErr(fmt.Errorf("error adding %s %q to address map %q: %w", membership.Kind, membership.Identifier, d.Id(), err))
+			}
+		}
+	}
+
+	return resourceCloudflareAddressMapRead(ctx, d, meta)
+}
+
+func resourceCloudflareAddressMapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare address map using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, addressMapURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting address map %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAddressMapImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/addressMapID\"", d.Id())
+	}
+
+	accountID, addressMapID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare address map: id %s for account %s", addressMapID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(addressMapID)
+
+	resourceCloudflareAddressMapRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAddressMapIPs(ips *schema.Set) []string {
+	result := make([]string, 0, ips.Len())
+	for _, ip := range ips.List() {
+		result = append(result, ip.(map[string]interface{})["ip"].(string))
+	}
+	return result
+}
+
+func flattenAddressMapIPs(ips []addressMapIP) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(ips))
+	for _, ip := range ips {
+		result = append(result, map[string]interface{}{"ip": ip.IP})
+	}
+	return result
+}
+
+func expandAddressMapMemberships(memberships *schema.Set) []addressMapMembership {
+	result := make([]addressMapMembership, 0, memberships.Len())
+	for _, m := range memberships.List() {
+		membership := m.(map[string]interface{})
+		result = append(result, addressMapMembership{
+			Identifier: membership["identifier"].(string),
+			Kind:       membership["kind"].(string),
+		})
+	}
+	return result
+}
+
+func flattenAddressMapMemberships(memberships []addressMapMembership) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(memberships))
+	for _, membership := range memberships {
+		result = append(result, map[string]interface{}{
+			"identifier": membership.Identifier,
+			"kind":       membership.Kind,
+		})
+	}
+	return result
+}
+
+func addressMapURI(accountID, addressMapID string) string {
+	uri := fmt.Sprintf("/accounts/%s/addressing/address_maps", accountID)
+	if addressMapID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, addressMapID)
+	}
+	return uri
+}
+
+// addressMapMembershipURI returns the endpoint adding or removing an account
+// or zone from an address map.
+func addressMapMembershipURI(accountID, addressMapID string, membership addressMapMembership) string {
+	return fmt.Sprintf("%s/%ss/%s", addressMapURI(accountID, addressMapID), membership.Kind, membership.Identifier)
+}
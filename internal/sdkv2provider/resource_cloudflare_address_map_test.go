@@ -0,0 +1,158 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareAddressMap_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("cloudflare_address_map.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckBYOIPPrefix(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareAddressMapDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAddressMapConfig(rnd, accountID, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "description", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+					resource.TestCheckResourceAttr(name, "can_delete", "true"),
+					resource.TestCheckResourceAttr(name, "can_modify_ips", "true"),
+					resource.TestCheckResourceAttr(name, "memberships.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "memberships.*", map[string]string{
+						"identifier": accountID,
+						"kind":       "account",
+					}),
+				),
+			},
+			{
+				Config: testAccCloudflareAddressMapConfig(rnd, accountID, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "memberships.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "memberships.*", map[string]string{
+						"identifier": zoneID,
+						"kind":       "zone",
+					}),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareAddressMapCreate(t *testing.T) {
+	var createBody map[string]interface{}
+	addrMap := `{"id":"055817b111884e0227e1be16a0be6ee0","description":"example","default_sni":"*.example.com","enabled":true,"can_delete":true,"can_modify_ips":true,"ips":[{"ip":"192.0.2.1","created_at":"2020-04-01T05:20:00.12345Z"}],"memberships":[{"identifier":"023e105f4ecef8ad9ca31a8372d0c353","kind":"zone","can_delete":true}]}`
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/addressing/address_maps", r.URL.Path)
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &createBody))
+		case http.MethodGet:
+			assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/addressing/address_maps/055817b111884e0227e1be16a0be6ee0", r.URL.Path)
+		}
+		testclient.WriteResult(w, json.RawMessage(addrMap))
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareAddressMapSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+		"description":             "example",
+		"default_sni":             "*.example.com",
+		"enabled":                 true,
+		"ips":                     []interface{}{map[string]interface{}{"ip": "192.0.2.1"}},
+		"memberships": []interface{}{map[string]interface{}{
+			"identifier": "023e105f4ecef8ad9ca31a8372d0c353",
+			"kind":       "zone",
+		}},
+	})
+
+	diags := resourceCloudflareAddressMapCreate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, map[string]interface{}{
+		"description": "example",
+		"default_sni": "*.example.com",
+		"enabled":     true,
+		"ips":         []interface{}{"192.0.2.1"},
+		"memberships": []interface{}{map[string]interface{}{
+			"identifier": "023e105f4ecef8ad9ca31a8372d0c353",
+			"kind":       "zone",
+		}},
+	}, createBody)
+
+	assert.Equal(t, "055817b111884e0227e1be16a0be6ee0", d.Id())
+	assert.Equal(t, true, d.Get("can_delete"))
+	assert.Equal(t, true, d.Get("can_modify_ips"))
+	assert.Equal(t, []interface{}{map[string]interface{}{"ip": "192.0.2.1"}}, d.Get("ips").(*schema.Set).List())
+}
+
+func testAccCloudflareAddressMapConfig(rnd, accountID, zoneID string, withZone bool) string {
+	zoneMembership := ""
+	if withZone {
+		zoneMembership = fmt.Sprintf(`
+  memberships {
+    identifier = "%s"
+    kind       = "zone"
+  }`, zoneID)
+	}
+
+	return fmt.Sprintf(`
+resource "cloudflare_address_map" "%[1]s" {
+  account_id  = "%[2]s"
+  description = "%[1]s"
+  enabled     = false
+
+  memberships {
+    identifier = "%[2]s"
+    kind       = "account"
+  }%[3]s
+}
+`, rnd, accountID, zoneMembership)
+}
+
+func testAccCheckCloudflareAddressMapDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_address_map" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, addressMapURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("address map still exists")
+		}
+	}
+
+	return nil
+}
@@ -14,7 +14,7 @@ import (
 
 func resourceCloudflareAPIShield() *schema.Resource {
 	return &schema.Resource{
-		Schema:        resourceCloudflareAPIShieldSchema(),
+		Schema:        resourceCloudflareAPIShieldConfigurationSchema(),
 		CreateContext: resourceCloudflareAPIShieldCreate,
 		ReadContext:   resourceCloudflareAPIShieldRead,
 		UpdateContext: resourceCloudflareAPIShieldUpdate,
@@ -0,0 +1,173 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// apiShieldOperation is an API Shield operation identified by the HTTP
+// method, host and endpoint.
+type apiShieldOperation struct {
+	ID       string `json:"operation_id,omitempty"`
+	Method   string `json:"method"`
+	Host     string `json:"host"`
+	Endpoint string `json:"endpoint"`
+}
+
+func resourceCloudflareAPIShieldOperation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldOperationSchema(),
+		CreateContext: resourceCloudflareAPIShieldOperationCreate,
+		ReadContext:   resourceCloudflareAPIShieldOperationRead,
+		DeleteContext: resourceCloudflareAPIShieldOperationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldOperationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage an operation in API Shield Endpoint Management.
+		`),
+	}
+}
+
+func resourceCloudflareAPIShieldOperationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	operation := apiShieldOperation{
+		Method:   d.Get("method").(string),
+		Host:     d.Get("host").(string),
+		Endpoint: d.Get("endpoint").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare API Shield operation from struct: %+v", operation))
+
+	res, err := client.Raw(ctx, http.MethodPost, apiShieldOperationURI(zoneID, ""), []apiShieldOperation{operation}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield operation: %w", err))
+	}
+
+	var operations []apiShieldOperation
+	if err := json.Unmarshal(res, &operations); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield operation: %w", err))
+	}
+
+	if len(operations) != 1 {
+		return diag.FromErr(fmt.Errorf("expected exactly one API Shield operation in create response, got %d", len(operations)))
+	}
+
+	d.SetId(operations[0].ID)
+
+	return resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldOperationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, apiShieldOperationURI(zoneID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield operation %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch API Shield operation: %w", err))
+	}
+
+	var operation apiShieldOperation
+	if err := json.Unmarshal(res, &operation); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield operation: %w", err))
+	}
+
+	d.Set("method", operation.Method)
+	d.Set("host", operation.Host)
+	d.Set("endpoint", operation.Endpoint)
+	d.Set("operation_id", operation.ID)
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	_, err := client.Raw(ctx, http.MethodDelete, apiShieldOperationURI(zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete API Shield operation: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldOperationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/operationID\"", d.Id())
+	}
+
+	zoneID, operationID := attributes[0], attributes[1]
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(operationID)
+
+	resourceCloudflareAPIShieldOperationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func apiShieldOperationURI(zoneID, operationID string) string {
+	uri := fmt.Sprintf("/zones/%s/api_gateway/operations", zoneID)
+	if operationID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, operationID)
+	}
+	return uri
+}
+
+// listAPIShieldOperations returns all operations for a zone matching the
+// provided filters, fetching every page of results.
+func listAPIShieldOperations(ctx context.Context, client *cloudflare.API, zoneID string, filters url.Values) ([]apiShieldOperation, error) {
+	const perPage = 50
+
+	var operations []apiShieldOperation
+	for page := 1; ; page++ {
+		params := url.Values{}
+		for k, v := range filters {
+			params[k] = v
+		}
+		params.Set("page", strconv.Itoa(page))
+		params.Set("per_page", strconv.Itoa(perPage))
+
+		res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s?%s", apiShieldOperationURI(zoneID, ""), params.Encode()), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result []apiShieldOperation
+		if err := json.Unmarshal(res, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal API Shield operations: %w", err)
+		}
+
+		operations = append(operations, result...)
+
+		if len(result) < perPage {
+			break
+		}
+	}
+
+	return operations, nil
+}
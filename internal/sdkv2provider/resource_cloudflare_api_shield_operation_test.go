@@ -0,0 +1,84 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareAPIShieldOperation_Create(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the API token
+	// endpoint does not yet support the API tokens without an explicit scope.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	resourceID := "cloudflare_api_shield_operation." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckAPIShieldOperationDelete,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldOperation(rnd, zoneID, "GET", domain, "/example/path"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceID, "method", "GET"),
+					resource.TestCheckResourceAttr(resourceID, "host", domain),
+					resource.TestCheckResourceAttr(resourceID, "endpoint", "/example/path"),
+					resource.TestCheckResourceAttrSet(resourceID, "operation_id"),
+				),
+			},
+			{
+				ResourceName:        resourceID,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func testAccCheckAPIShieldOperationDelete(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_api_shield_operation" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, apiShieldOperationURI(rs.Primary.Attributes["zone_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("operation still exists")
+		}
+
+		var notFoundError *cloudflare.NotFoundError
+		if !errors.As(err, &notFoundError) {
+			return fmt.Errorf("expected not found error but got: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareAPIShieldOperation(resourceName, zone, method, host, endpoint string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_api_shield_operation" "%[1]s" {
+		zone_id  = "%[2]s"
+		method   = "%[3]s"
+		host     = "%[4]s"
+		endpoint = "%[5]s"
+	}
+`, resourceName, zone, method, host, endpoint)
+}
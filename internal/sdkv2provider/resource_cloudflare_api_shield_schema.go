@@ -0,0 +1,228 @@
+package sdkv2provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"gopkg.in/yaml.v3"
+)
+
+const apiShieldSchemaKindOpenAPIV3 = "openapi_v3"
+
+// apiShieldSchema is an OpenAPI schema uploaded to API Shield for request
+// validation.
+type apiShieldSchema struct {
+	ID                string `json:"schema_id"`
+	Name              string `json:"name"`
+	Kind              string `json:"kind"`
+	Source            string `json:"source"`
+	ValidationEnabled bool   `json:"validation_enabled"`
+}
+
+func resourceCloudflareAPIShieldSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareAPIShieldSchemaSchema(),
+		CreateContext: resourceCloudflareAPIShieldSchemaCreate,
+		ReadContext:   resourceCloudflareAPIShieldSchemaRead,
+		UpdateContext: resourceCloudflareAPIShieldSchemaUpdate,
+		DeleteContext: resourceCloudflareAPIShieldSchemaDelete,
+		CustomizeDiff: resourceCloudflareAPIShieldSchemaCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareAPIShieldSchemaImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource to manage schemas used by API Shield for
+			request validation.
+		`),
+	}
+}
+
+func resourceCloudflareAPIShieldSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	name := d.Get("name").(string)
+	kind := d.Get("kind").(string)
+	source := d.Get("source").(string)
+
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+
+	file, err := w.CreateFormFile("file", name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield schema: %w", err))
+	}
+	if _, err := file.Write([]byte(source)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield schema: %w", err))
+	}
+
+	for field, value := range map[string]string{
+		"name":               name,
+		"kind":               kind,
+		"validation_enabled": strconv.FormatBool(d.Get("validation_enabled").(bool)),
+	} {
+		if err := w.WriteField(field, value); err != nil {
+			return diag.FromErr(fmt.Errorf("failed to create API Shield schema: %w", err))
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield schema: %w", err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare API Shield schema %q", name))
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", w.FormDataContentType())
+
+	res, err := client.Raw(ctx, http.MethodPost, apiShieldSchemaURI(zoneID, ""), body.Bytes(), headers)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to create API Shield schema: %w", err))
+	}
+
+	var result struct {
+		Schema apiShieldSchema `json:"schema"`
+	}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield schema: %w", err))
+	}
+
+	d.SetId(result.Schema.ID)
+
+	return resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, apiShieldSchemaURI(zoneID, d.Id())+"?omit_source=false", nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("API Shield schema %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed to fetch API Shield schema: %w", err))
+	}
+
+	var s apiShieldSchema
+	if err := json.Unmarshal(res, &s); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal API Shield schema: %w", err))
+	}
+
+	d.Set("name", s.Name)
+	d.Set("kind", s.Kind)
+	d.Set("validation_enabled", s.ValidationEnabled)
+	d.Set("schema_id", s.ID)
+
+	// The source is returned in a normalised form so it is only populated
+	// during import to avoid a perpetual diff.
+	if d.Get("source").(string) == "" {
+		d.Set("source", s.Source)
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	_, err := client.Raw(ctx, http.MethodPatch, apiShieldSchemaURI(zoneID, d.Id()), map[string]interface{}{
+		"validation_enabled": d.Get("validation_enabled").(bool),
+	}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to update API Shield schema: %w", err))
+	}
+
+	return resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+}
+
+func resourceCloudflareAPIShieldSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	_, err := client.Raw(ctx, http.MethodDelete, apiShieldSchemaURI(zoneID, d.Id()), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to delete API Shield schema: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareAPIShieldSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/schemaID\"", d.Id())
+	}
+
+	zoneID, schemaID := attributes[0], attributes[1]
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(schemaID)
+
+	resourceCloudflareAPIShieldSchemaRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareAPIShieldSchemaCustomizeDiff validates the schema source at
+// plan time so that an invalid document is reported before anything is
+// uploaded.
+func resourceCloudflareAPIShieldSchemaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("kind") || !d.NewValueKnown("source") {
+		return nil
+	}
+
+	if err := validateAPIShieldSchemaSource(d.Get("kind").(string), d.Get("source").(string)); err != nil {
+		return fmt.Errorf("invalid API Shield schema source: %w", err)
+	}
+
+	return nil
+}
+
+func apiShieldSchemaURI(zoneID, schemaID string) string {
+	uri := fmt.Sprintf("/zones/%s/api_gateway/user_schemas", zoneID)
+	if schemaID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, schemaID)
+	}
+	return uri
+}
+
+// validateAPIShieldSchemaSource ensures the schema source parses as the
+// declared kind before it is uploaded. OpenAPI documents may be provided as
+// either JSON or YAML.
+func validateAPIShieldSchemaSource(kind, source string) error {
+	switch kind {
+	case apiShieldSchemaKindOpenAPIV3:
+		var document struct {
+			OpenAPI string `yaml:"openapi"`
+		}
+		if err := yaml.Unmarshal([]byte(source), &document); err != nil {
+			return fmt.Errorf("failed to parse OpenAPI document: %w", err)
+		}
+
+		if !strings.HasPrefix(document.OpenAPI, "3.") {
+			return fmt.Errorf("expected an OpenAPI 3.x document, got openapi version %q", document.OpenAPI)
+		}
+	default:
+		return fmt.Errorf("unsupported schema kind %q", kind)
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAccAPIShieldSchemaSource = `{
+  "openapi": "3.0.0",
+  "info": {
+    "title": "Example API",
+    "version": "1.0.0"
+  },
+  "servers": [
+    {
+      "url": "https://example.com"
+    }
+  ],
+  "paths": {
+    "/users": {
+      "get": {
+        "responses": {
+          "200": {
+            "description": "OK"
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestAccCloudflareAPIShieldSchema_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the API token
+	// endpoint does not yet support the API tokens without an explicit scope.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	resourceID := "cloudflare_api_shield_schema." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(resourceID, "name", rnd),
+					resource.TestCheckResourceAttr(resourceID, "kind", "openapi_v3"),
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceID, "schema_id"),
+				),
+			},
+			{
+				Config: testAccCloudflareAPIShieldSchema(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceID, "validation_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestValidateAPIShieldSchemaSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		kind    string
+		source  string
+		wantErr bool
+	}{
+		"openapi v3 json":   {kind: "openapi_v3", source: testAccAPIShieldSchemaSource},
+		"openapi v3 yaml":   {kind: "openapi_v3", source: "openapi: 3.0.3\ninfo:\n  title: Example API\n  version: 1.0.0\npaths: {}\n"},
+		"swagger v2":        {kind: "openapi_v3", source: `{"swagger": "2.0"}`, wantErr: true},
+		"malformed":         {kind: "openapi_v3", source: `{"openapi": "3.0.0"`, wantErr: true},
+		"unsupported kind":  {kind: "graphql", source: testAccAPIShieldSchemaSource, wantErr: true},
+		"missing openapi":   {kind: "openapi_v3", source: `{"info": {}}`, wantErr: true},
+		"empty source text": {kind: "openapi_v3", source: "", wantErr: true},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := validateAPIShieldSchemaSource(tc.kind, tc.source)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResourceCloudflareAPIShieldSchemaInvalidSource(t *testing.T) {
+	raw := cty.ObjectVal(map[string]cty.Value{
+		"zone_id":            cty.StringVal("0da42c8d2132a9ddaf714f9e7c920711"),
+		"name":               cty.StringVal("example"),
+		"kind":               cty.StringVal("openapi_v3"),
+		"source":             cty.StringVal(`{"swagger": "2.0"}`),
+		"validation_enabled": cty.False,
+	})
+	config := terraform.NewResourceConfigShimmed(raw, resourceCloudflareAPIShieldSchema().CoreConfigSchema())
+
+	_, err := resourceCloudflareAPIShieldSchema().Diff(context.Background(), &terraform.InstanceState{RawConfig: raw}, config, nil)
+	assert.ErrorContains(t, err, "expected an OpenAPI 3.x document")
+}
+
+func testAccCloudflareAPIShieldSchema(resourceName, zone string, validationEnabled bool) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_api_shield_schema" "%[1]s" {
+		zone_id            = "%[2]s"
+		name               = "%[1]s"
+		kind               = "openapi_v3"
+		validation_enabled = %[3]t
+		source             = <<EOT
+%[4]s
+EOT
+	}
+`, resourceName, zone, validationEnabled, testAccAPIShieldSchemaSource)
+}
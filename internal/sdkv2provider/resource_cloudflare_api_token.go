@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -21,6 +24,7 @@ func resourceCloudflareApiToken() *schema.Resource {
 		ReadContext:   resourceCloudflareApiTokenRead,
 		UpdateContext: resourceCloudflareApiTokenUpdate,
 		DeleteContext: resourceCloudflareApiTokenDelete,
+		CustomizeDiff: resourceCloudflareApiTokenCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -33,11 +37,51 @@ func resourceCloudflareApiToken() *schema.Resource {
 	}
 }
 
-func buildAPIToken(d *schema.ResourceData) cloudflare.APIToken {
+var errAPITokenPolicyWithoutPermissionGroups = errors.New("each policy must set at least one of permission_groups or permission_group_names")
+
+// resourceCloudflareApiTokenCustomizeDiff validates at plan time that every
+// policy grants at least one permission group.
+func resourceCloudflareApiTokenCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	policies := config.GetAttr("policy")
+	if policies.IsNull() || !policies.IsKnown() {
+		return nil
+	}
+
+	for it := policies.ElementIterator(); it.Next(); {
+		_, policy := it.Element()
+		if !policy.IsKnown() {
+			continue
+		}
+
+		if apiTokenPolicyAttributeEmpty(policy.GetAttr("permission_groups")) && apiTokenPolicyAttributeEmpty(policy.GetAttr("permission_group_names")) {
+			return errAPITokenPolicyWithoutPermissionGroups
+		}
+	}
+
+	return nil
+}
+
+// apiTokenPolicyAttributeEmpty reports whether a set attribute of a policy is
+// known to contain no values.
+func apiTokenPolicyAttributeEmpty(v cty.Value) bool {
+	return v.IsNull() || (v.IsKnown() && v.LengthInt() == 0)
+}
+
+func buildAPIToken(ctx context.Context, client *cloudflare.API, d *schema.ResourceData) (cloudflare.APIToken, error) {
 	token := cloudflare.APIToken{}
 
+	policies, err := resourceDataToApiTokenPolices(ctx, client, d)
+	if err != nil {
+		return token, err
+	}
+
 	token.Name = d.Get("name").(string)
-	token.Policies = resourceDataToApiTokenPolices(d)
+	token.Policies = policies
 
 	ipsIn := []string{}
 	ipsNotIn := []string{}
@@ -71,7 +115,7 @@ func buildAPIToken(d *schema.ResourceData) cloudflare.APIToken {
 		token.ExpiresOn = &expiresOn
 	}
 
-	return token
+	return token, nil
 }
 
 func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -81,8 +125,12 @@ func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceDat
 
 	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare API Token: name %s", name))
 
-	t := buildAPIToken(d)
-	t, err := client.CreateAPIToken(ctx, t)
+	t, err := buildAPIToken(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error building Cloudflare API Token %q: %w", name, err))
+	}
+
+	t, err = client.CreateAPIToken(ctx, t)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Cloudflare API Token %q: %w", name, err))
 	}
@@ -94,22 +142,20 @@ func resourceCloudflareApiTokenCreate(ctx context.Context, d *schema.ResourceDat
 	return resourceCloudflareApiTokenRead(ctx, d, meta)
 }
 
-func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APITokenPolicies {
+func resourceDataToApiTokenPolices(ctx context.Context, client *cloudflare.API, d *schema.ResourceData) ([]cloudflare.APITokenPolicies, error) {
 	policies := d.Get("policy").(*schema.Set).List()
 	var cfPolicies []cloudflare.APITokenPolicies
 
+	// Permission groups are only listed when they are referenced by name.
+	var availableGroups []cloudflare.APITokenPermissionGroups
+
 	for _, p := range policies {
 		policy := p.(map[string]interface{})
 
 		permissionGroups := expandInterfaceToStringList(policy["permission_groups"].(*schema.Set).List())
-		if len(permissionGroups) == 0 {
-			continue
-		}
-		var cfPermissionGroups []cloudflare.APITokenPermissionGroups
-		for _, pg := range permissionGroups {
-			cfPermissionGroups = append(cfPermissionGroups, cloudflare.APITokenPermissionGroups{
-				ID: pg,
-			})
+		permissionGroupNames := expandInterfaceToStringList(policy["permission_group_names"].(*schema.Set).List())
+		if len(permissionGroups) == 0 && len(permissionGroupNames) == 0 {
+			return nil, errAPITokenPolicyWithoutPermissionGroups
 		}
 
 		cfResources := map[string]interface{}{}
@@ -123,6 +169,31 @@ func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APIToken
 			}
 		}
 
+		if len(permissionGroupNames) > 0 {
+			if availableGroups == nil {
+				tflog.Debug(ctx, "Listing API Token Permission Groups to resolve permission group names")
+
+				var err error
+				availableGroups, err = client.ListAPITokensPermissionGroups(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("error listing API Token Permission Groups: %w", err)
+				}
+			}
+
+			resolved, err := resolveAPITokenPermissionGroupNames(permissionGroupNames, apiTokenPolicyScopes(cfResources), availableGroups)
+			if err != nil {
+				return nil, err
+			}
+			permissionGroups = append(permissionGroups, resolved...)
+		}
+
+		var cfPermissionGroups []cloudflare.APITokenPermissionGroups
+		for _, pg := range permissionGroups {
+			cfPermissionGroups = append(cfPermissionGroups, cloudflare.APITokenPermissionGroups{
+				ID: pg,
+			})
+		}
+
 		cfPolicies = append(cfPolicies, cloudflare.APITokenPolicies{
 			Effect:           policy["effect"].(string),
 			Resources:        cfResources,
@@ -130,7 +201,87 @@ func resourceDataToApiTokenPolices(d *schema.ResourceData) []cloudflare.APIToken
 		})
 	}
 
-	return cfPolicies
+	return cfPolicies, nil
+}
+
+// apiTokenPolicyScopes returns the permission group scopes of the resources a
+// policy applies to, e.g. "com.cloudflare.api.account.zone" for
+// "com.cloudflare.api.account.zone.*".
+func apiTokenPolicyScopes(resources map[string]interface{}) map[string]bool {
+	scopes := map[string]bool{}
+
+	addScope := func(resource string) {
+		if i := strings.LastIndex(resource, "."); i > 0 {
+			scopes[resource[:i]] = true
+		}
+	}
+
+	for k, v := range resources {
+		// An account mapped to a set of its zones grants zone permissions.
+		if nested, ok := v.(map[string]string); ok {
+			for nk := range nested {
+				addScope(nk)
+			}
+			continue
+		}
+
+		addScope(k)
+	}
+
+	return scopes
+}
+
+// resolveAPITokenPermissionGroupNames maps permission group names to their
+// IDs. Some names are shared by permission groups of different scopes in
+// which case the group matching one of the policy scopes is used.
+func resolveAPITokenPermissionGroupNames(names []string, scopes map[string]bool, groups []cloudflare.APITokenPermissionGroups) ([]string, error) {
+	byName := map[string][]cloudflare.APITokenPermissionGroups{}
+	for _, group := range groups {
+		byName[group.Name] = append(byName[group.Name], group)
+	}
+
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		candidates := byName[name]
+
+		if len(candidates) > 1 {
+			var inScope []cloudflare.APITokenPermissionGroups
+			for _, candidate := range candidates {
+				for _, scope := range candidate.Scopes {
+					if scopes[scope] {
+						inScope = append(inScope, candidate)
+						break
+					}
+				}
+			}
+			candidates = inScope
+		}
+
+		switch len(candidates) {
+		case 0:
+			if len(byName[name]) > 0 {
+				return nil, fmt.Errorf("permission group %q does not apply to the resources of the policy", name)
+			}
+
+			knownNames := make([]string, 0, len(byName))
+			for knownName := range byName {
+				knownNames = append(knownNames, knownName)
+			}
+			sort.Strings(knownNames)
+
+			msg := fmt.Sprintf("unknown permission group %q", name)
+			if suggestions := closestStringMatches(name, knownNames, 3); len(suggestions) > 0 {
+				msg = fmt.Sprintf("%s (did you mean %s?)", msg, strings.Join(suggestions, ", "))
+			}
+			return nil, errors.New(msg)
+		case 1:
+			ids = append(ids, candidates[0].ID)
+		default:
+			return nil, fmt.Errorf("permission group name %q matches more than one permission group for the resources of the policy, use its ID in permission_groups instead", name)
+		}
+	}
+
+	return ids, nil
 }
 
 func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -151,18 +302,33 @@ func resourceCloudflareApiTokenRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(fmt.Errorf("error finding Cloudflare API Token %q: %w", d.Id(), err))
 	}
 
+	// Permission groups configured by name are kept as names so that they
+	// don't show up as a change to permission_groups.
+	namedPermissionGroups := map[string]bool{}
+	for _, p := range d.Get("policy").(*schema.Set).List() {
+		for _, name := range p.(map[string]interface{})["permission_group_names"].(*schema.Set).List() {
+			namedPermissionGroups[name.(string)] = true
+		}
+	}
+
 	policies := []map[string]interface{}{}
 
 	for _, p := range t.Policies {
 		permissionGroups := []string{}
+		permissionGroupNames := []string{}
 		for _, v := range p.PermissionGroups {
-			permissionGroups = append(permissionGroups, v.ID)
+			if namedPermissionGroups[v.Name] {
+				permissionGroupNames = append(permissionGroupNames, v.Name)
+			} else {
+				permissionGroups = append(permissionGroups, v.ID)
+			}
 		}
 
 		policies = append(policies, map[string]interface{}{
-			"resources":         p.Resources,
-			"permission_groups": permissionGroups,
-			"effect":            p.Effect,
+			"resources":              p.Resources,
+			"permission_groups":      permissionGroups,
+			"permission_group_names": permissionGroupNames,
+			"effect":                 p.Effect,
 		})
 	}
 
@@ -208,11 +374,14 @@ func resourceCloudflareApiTokenUpdate(ctx context.Context, d *schema.ResourceDat
 	name := d.Get("name").(string)
 	tokenID := d.Id()
 
-	t := buildAPIToken(d)
+	t, err := buildAPIToken(ctx, client, d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error building Cloudflare API Token %q: %w", name, err))
+	}
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare API Token: name %s", name))
 
-	_, err := client.UpdateAPIToken(ctx, tokenID, t)
+	_, err = client.UpdateAPIToken(ctx, tokenID, t)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Cloudflare API Token %q: %w", name, err))
 	}
@@ -1,11 +1,17 @@
 package sdkv2provider
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccAPIToken_Basic(t *testing.T) {
@@ -280,3 +286,128 @@ func testAccCloudflareAPITokenWithTTL(rnd string, permissionID string) string {
 	}
 `, rnd, permissionID)
 }
+
+func TestAccAPIToken_PermissionGroupNames(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the API token
+	// endpoint does not yet support the API tokens without an explicit scope.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := "cloudflare_api_token." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareAPITokenWithPermissionGroupNames(rnd, "DNS Read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "policy.*", map[string]string{
+						"permission_group_names.#": "1",
+						"permission_group_names.0": "DNS Read",
+						"permission_groups.#":      "0",
+					}),
+				),
+			},
+			{
+				Config:      testAccCloudflareAPITokenWithPermissionGroupNames(rnd, "DNS Reed"),
+				ExpectError: regexp.MustCompile(`unknown permission group "DNS Reed" \(did you mean DNS Read`),
+			},
+		},
+	})
+}
+
+func testAccCloudflareAPITokenWithPermissionGroupNames(rnd, permissionGroupName string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_api_token" "%[1]s" {
+		name = "%[1]s"
+
+		policy {
+			effect = "allow"
+			permission_group_names = [ "%[2]s" ]
+			resources = { "com.cloudflare.api.account.zone.*" = "*" }
+		}
+	}
+`, rnd, permissionGroupName)
+}
+
+func TestResolveAPITokenPermissionGroupNames(t *testing.T) {
+	groups := []cloudflare.APITokenPermissionGroups{
+		{ID: "82e64a83756745bbbb1c9c2701bf816b", Name: "DNS Read", Scopes: []string{"com.cloudflare.api.account.zone"}},
+		{ID: "e086da7e2179491d91ee5f35b3ca210a", Name: "Workers Scripts Write", Scopes: []string{"com.cloudflare.api.account"}},
+		{ID: "28f4b596e7d643029c524985477ae49a", Name: "Workers Routes Write", Scopes: []string{"com.cloudflare.api.account.zone"}},
+		{ID: "9ff81cbbe65c400b97d92c3c1033cab6", Name: "Cache Settings Write", Scopes: []string{"com.cloudflare.api.account.zone"}},
+		{ID: "c1fde68c7bcc44588cbb6ddbc16d6480", Name: "Account Settings Read", Scopes: []string{"com.cloudflare.api.account"}},
+		{ID: "a1c0fec57cf94af79479a6d827fa518c", Name: "Settings Read", Scopes: []string{"com.cloudflare.api.account"}},
+		{ID: "517b21aee92c4d89936c976ba6e4be55", Name: "Settings Read", Scopes: []string{"com.cloudflare.api.account.zone"}},
+	}
+
+	testCases := map[string]struct {
+		names     []string
+		resources map[string]interface{}
+		expected  []string
+		err       string
+	}{
+		"single scope": {
+			names:     []string{"DNS Read", "Cache Settings Write"},
+			resources: map[string]interface{}{"com.cloudflare.api.account.zone.*": "*"},
+			expected:  []string{"82e64a83756745bbbb1c9c2701bf816b", "9ff81cbbe65c400b97d92c3c1033cab6"},
+		},
+		"shared name resolved by scope": {
+			names:     []string{"Settings Read"},
+			resources: map[string]interface{}{"com.cloudflare.api.account.zone.0da42c8d2132a9ddaf714f9e7c920711": "*"},
+			expected:  []string{"517b21aee92c4d89936c976ba6e4be55"},
+		},
+		"shared name resolved by nested scope": {
+			names: []string{"Settings Read"},
+			resources: map[string]interface{}{"com.cloudflare.api.account.f037e56e89293a057740de681ac9abbe": map[string]string{
+				"com.cloudflare.api.account.zone.*": "*",
+			}},
+			expected: []string{"517b21aee92c4d89936c976ba6e4be55"},
+		},
+		"unknown name": {
+			names:     []string{"DNS Reed"},
+			resources: map[string]interface{}{"com.cloudflare.api.account.zone.*": "*"},
+			err:       `unknown permission group "DNS Reed" (did you mean DNS Read?)`,
+		},
+		"shared name out of scope": {
+			names:     []string{"Settings Read"},
+			resources: map[string]interface{}{"com.cloudflare.api.user.*": "*"},
+			err:       `permission group "Settings Read" does not apply to the resources of the policy`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ids, err := resolveAPITokenPermissionGroupNames(tc.names, apiTokenPolicyScopes(tc.resources), groups)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, ids)
+		})
+	}
+}
+
+func TestResourceCloudflareApiTokenPolicyWithoutPermissionGroups(t *testing.T) {
+	raw := cty.ObjectVal(map[string]cty.Value{
+		"name": cty.StringVal("example"),
+		"policy": cty.SetVal([]cty.Value{
+			cty.ObjectVal(map[string]cty.Value{
+				"permission_groups":      cty.NullVal(cty.Set(cty.String)),
+				"permission_group_names": cty.SetValEmpty(cty.String),
+				"resources":              cty.MapVal(map[string]cty.Value{"com.cloudflare.api.account.zone.*": cty.StringVal("*")}),
+				"effect":                 cty.StringVal("allow"),
+			}),
+		}),
+	})
+	config := terraform.NewResourceConfigShimmed(raw, resourceCloudflareApiToken().CoreConfigSchema())
+
+	_, err := resourceCloudflareApiToken().Diff(context.Background(), &terraform.InstanceState{RawConfig: raw}, config, nil)
+	assert.ErrorContains(t, err, "each policy must set at least one of permission_groups or permission_group_names")
+}
@@ -3,12 +3,14 @@ package sdkv2provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -20,10 +22,16 @@ func resourceCloudflareArgoTunnel() *schema.Resource {
 		Schema:        resourceCloudflareArgoTunnelSchema(),
 		CreateContext: resourceCloudflareArgoTunnelCreate,
 		ReadContext:   resourceCloudflareArgoTunnelRead,
+		UpdateContext: resourceCloudflareArgoTunnelUpdate,
 		DeleteContext: resourceCloudflareArgoTunnelDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareArgoTunnelImport,
 		},
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIf("secret", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return !d.Get("rotate_secret").(bool)
+			}),
+		),
 	}
 }
 
@@ -52,20 +60,39 @@ func resourceCloudflareArgoTunnelRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("failed to fetch Argo Tunnel: %w", err))
 	}
 
-	token, err := client.TunnelToken(ctx, cloudflare.AccountIdentifier(accID), tunnel.ID)
+	d.Set("cname", fmt.Sprintf("%s.%s", tunnel.ID, argoTunnelCNAME))
 
+	token, err := client.TunnelToken(ctx, cloudflare.AccountIdentifier(accID), tunnel.ID)
 	if err != nil {
-		tflog.Warn(ctx, "unable to set the tunnel_token in state because it's not found in API")
-		d.Set("tunnel_token", "")
+		// Keep whatever token is already in state rather than flapping
+		// between a value and an empty string on transient failures.
+		tflog.Warn(ctx, fmt.Sprintf("unable to refresh the tunnel_token of Argo Tunnel %s: %s", tunnel.ID, err))
 		return nil
 	}
 
-	d.Set("cname", fmt.Sprintf("%s.%s", tunnel.ID, argoTunnelCNAME))
 	d.Set("tunnel_token", token)
 
 	return nil
 }
 
+func resourceCloudflareArgoTunnelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	if d.HasChange("secret") {
+		tflog.Info(ctx, fmt.Sprintf("Rotating secret of Argo Tunnel %s", d.Id()))
+
+		_, err := quietClient(client).Raw(ctx, http.MethodPatch, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accID, d.Id()), cloudflare.TunnelUpdateParams{
+			Secret: d.Get("secret").(string),
+		}, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed to rotate Argo Tunnel secret: %w", err))
+		}
+	}
+
+	return resourceCloudflareArgoTunnelRead(ctx, d, meta)
+}
+
 func resourceCloudflareArgoTunnelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accID := d.Get(consts.AccountIDSchemaKey).(string)
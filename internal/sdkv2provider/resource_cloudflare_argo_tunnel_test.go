@@ -2,14 +2,19 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareArgoTunnelCreate(t *testing.T) {
@@ -36,12 +41,117 @@ func TestAccCloudflareArgoTunnelCreate(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "name", rnd),
 					resource.TestCheckResourceAttr(name, "secret", "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="),
 					resource.TestMatchResourceAttr(name, "cname", regexp.MustCompile(".*\\.cfargotunnel\\.com")),
+					resource.TestCheckResourceAttrSet(name, "tunnel_token"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccCloudflareArgoTunnelRotateSecret(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Argo Tunnel
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	accID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_argo_tunnel.%s", rnd)
+	var tunnelID, token string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareArgoTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareArgoTunnelRotateSecret(accID, rnd, "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "tunnel_token"),
+					resource.TestCheckResourceAttr(name, "rotate_secret", "true"),
+					testAccCheckCloudflareArgoTunnelAttrs(name, &tunnelID, &token),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareArgoTunnelRotateSecret(accID, rnd, "CAcGBQQDAgEIBwYFBAMCAQgHBgUEAwIBCAcGBQQDAgE="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "secret", "CAcGBQQDAgEIBwYFBAMCAQgHBgUEAwIBCAcGBQQDAgE="),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources[name]
+						if rs.Primary.ID != tunnelID {
+							return fmt.Errorf("expected tunnel %s to be updated in place, got %s", tunnelID, rs.Primary.ID)
+						}
+						if rs.Primary.Attributes["tunnel_token"] == token {
+							return fmt.Errorf("expected tunnel_token to change after rotating the secret")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestArgoTunnelUpdateRotatesSecret(t *testing.T) {
+	var patchedSecret string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/cfd_tunnel/f174e90a-fafe-4643-bbbc-4a0ed4fc8415", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body map[string]string
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			patchedSecret = body["tunnel_secret"]
+		}
+
+		testclient.WriteResult(w, json.RawMessage(`{"id":"f174e90a-fafe-4643-bbbc-4a0ed4fc8415","name":"example"}`))
+	})
+	mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/cfd_tunnel/f174e90a-fafe-4643-bbbc-4a0ed4fc8415/token", func(w http.ResponseWriter, r *http.Request) {
+		testclient.WriteResult(w, "rotated-token")
+	})
+
+	client := testclient.New(t, mux)
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareArgoTunnelSchema(), map[string]interface{}{
+		"account_id":    "f037e56e89293a057740de681ac9abbe",
+		"name":          "example",
+		"secret":        "CAcGBQQDAgEIBwYFBAMCAQgHBgUEAwIBCAcGBQQDAgE=",
+		"rotate_secret": true,
+	})
+	d.SetId("f174e90a-fafe-4643-bbbc-4a0ed4fc8415")
+
+	diags := resourceCloudflareArgoTunnelUpdate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "CAcGBQQDAgEIBwYFBAMCAQgHBgUEAwIBCAcGBQQDAgE=", patchedSecret)
+	assert.Equal(t, "rotated-token", d.Get("tunnel_token"))
+	assert.Equal(t, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415.cfargotunnel.com", d.Get("cname"))
+}
+
+func testAccCheckCloudflareArgoTunnelAttrs(n string, tunnelID, token *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		*tunnelID = rs.Primary.ID
+		*token = rs.Primary.Attributes["tunnel_token"]
+		return nil
+	}
+}
+
+func testAccCheckCloudflareArgoTunnelRotateSecret(accID, name, secret string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_argo_tunnel" "%[2]s" {
+		account_id    = "%[1]s"
+		name          = "%[2]s"
+		secret        = "%[3]s"
+		rotate_secret = true
+	}`, accID, name, secret)
+}
+
 func testAccCheckCloudflareArgoTunnelBasic(accID, name string) string {
 	return fmt.Sprintf(`
 	resource "cloudflare_argo_tunnel" "%[2]s" {
@@ -53,7 +163,7 @@ func testAccCheckCloudflareArgoTunnelBasic(accID, name string) string {
 
 func testAccCheckCloudflareArgoTunnelDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "cloudflare_argo_tunnel" {
+		if rs.Type != "cloudflare_argo_tunnel" && rs.Type != "cloudflare_zero_trust_tunnel_cloudflared" {
 			continue
 		}
 
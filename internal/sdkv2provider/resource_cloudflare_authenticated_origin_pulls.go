@@ -8,11 +8,18 @@ import (
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
 
+const (
+	authenticatedOriginPullsModeGlobal      = "GlobalAOP"
+	authenticatedOriginPullsModePerZone     = "PerZoneAOP"
+	authenticatedOriginPullsModePerHostname = "PerHostnameAOP"
+)
+
 func resourceCloudflareAuthenticatedOriginPulls() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareAuthenticatedOriginPullsSchema(),
@@ -20,6 +27,7 @@ func resourceCloudflareAuthenticatedOriginPulls() *schema.Resource {
 		ReadContext:   resourceCloudflareAuthenticatedOriginPullsRead,
 		UpdateContext: resourceCloudflareAuthenticatedOriginPullsCreate,
 		DeleteContext: resourceCloudflareAuthenticatedOriginPullsDelete,
+		CustomizeDiff: resourceCloudflareAuthenticatedOriginPullsCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareAuthenticatedOriginPullsImport,
 		},
@@ -36,15 +44,13 @@ func resourceCloudflareAuthenticatedOriginPullsCreate(ctx context.Context, d *sc
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
 
-	var checksum string
 	isEnabled := false
 	if enabledVal, ok := d.GetOk("enabled"); ok {
 		// if enabled is not the zero val, use that
 		isEnabled = enabledVal.(bool)
 	}
-	switch {
-	case hostname != "" && aopCert != "":
-		// Per Hostname AOP
+	switch authenticatedOriginPullsMode(hostname, aopCert) {
+	case authenticatedOriginPullsModePerHostname:
 		conf := []cloudflare.PerHostnameAuthenticatedOriginPullsConfig{{
 			CertID:   aopCert,
 			Hostname: hostname,
@@ -54,26 +60,21 @@ func resourceCloudflareAuthenticatedOriginPullsCreate(ctx context.Context, d *sc
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error creating Per-Hostname Authenticated Origin Pulls resource on zone %q for hostname %s: %w", zoneID, hostname, err))
 		}
-		checksum = stringChecksum(fmt.Sprintf("PerHostnameAOP/%s/%s/%s", zoneID, hostname, aopCert))
 
-	case aopCert != "":
-		// Per Zone AOP
+	case authenticatedOriginPullsModePerZone:
 		_, err := client.SetPerZoneAuthenticatedOriginPullsStatus(ctx, zoneID, isEnabled)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error creating Per-Zone Authenticated Origin Pulls resource on zone %q: %w", zoneID, err))
 		}
-		checksum = stringChecksum(fmt.Sprintf("PerZoneAOP/%s/%s", zoneID, aopCert))
 
 	default:
-		// Global AOP
 		_, err := client.SetAuthenticatedOriginPullsStatus(ctx, zoneID, isEnabled)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error creating Global Authenticated Origin Pulls resource on zone %q: %w", zoneID, err))
 		}
-		checksum = stringChecksum(fmt.Sprintf("GlobalAOP/%s/", zoneID))
 	}
 
-	d.SetId(checksum)
+	d.SetId(authenticatedOriginPullsID(zoneID, hostname, aopCert))
 	return resourceCloudflareAuthenticatedOriginPullsRead(ctx, d, meta)
 }
 
@@ -83,22 +84,30 @@ func resourceCloudflareAuthenticatedOriginPullsRead(ctx context.Context, d *sche
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
 
-	if hostname != "" && aopCert != "" {
-		// Per Hostname AOP
+	switch authenticatedOriginPullsMode(hostname, aopCert) {
+	case authenticatedOriginPullsModePerHostname:
 		res, err := client.GetPerHostnameAuthenticatedOriginPullsConfig(ctx, zoneID, hostname)
 		if err != nil {
+			var notFoundError *cloudflare.NotFoundError
+			if errors.As(err, &notFoundError) {
+				tflog.Info(ctx, fmt.Sprintf("Per-Hostname Authenticated Origin Pulls setting for %s no longer exists", hostname))
+				d.SetId("")
+				return nil
+			}
 			return diag.FromErr(errors.Wrap(err, "failed to get Per-Hostname Authenticated Origin Pulls setting"))
 		}
 		d.Set("enabled", res.Enabled)
-	} else if aopCert != "" {
-		// Per Zone AOP
+		// Surface a certificate swapped outside of Terraform for the hostname.
+		if res.CertID != "" {
+			d.Set("authenticated_origin_pulls_certificate", res.CertID)
+		}
+	case authenticatedOriginPullsModePerZone:
 		res, err := client.GetPerZoneAuthenticatedOriginPullsStatus(ctx, zoneID)
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, "failed to get Per-Zone Authenticated Origin Pulls setting"))
 		}
 		d.Set("enabled", res.Enabled)
-	} else {
-		// Global AOP
+	default:
 		res, err := client.GetAuthenticatedOriginPullsStatus(ctx, zoneID)
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, "failed to get Global Authenticated Origin Pulls setting"))
@@ -118,8 +127,8 @@ func resourceCloudflareAuthenticatedOriginPullsDelete(ctx context.Context, d *sc
 	hostname := d.Get("hostname").(string)
 	aopCert := d.Get("authenticated_origin_pulls_certificate").(string)
 
-	if hostname != "" && aopCert != "" {
-		// Per Hostname AOP
+	switch authenticatedOriginPullsMode(hostname, aopCert) {
+	case authenticatedOriginPullsModePerHostname:
 		conf := []cloudflare.PerHostnameAuthenticatedOriginPullsConfig{{
 			CertID:   aopCert,
 			Hostname: hostname,
@@ -129,14 +138,12 @@ func resourceCloudflareAuthenticatedOriginPullsDelete(ctx context.Context, d *sc
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error disabling Per-Hostname Authenticated Origin Pulls resource on zone %q: %w", zoneID, err))
 		}
-	} else if aopCert != "" {
-		// Per Zone AOP
+	case authenticatedOriginPullsModePerZone:
 		_, err := client.SetPerZoneAuthenticatedOriginPullsStatus(ctx, zoneID, false)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error disabling Per-Zone Authenticated Origin Pulls resource on zone %q: %w", zoneID, err))
 		}
-	} else {
-		// Global AOP
+	default:
 		_, err := client.SetAuthenticatedOriginPullsStatus(ctx, zoneID, false)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("error disabling Global Authenticated Origin Pulls resource on zone %q: %w", zoneID, err))
@@ -156,18 +163,67 @@ func resourceCloudflareAuthenticatedOriginPullsImport(ctx context.Context, d *sc
 	d.Set("zone_id", zoneID)
 
 	// Set attributes based on inputs which informs which form of AOP to use
-	var checksum string
-	if hostname != "" && certID != "" {
+	switch authenticatedOriginPullsMode(hostname, certID) {
+	case authenticatedOriginPullsModePerHostname:
 		d.Set("hostname", hostname)
 		d.Set("authenticated_origin_pulls_certificate", certID)
-		checksum = stringChecksum(fmt.Sprintf("PerHostnameAOP/%s/%s/%s", zoneID, hostname, certID))
-	} else if certID != "" {
+	case authenticatedOriginPullsModePerZone:
 		d.Set("authenticated_origin_pulls_certificate", certID)
-		checksum = stringChecksum(fmt.Sprintf("PerZoneAOP/%s/%s", zoneID, certID))
-	} else {
-		checksum = stringChecksum(fmt.Sprintf("GlobalAOP/%s/", zoneID))
+	default:
+		if hostname != "" {
+			return nil, fmt.Errorf("invalid id (\"%s\") specified, a certificate ID is required to import Per-Hostname Authenticated Origin Pulls", d.Id())
+		}
 	}
-	d.SetId(checksum)
+	d.SetId(authenticatedOriginPullsID(zoneID, hostname, certID))
 	resourceCloudflareAuthenticatedOriginPullsRead(ctx, d, meta)
 	return []*schema.ResourceData{d}, nil
 }
+
+func resourceCloudflareAuthenticatedOriginPullsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("authenticated_origin_pulls_certificate") {
+		return nil
+	}
+
+	hostname := d.Get("hostname").(string)
+	oldCert, newCert := d.GetChange("authenticated_origin_pulls_certificate")
+
+	// A certificate that isn't known yet, such as one being uploaded in the
+	// same run, is always going to be set.
+	newCertID := newCert.(string)
+	if !d.NewValueKnown("authenticated_origin_pulls_certificate") {
+		newCertID = "unknown"
+	}
+
+	// Swapping the certificate is done in place but moving between zone-level
+	// and Per-Zone Authenticated Origin Pulls means a different setting must be
+	// disabled first.
+	if authenticatedOriginPullsMode(hostname, oldCert.(string)) != authenticatedOriginPullsMode(hostname, newCertID) {
+		return d.ForceNew("authenticated_origin_pulls_certificate")
+	}
+
+	return nil
+}
+
+// authenticatedOriginPullsMode returns which form of Authenticated Origin
+// Pulls is managed for the combination of hostname and certificate.
+func authenticatedOriginPullsMode(hostname, certID string) string {
+	switch {
+	case hostname != "" && certID != "":
+		return authenticatedOriginPullsModePerHostname
+	case certID != "":
+		return authenticatedOriginPullsModePerZone
+	default:
+		return authenticatedOriginPullsModeGlobal
+	}
+}
+
+func authenticatedOriginPullsID(zoneID, hostname, certID string) string {
+	switch authenticatedOriginPullsMode(hostname, certID) {
+	case authenticatedOriginPullsModePerHostname:
+		return stringChecksum(fmt.Sprintf("%s/%s/%s/%s", authenticatedOriginPullsModePerHostname, zoneID, hostname, certID))
+	case authenticatedOriginPullsModePerZone:
+		return stringChecksum(fmt.Sprintf("%s/%s/%s", authenticatedOriginPullsModePerZone, zoneID, certID))
+	default:
+		return stringChecksum(fmt.Sprintf("%s/%s/", authenticatedOriginPullsModeGlobal, zoneID))
+	}
+}
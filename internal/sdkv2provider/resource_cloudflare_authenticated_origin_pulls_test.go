@@ -3,9 +3,11 @@ package sdkv2provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareAuthenticatedOriginPullsGlobal(t *testing.T) {
@@ -74,6 +76,50 @@ func TestAccCloudflareAuthenticatedOriginPullsPerHostname(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareAuthenticatedOriginPullsHostnameRequiresCertificate(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareAuthenticatedOriginPullsHostnameOnlyConfig(zoneID, rnd),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`all of ` + "`" + `authenticated_origin_pulls_certificate,hostname` + "`" + ` must be specified`),
+			},
+		},
+	})
+}
+
+func TestAuthenticatedOriginPullsMode(t *testing.T) {
+	testCases := map[string]struct {
+		hostname string
+		certID   string
+		expected string
+	}{
+		"zone level":   {expected: authenticatedOriginPullsModeGlobal},
+		"per zone":     {certID: "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60", expected: authenticatedOriginPullsModePerZone},
+		"per hostname": {hostname: "app.example.com", certID: "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60", expected: authenticatedOriginPullsModePerHostname},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, authenticatedOriginPullsMode(tc.hostname, tc.certID))
+		})
+	}
+}
+
+func testAccCheckCloudflareAuthenticatedOriginPullsHostnameOnlyConfig(zoneID, name string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_authenticated_origin_pulls" "%[2]s" {
+	  zone_id  = "%[1]s"
+	  hostname = "%[2]s.example.com"
+	  enabled  = true
+  }`, zoneID, name)
+}
+
 func testAccCheckCloudflareAuthenticatedOriginPullsGlobalConfig(zoneID, name string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_authenticated_origin_pulls" "%[2]s" {
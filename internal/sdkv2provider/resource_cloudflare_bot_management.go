@@ -0,0 +1,176 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// botManagement are the zone level Bot Management settings. Which of them
+// are returned and can be changed depends on the plan of the zone so all of
+// them are optional.
+type botManagement struct {
+	EnableJS                *bool   `json:"enable_js,omitempty"`
+	FightMode               *bool   `json:"fight_mode,omitempty"`
+	SBFMDefinitelyAutomated *string `json:"sbfm_definitely_automated,omitempty"`
+	SBFMLikelyAutomated     *string `json:"sbfm_likely_automated,omitempty"`
+	SBFMVerifiedBots        *string `json:"sbfm_verified_bots,omitempty"`
+	OptimizeWordpress       *bool   `json:"optimize_wordpress,omitempty"`
+	AutoUpdateModel         *bool   `json:"auto_update_model,omitempty"`
+	AIBotsProtection        *string `json:"ai_bots_protection,omitempty"`
+}
+
+func resourceCloudflareBotManagement() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareBotManagementSchema(),
+		CreateContext: resourceCloudflareBotManagementUpdate,
+		ReadContext:   resourceCloudflareBotManagementRead,
+		UpdateContext: resourceCloudflareBotManagementUpdate,
+		DeleteContext: resourceCloudflareBotManagementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareBotManagementImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Bot Management resource to configure Bot
+			Fight Mode, Super Bot Fight Mode and Bot Management for
+			Enterprise. Which settings can be changed depends on the plan of
+			the zone. Deleting the resource leaves the Bot Management settings
+			of the zone unchanged.
+		`),
+	}
+}
+
+func resourceCloudflareBotManagementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, botManagementURI(zoneID), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Bot Management settings for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Bot Management settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings botManagement
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Bot Management settings for zone %q: %w", zoneID, err))
+	}
+
+	if settings.EnableJS != nil {
+		d.Set("enable_js", *settings.EnableJS)
+	}
+	if settings.FightMode != nil {
+		d.Set("fight_mode", *settings.FightMode)
+	}
+	if settings.SBFMDefinitelyAutomated != nil {
+		d.Set("sbfm_definitely_automated", *settings.SBFMDefinitelyAutomated)
+	}
+	if settings.SBFMLikelyAutomated != nil {
+		d.Set("sbfm_likely_automated", *settings.SBFMLikelyAutomated)
+	}
+	if settings.SBFMVerifiedBots != nil {
+		d.Set("sbfm_verified_bots", *settings.SBFMVerifiedBots)
+	}
+	if settings.OptimizeWordpress != nil {
+		d.Set("optimize_wordpress", *settings.OptimizeWordpress)
+	}
+	if settings.AutoUpdateModel != nil {
+		d.Set("auto_update_model", *settings.AutoUpdateModel)
+	}
+	if settings.AIBotsProtection != nil {
+		d.Set("ai_bots_protection", *settings.AIBotsProtection)
+	}
+
+	return nil
+}
+
+func resourceCloudflareBotManagementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	settings := buildBotManagement(d)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Bot Management settings for zone %q", zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodPut, botManagementURI(zoneID), settings, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Bot Management settings for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareBotManagementRead(ctx, d, meta)
+}
+
+func resourceCloudflareBotManagementDelete(ctx context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// Bot Management settings share the lifetime of the zone and their
+	// defaults depend on its plan so there is nothing to restore. This is a
+	// no-op.
+	return nil
+}
+
+func resourceCloudflareBotManagementImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Bot Management settings for zone %q", zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+
+	resourceCloudflareBotManagementRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// buildBotManagement only includes the settings which are configured or were
+// returned by the API as settings which aren't available on the plan of the
+// zone are rejected.
+func buildBotManagement(d *schema.ResourceData) botManagement {
+	configured := func(key string) bool {
+		_, ok := d.GetOkExists(key)
+		return ok
+	}
+
+	var settings botManagement
+	if configured("enable_js") {
+		settings.EnableJS = cloudflare.BoolPtr(d.Get("enable_js").(bool))
+	}
+	if configured("fight_mode") {
+		settings.FightMode = cloudflare.BoolPtr(d.Get("fight_mode").(bool))
+	}
+	if configured("sbfm_definitely_automated") {
+		settings.SBFMDefinitelyAutomated = cloudflare.StringPtr(d.Get("sbfm_definitely_automated").(string))
+	}
+	if configured("sbfm_likely_automated") {
+		settings.SBFMLikelyAutomated = cloudflare.StringPtr(d.Get("sbfm_likely_automated").(string))
+	}
+	if configured("sbfm_verified_bots") {
+		settings.SBFMVerifiedBots = cloudflare.StringPtr(d.Get("sbfm_verified_bots").(string))
+	}
+	if configured("optimize_wordpress") {
+		settings.OptimizeWordpress = cloudflare.BoolPtr(d.Get("optimize_wordpress").(bool))
+	}
+	if configured("auto_update_model") {
+		settings.AutoUpdateModel = cloudflare.BoolPtr(d.Get("auto_update_model").(bool))
+	}
+	if configured("ai_bots_protection") {
+		settings.AIBotsProtection = cloudflare.StringPtr(d.Get("ai_bots_protection").(string))
+	}
+
+	return settings
+}
+
+func botManagementURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/bot_management", zoneID)
+}
@@ -0,0 +1,75 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareBotManagement_SBFM(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_bot_management." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, "managed_challenge", "allow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enable_js", "true"),
+					resource.TestCheckResourceAttr(name, "sbfm_definitely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(name, "sbfm_likely_automated", "managed_challenge"),
+					resource.TestCheckResourceAttr(name, "sbfm_verified_bots", "allow"),
+					resource.TestCheckResourceAttr(name, "optimize_wordpress", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, "block", "block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "sbfm_definitely_automated", "block"),
+					resource.TestCheckResourceAttr(name, "sbfm_likely_automated", "block"),
+					resource.TestCheckResourceAttr(name, "sbfm_verified_bots", "block"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestBuildBotManagementOnlyIncludesConfiguredSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareBotManagementSchema(), map[string]interface{}{
+		"zone_id":            "0da42c8d2132a9ddaf714f9e7c920711",
+		"fight_mode":         false,
+		"ai_bots_protection": "block",
+	})
+
+	assert.Equal(t, botManagement{
+		FightMode:        cloudflare.BoolPtr(false),
+		AIBotsProtection: cloudflare.StringPtr("block"),
+	}, buildBotManagement(d))
+}
+
+func testAccCloudflareBotManagementSBFMConfig(rnd, zoneID, automatedAction, verifiedBotsAction string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_bot_management" "%[1]s" {
+  zone_id                   = "%[2]s"
+  enable_js                 = true
+  sbfm_definitely_automated = "%[3]s"
+  sbfm_likely_automated     = "%[3]s"
+  sbfm_verified_bots        = "%[4]s"
+  optimize_wordpress        = true
+}
+`, rnd, zoneID, automatedAction, verifiedBotsAction)
+}
@@ -0,0 +1,141 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// byoIPPrefixDelegation allows another account to use part of a BYOIP prefix.
+type byoIPPrefixDelegation struct {
+	ID                 string `json:"id,omitempty"`
+	CIDR               string `json:"cidr"`
+	DelegatedAccountID string `json:"delegated_account_id"`
+}
+
+func resourceCloudflareBYOIPPrefixDelegation() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareBYOIPPrefixDelegationSchema(),
+		CreateContext: resourceCloudflareBYOIPPrefixDelegationCreate,
+		ReadContext:   resourceCloudflareBYOIPPrefixDelegationRead,
+		DeleteContext: resourceCloudflareBYOIPPrefixDelegationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareBYOIPPrefixDelegationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides the ability to delegate part of a Bring-Your-Own-IP prefix
+			(BYOIP) to another account so that it can use the IPs.
+		`),
+	}
+}
+
+func resourceCloudflareBYOIPPrefixDelegationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	prefixID := d.Get("prefix_id").(string)
+
+	delegation := byoIPPrefixDelegation{
+		CIDR:               d.Get("cidr").(string),
+		DelegatedAccountID: d.Get("delegated_account_id").(string),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Delegating %s of IP prefix %s to account %s", delegation.CIDR, prefixID, delegation.DelegatedAccountID))
+
+	res, err := client.Raw(ctx, http.MethodPost, byoIPPrefixDelegationURI(accountID, prefixID, ""), delegation, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating delegation for IP prefix %q: %w", prefixID, err))
+	}
+
+	var created byoIPPrefixDelegation
+	if err := json.Unmarshal(res, &created); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal IP prefix delegation: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareBYOIPPrefixDelegationRead(ctx, d, meta)
+}
+
+func resourceCloudflareBYOIPPrefixDelegationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	prefixID := d.Get("prefix_id").(string)
+
+	// Delegations can only be listed per prefix.
+	res, err := client.Raw(ctx, http.MethodGet, byoIPPrefixDelegationURI(accountID, prefixID, ""), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing delegations for IP prefix %q: %w", prefixID, err))
+	}
+
+	var delegations []byoIPPrefixDelegation
+	if err := json.Unmarshal(res, &delegations); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal IP prefix delegations: %w", err))
+	}
+
+	for _, delegation := range delegations {
+		if delegation.ID != d.Id() {
+			continue
+		}
+
+		d.Set("cidr", delegation.CIDR)
+		d.Set("delegated_account_id", delegation.DelegatedAccountID)
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("IP prefix delegation %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareBYOIPPrefixDelegationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	prefixID := d.Get("prefix_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting IP prefix delegation using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, byoIPPrefixDelegationURI(accountID, prefixID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting IP prefix delegation %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareBYOIPPrefixDelegationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/prefixID/delegationID"`, d.Id())
+	}
+
+	accountID, prefixID, delegationID := attributes[0], attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing IP prefix delegation: id %s for prefix %s", delegationID, prefixID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("prefix_id", prefixID)
+	d.SetId(delegationID)
+
+	resourceCloudflareBYOIPPrefixDelegationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func byoIPPrefixDelegationURI(accountID, prefixID, delegationID string) string {
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations", accountID, prefixID)
+	if delegationID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, delegationID)
+	}
+	return uri
+}
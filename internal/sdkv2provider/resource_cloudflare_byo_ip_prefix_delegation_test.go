@@ -0,0 +1,99 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareBYOIPPrefixDelegation_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_byo_ip_prefix_delegation.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	prefixID := os.Getenv("CLOUDFLARE_BYO_IP_PREFIX_ID")
+	cidr := os.Getenv("CLOUDFLARE_BYO_IP_PREFIX_DELEGATION_CIDR")
+	delegatedAccountID := os.Getenv("CLOUDFLARE_BYO_IP_PREFIX_DELEGATED_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckBYOIPPrefixDelegation(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "cloudflare_byo_ip_prefix_delegation" "%[1]s" {
+  account_id           = "%[2]s"
+  prefix_id            = "%[3]s"
+  cidr                 = "%[4]s"
+  delegated_account_id = "%[5]s"
+}`, rnd, accountID, prefixID, cidr, delegatedAccountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(name, "id"),
+					resource.TestCheckResourceAttr(name, "prefix_id", prefixID),
+					resource.TestCheckResourceAttr(name, "cidr", cidr),
+					resource.TestCheckResourceAttr(name, "delegated_account_id", delegatedAccountID),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/%s/", accountID, prefixID),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareBYOIPPrefixDelegationRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/addressing/prefixes/2af39739cc4e3b5910c918468bb89828/delegations", r.URL.Path)
+
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"id": "d933b1530bc56c9953cf8ce166da8004", "cidr": "192.0.2.0/26", "delegated_account_id": "b1946ac92492d2347c6235b4d2611184", "parent_prefix_id": "2af39739cc4e3b5910c918468bb89828"}
+		]`))
+	}))
+
+	testCases := map[string]struct {
+		id                 string
+		expectedID         string
+		expectedCIDR       string
+		expectedDelegateTo string
+	}{
+		"existing delegation": {
+			id:                 "d933b1530bc56c9953cf8ce166da8004",
+			expectedID:         "d933b1530bc56c9953cf8ce166da8004",
+			expectedCIDR:       "192.0.2.0/26",
+			expectedDelegateTo: "b1946ac92492d2347c6235b4d2611184",
+		},
+		"removed delegation": {id: "5c0ff2d8b0d35ed1b9bc64dd3a5e1d6f"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudflareBYOIPPrefixDelegationSchema(), map[string]interface{}{
+				consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+				"prefix_id":               "2af39739cc4e3b5910c918468bb89828",
+			})
+			d.SetId(tc.id)
+
+			diags := resourceCloudflareBYOIPPrefixDelegationRead(context.Background(), d, client)
+			assert.False(t, diags.HasError())
+
+			assert.Equal(t, tc.expectedID, d.Id())
+			assert.Equal(t, tc.expectedCIDR, d.Get("cidr"))
+			assert.Equal(t, tc.expectedDelegateTo, d.Get("delegated_account_id"))
+		})
+	}
+}
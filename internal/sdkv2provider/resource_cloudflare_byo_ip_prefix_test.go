@@ -41,6 +41,39 @@ func TestAccCloudflareBYOIPPrefix(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareBYOIPPrefix_Advertisement(t *testing.T) {
+	prefixID := os.Getenv("CLOUDFLARE_BYO_IP_PREFIX_ID")
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_byo_ip_prefix.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+			testAccPreCheckBYOIPPrefix(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareBYOIPPrefixAdvertisementConfig(rnd, accountID, prefixID, "off"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", prefixID),
+					resource.TestCheckResourceAttr(name, "advertisement", "off"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareBYOIPPrefixAdvertisementConfig(rnd, accountID, prefixID, "on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "id", prefixID),
+					resource.TestCheckResourceAttr(name, "advertisement", "on"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareBYOIPPrefixConfig(prefixID, description, name string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_byo_ip_prefix" "%[3]s" {
@@ -48,3 +81,12 @@ func testAccCheckCloudflareBYOIPPrefixConfig(prefixID, description, name string)
 	  description = "%[2]s"
   }`, prefixID, description, name)
 }
+
+func testAccCheckCloudflareBYOIPPrefixAdvertisementConfig(name, accountID, prefixID, advertisement string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_byo_ip_prefix" "%[1]s" {
+	  account_id = "%[2]s"
+	  prefix_id = "%[3]s"
+	  advertisement = "%[4]s"
+  }`, name, accountID, prefixID, advertisement)
+}
@@ -0,0 +1,162 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var cloudConnectorRuleProviders = []string{"aws_s3", "gcp_storage", "cloudflare_r2", "azure_storage"}
+
+// cloudConnectorRule routes the requests matching its expression to a cloud
+// provider. Rules are evaluated in order.
+type cloudConnectorRule struct {
+	ID          string                       `json:"id,omitempty"`
+	Expression  string                       `json:"expression"`
+	Provider    string                       `json:"provider"`
+	Description string                       `json:"description,omitempty"`
+	Enabled     bool                         `json:"enabled"`
+	Parameters  cloudConnectorRuleParameters `json:"parameters"`
+}
+
+type cloudConnectorRuleParameters struct {
+	Host string `json:"host"`
+}
+
+func resourceCloudflareCloudConnectorRules() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareCloudConnectorRulesSchema(),
+		CreateContext: resourceCloudflareCloudConnectorRulesUpdate,
+		ReadContext:   resourceCloudflareCloudConnectorRulesRead,
+		UpdateContext: resourceCloudflareCloudConnectorRulesUpdate,
+		DeleteContext: resourceCloudflareCloudConnectorRulesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareCloudConnectorRulesImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Cloud Connector rules resource. Cloud
+			Connector rules route the matching traffic of a zone to a cloud
+			provider. A zone has a single ordered list of rules so only one
+			resource should be used per zone.
+		`),
+	}
+}
+
+func resourceCloudflareCloudConnectorRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, cloudConnectorRulesURI(zoneID), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Cloud Connector rules for zone %s no longer exist", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Cloud Connector rules for zone %q: %w", zoneID, err))
+	}
+
+	var rules []cloudConnectorRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Cloud Connector rules: %w", err))
+	}
+
+	if err := d.Set("rules", flattenCloudConnectorRules(rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set rules: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareCloudConnectorRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Cloud Connector rules for zone %s", zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodPut, cloudConnectorRulesURI(zoneID), buildCloudConnectorRules(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Cloud Connector rules for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareCloudConnectorRulesRead(ctx, d, meta)
+}
+
+func resourceCloudflareCloudConnectorRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Cloud Connector rules for zone %s", zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodPut, cloudConnectorRulesURI(zoneID), []cloudConnectorRule{}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Cloud Connector rules for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareCloudConnectorRulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Cloud Connector rules for zone %s", zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(zoneID)
+
+	resourceCloudflareCloudConnectorRulesRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildCloudConnectorRules(d *schema.ResourceData) []cloudConnectorRule {
+	rules := make([]cloudConnectorRule, 0)
+
+	for _, r := range d.Get("rules").([]interface{}) {
+		rule := r.(map[string]interface{})
+		rules = append(rules, cloudConnectorRule{
+			Expression:  rule["expression"].(string),
+			Provider:    rule["provider"].(string),
+			Description: rule["description"].(string),
+			Enabled:     rule["enabled"].(bool),
+			Parameters: cloudConnectorRuleParameters{
+				Host: rule["parameters"].([]interface{})[0].(map[string]interface{})["host"].(string),
+			},
+		})
+	}
+
+	return rules
+}
+
+func flattenCloudConnectorRules(rules []cloudConnectorRule) []interface{} {
+	flattened := make([]interface{}, 0, len(rules))
+
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"id":          rule.ID,
+			"expression":  rule.Expression,
+			"provider":    rule.Provider,
+			"description": rule.Description,
+			"enabled":     rule.Enabled,
+			"parameters": []interface{}{map[string]interface{}{
+				"host": rule.Parameters.Host,
+			}},
+		})
+	}
+
+	return flattened
+}
+
+func cloudConnectorRulesURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/cloud_connector/rules", zoneID)
+}
@@ -0,0 +1,171 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareCloudConnectorRules_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("cloudflare_cloud_connector_rules.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareCloudConnectorRulesDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareCloudConnectorRules(rnd, zoneID, "aws_s3", "cloudflare_r2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, consts.ZoneIDSchemaKey, zoneID),
+					resource.TestCheckResourceAttr(name, "rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "rules.0.provider", "aws_s3"),
+					resource.TestCheckResourceAttr(name, "rules.0.expression", `http.request.uri.path wildcard "/aws_s3/*"`),
+					resource.TestCheckResourceAttr(name, "rules.0.parameters.0.host", "aws_s3.example.com"),
+					resource.TestCheckResourceAttr(name, "rules.0.enabled", "true"),
+					resource.TestCheckResourceAttr(name, "rules.1.provider", "cloudflare_r2"),
+				),
+			},
+			{
+				Config: testAccCloudflareCloudConnectorRules(rnd, zoneID, "cloudflare_r2", "aws_s3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rules.#", "2"),
+					resource.TestCheckResourceAttr(name, "rules.0.provider", "cloudflare_r2"),
+					resource.TestCheckResourceAttr(name, "rules.1.provider", "aws_s3"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareCloudConnectorRulesUpdate(t *testing.T) {
+	var stored json.RawMessage
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/0da42c8d2132a9ddaf714f9e7c920711/cloud_connector/rules", r.URL.Path)
+
+		if r.Method == http.MethodPut {
+			var rules []cloudConnectorRule
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&rules))
+			for i := range rules {
+				rules[i].ID = fmt.Sprintf("rule-%d", i)
+			}
+			stored, _ = json.Marshal(rules)
+		}
+
+		testclient.WriteResult(w, stored)
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareCloudConnectorRulesSchema(), map[string]interface{}{
+		consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+		"rules": []interface{}{
+			map[string]interface{}{
+				"expression": `http.request.uri.path wildcard "/images/*"`,
+				"provider":   "gcp_storage",
+				"enabled":    true,
+				"parameters": []interface{}{map[string]interface{}{"host": "images.storage.googleapis.com"}},
+			},
+			map[string]interface{}{
+				"expression":  `http.request.uri.path wildcard "/videos/*"`,
+				"provider":    "azure_storage",
+				"description": "videos",
+				"enabled":     false,
+				"parameters":  []interface{}{map[string]interface{}{"host": "videos.blob.core.windows.net"}},
+			},
+		},
+	})
+
+	diags := resourceCloudflareCloudConnectorRulesUpdate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, "0da42c8d2132a9ddaf714f9e7c920711", d.Id())
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"id":          "rule-0",
+			"expression":  `http.request.uri.path wildcard "/images/*"`,
+			"provider":    "gcp_storage",
+			"description": "",
+			"enabled":     true,
+			"parameters":  []interface{}{map[string]interface{}{"host": "images.storage.googleapis.com"}},
+		},
+		map[string]interface{}{
+			"id":          "rule-1",
+			"expression":  `http.request.uri.path wildcard "/videos/*"`,
+			"provider":    "azure_storage",
+			"description": "videos",
+			"enabled":     false,
+			"parameters":  []interface{}{map[string]interface{}{"host": "videos.blob.core.windows.net"}},
+		},
+	}, d.Get("rules"))
+}
+
+func testAccCloudflareCloudConnectorRules(rnd, zoneID, firstProvider, secondProvider string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_cloud_connector_rules" "%[1]s" {
+  zone_id = "%[2]s"
+
+  rules {
+    expression  = "http.request.uri.path wildcard \"/%[3]s/*\""
+    provider    = "%[3]s"
+    description = "%[1]s %[3]s"
+    parameters {
+      host = "%[3]s.example.com"
+    }
+  }
+
+  rules {
+    expression  = "http.request.uri.path wildcard \"/%[4]s/*\""
+    provider    = "%[4]s"
+    description = "%[1]s %[4]s"
+    parameters {
+      host = "%[4]s.example.com"
+    }
+  }
+}
+`, rnd, zoneID, firstProvider, secondProvider)
+}
+
+func testAccCheckCloudflareCloudConnectorRulesDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_cloud_connector_rules" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, cloudConnectorRulesURI(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var rules []cloudConnectorRule
+		if err := json.Unmarshal(res, &rules); err != nil {
+			return err
+		}
+
+		if len(rules) > 0 {
+			return fmt.Errorf("Cloud Connector rules still exist")
+		}
+	}
+
+	return nil
+}
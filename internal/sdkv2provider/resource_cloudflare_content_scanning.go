@@ -0,0 +1,125 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	contentScanningEnabled  = "enabled"
+	contentScanningDisabled = "disabled"
+)
+
+// contentScanningStatus is the WAF content scanning status of a zone.
+type contentScanningStatus struct {
+	Value    string `json:"value"`
+	Modified string `json:"modified,omitempty"`
+}
+
+func resourceCloudflareContentScanning() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareContentScanningSchema(),
+		CreateContext: resourceCloudflareContentScanningUpdate,
+		ReadContext:   resourceCloudflareContentScanningRead,
+		UpdateContext: resourceCloudflareContentScanningUpdate,
+		DeleteContext: resourceCloudflareContentScanningDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareContentScanningImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare WAF content scanning resource. Content
+			scanning looks for malicious content in files uploaded to the
+			zone. Deleting the resource disables content scanning.
+		`),
+	}
+}
+
+func resourceCloudflareContentScanningRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, contentScanningURI(zoneID, "settings"), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Content scanning status for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading content scanning status for zone %q: %w", zoneID, err))
+	}
+
+	var status contentScanningStatus
+	if err := json.Unmarshal(res, &status); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal content scanning status for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("enabled", status.Value == contentScanningEnabled)
+
+	return nil
+}
+
+func resourceCloudflareContentScanningUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	if err := setContentScanning(ctx, client, zoneID, d.Get("enabled").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareContentScanningRead(ctx, d, meta)
+}
+
+func resourceCloudflareContentScanningDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	if err := setContentScanning(ctx, client, zoneID, false); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceCloudflareContentScanningImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare content scanning status for zone %q", zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+
+	resourceCloudflareContentScanningRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setContentScanning(ctx context.Context, client *cloudflare.API, zoneID string, enabled bool) error {
+	action, value := "disable", contentScanningDisabled
+	if enabled {
+		action, value = "enable", contentScanningEnabled
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting content scanning for zone %q to %s", zoneID, value))
+
+	if _, err := client.Raw(ctx, http.MethodPost, contentScanningURI(zoneID, action), nil, nil); err != nil {
+		return fmt.Errorf("error setting content scanning for zone %q to %s: %w", zoneID, value, err)
+	}
+
+	return nil
+}
+
+func contentScanningURI(zoneID, path string) string {
+	return fmt.Sprintf("/zones/%s/content-upload-scan/%s", zoneID, path)
+}
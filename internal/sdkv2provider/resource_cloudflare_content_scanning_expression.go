@@ -0,0 +1,167 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// contentScanningExpression is a custom expression locating the content
+// objects of a request to scan.
+type contentScanningExpression struct {
+	ID      string `json:"id,omitempty"`
+	Payload string `json:"payload"`
+}
+
+func resourceCloudflareContentScanningExpression() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareContentScanningExpressionSchema(),
+		CreateContext: resourceCloudflareContentScanningExpressionCreate,
+		ReadContext:   resourceCloudflareContentScanningExpressionRead,
+		DeleteContext: resourceCloudflareContentScanningExpressionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareContentScanningExpressionImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare WAF content scanning custom expression
+			resource. Custom expressions tell content scanning where to find
+			the content objects of a request, in addition to the ones it
+			detects on its own.
+		`),
+	}
+}
+
+func resourceCloudflareContentScanningExpressionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	payload := d.Get("payload").(string)
+
+	existing, err := listContentScanningExpressions(ctx, client, zoneID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing content scanning expressions for zone %q: %w", zoneID, err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating content scanning expression for zone %q: %s", zoneID, payload))
+
+	// The API responds with all the expressions of the zone rather than the
+	// created one.
+	res, err := client.Raw(ctx, http.MethodPost, contentScanningURI(zoneID, "payloads"), []contentScanningExpression{{Payload: payload}}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating content scanning expression for zone %q: %w", zoneID, err))
+	}
+
+	var expressions []contentScanningExpression
+	if err := json.Unmarshal(res, &expressions); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal content scanning expressions: %w", err))
+	}
+
+	created, ok := findCreatedContentScanningExpression(existing, expressions, payload)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("failed to find the created content scanning expression for zone %q", zoneID))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceCloudflareContentScanningExpressionRead(ctx, d, meta)
+}
+
+func resourceCloudflareContentScanningExpressionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	expressions, err := listContentScanningExpressions(ctx, client, zoneID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Content scanning expressions for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing content scanning expressions for zone %q: %w", zoneID, err))
+	}
+
+	for _, expression := range expressions {
+		if expression.ID == d.Id() {
+			d.Set("payload", expression.Payload)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Content scanning expression %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareContentScanningExpressionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting content scanning expression %q for zone %q", d.Id(), zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, contentScanningURI(zoneID, "payloads/"+d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting content scanning expression %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareContentScanningExpressionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/expressionID\"", d.Id())
+	}
+
+	zoneID, expressionID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare content scanning expression: id %s for zone %s", expressionID, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(expressionID)
+
+	resourceCloudflareContentScanningExpressionRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func listContentScanningExpressions(ctx context.Context, client *cloudflare.API, zoneID string) ([]contentScanningExpression, error) {
+	res, err := client.Raw(ctx, http.MethodGet, contentScanningURI(zoneID, "payloads"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var expressions []contentScanningExpression
+	if err := json.Unmarshal(res, &expressions); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal content scanning expressions: %w", err)
+	}
+
+	return expressions, nil
+}
+
+// findCreatedContentScanningExpression returns the expression with the given
+// payload which wasn't part of the expressions of the zone before creating it.
+func findCreatedContentScanningExpression(before, after []contentScanningExpression, payload string) (contentScanningExpression, bool) {
+	existing := make(map[string]struct{}, len(before))
+	for _, expression := range before {
+		existing[expression.ID] = struct{}{}
+	}
+
+	for _, expression := range after {
+		if _, ok := existing[expression.ID]; !ok && expression.Payload == payload {
+			return expression, true
+		}
+	}
+
+	return contentScanningExpression{}, false
+}
@@ -0,0 +1,91 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareContentScanningExpression_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_content_scanning_expression." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareContentScanningExpressionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareContentScanningExpressionConfig(rnd, zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "payload", fmt.Sprintf(`lookup_json_string(http.request.body.raw, "%s")`, rnd)),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func TestFindCreatedContentScanningExpression(t *testing.T) {
+	payload := `lookup_json_string(http.request.body.raw, "file")`
+	before := []contentScanningExpression{
+		{ID: "a350a054caa840c9becd89c3b4f0195b", Payload: payload},
+	}
+	after := []contentScanningExpression{
+		{ID: "a350a054caa840c9becd89c3b4f0195b", Payload: payload},
+		{ID: "d6f8d3f5a8b147d2a7c3ef8c20a6b1e4", Payload: `lookup_json_string(http.request.body.raw, "avatar")`},
+		{ID: "f2e1c5d4b3a24e6f9c8b7a6d5e4f3c2b", Payload: payload},
+	}
+
+	created, ok := findCreatedContentScanningExpression(before, after, payload)
+	assert.True(t, ok)
+	assert.Equal(t, "f2e1c5d4b3a24e6f9c8b7a6d5e4f3c2b", created.ID)
+
+	_, ok = findCreatedContentScanningExpression(after, after, payload)
+	assert.False(t, ok)
+}
+
+func testAccCloudflareContentScanningExpressionConfig(rnd, zoneID, field string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_content_scanning_expression" "%[1]s" {
+  zone_id = "%[2]s"
+  payload = "lookup_json_string(http.request.body.raw, \"%[3]s\")"
+}
+`, rnd, zoneID, field)
+}
+
+func testAccCheckCloudflareContentScanningExpressionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_content_scanning_expression" {
+			continue
+		}
+
+		expressions, err := listContentScanningExpressions(context.Background(), client, rs.Primary.Attributes["zone_id"])
+		if err != nil {
+			return err
+		}
+
+		for _, expression := range expressions {
+			if expression.ID == rs.Primary.ID {
+				return fmt.Errorf("content scanning expression %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
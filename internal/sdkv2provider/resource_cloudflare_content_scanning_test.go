@@ -0,0 +1,78 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareContentScanning_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_content_scanning." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareContentScanningDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareContentScanningConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareContentScanningConfig(rnd, zoneID, false),
+				Check:  resource.TestCheckResourceAttr(name, "enabled", "false"),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareContentScanningConfig(rnd, zoneID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_content_scanning" "%[1]s" {
+  zone_id = "%[2]s"
+  enabled = %[3]t
+}
+`, rnd, zoneID, enabled)
+}
+
+func testAccCheckCloudflareContentScanningDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_content_scanning" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, contentScanningURI(rs.Primary.ID, "settings"), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var status contentScanningStatus
+		if err := json.Unmarshal(res, &status); err != nil {
+			return err
+		}
+		if status.Value != contentScanningDisabled {
+			return fmt.Errorf("content scanning for zone %s is still %s", rs.Primary.ID, status.Value)
+		}
+	}
+
+	return nil
+}
@@ -17,6 +17,22 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	customHostnameSSLStatusPendingValidation = "pending_validation"
+	customHostnameSSLStatusActive            = "active"
+)
+
+// customHostnameSSLFailedStatuses are the SSL statuses of a custom hostname
+// from which the certificate will not become active without intervention.
+var customHostnameSSLFailedStatuses = []string{
+	"deleted",
+	"expired",
+	"initializing_timed_out",
+	"validation_timed_out",
+	"issuance_timed_out",
+	"deployment_timed_out",
+}
+
 func resourceCloudflareCustomHostname() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareCustomHostnameSchema(),
@@ -27,6 +43,10 @@ func resourceCloudflareCustomHostname() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareCustomHostnameImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides a Cloudflare custom hostname (also known as SSL for SaaS) resource.
 		`),
@@ -140,25 +160,19 @@ func resourceCloudflareCustomHostnameCreate(ctx context.Context, d *schema.Resou
 	}
 
 	hostnameID := newCertificate.Result.ID
+	d.SetId(hostnameID)
 
 	if d.Get("wait_for_ssl_pending_validation").(bool) {
-		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate)-time.Minute, func() *resource.RetryError {
-			customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
-			tflog.Debug(ctx, fmt.Sprintf("custom hostname ssl status %s", customHostname.SSL.Status))
-			if err != nil {
-				return resource.NonRetryableError(errors.Wrap(err, "failed to fetch custom hostname"))
-			}
-			if customHostname.SSL != nil && customHostname.SSL.Status != "pending_validation" {
-				return resource.RetryableError(fmt.Errorf("hostname ssl sub-object is not yet in pending_validation status"))
-			}
-			return nil
-		})
-		if err != nil {
+		if err := waitForCustomHostnameSSLStatus(ctx, client, zoneID, hostnameID, customHostnameSSLStatusPendingValidation, d.Timeout(schema.TimeoutCreate)-time.Minute); err != nil {
 			return diag.FromErr(err)
 		}
 	}
 
-	d.SetId(newCertificate.Result.ID)
+	if d.Get("wait_for_ssl_active").(bool) {
+		if err := waitForCustomHostnameSSLStatus(ctx, client, zoneID, hostnameID, customHostnameSSLStatusActive, d.Timeout(schema.TimeoutCreate)-time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
@@ -174,6 +188,12 @@ func resourceCloudflareCustomHostnameUpdate(ctx context.Context, d *schema.Resou
 		return diag.FromErr(errors.Wrap(err, "failed to update custom hostname certificate"))
 	}
 
+	if d.Get("wait_for_ssl_active").(bool) {
+		if err := waitForCustomHostnameSSLStatus(ctx, client, zoneID, hostnameID, customHostnameSSLStatusActive, d.Timeout(schema.TimeoutUpdate)-time.Minute); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceCloudflareCustomHostnameRead(ctx, d, meta)
 }
 
@@ -194,6 +214,37 @@ func resourceCloudflareCustomHostnameImport(ctx context.Context, d *schema.Resou
 	return []*schema.ResourceData{d}, nil
 }
 
+// waitForCustomHostnameSSLStatus polls the custom hostname until its SSL
+// sub-object is in the given status. Polling stops early once the SSL
+// sub-object is in a status it can no longer recover from.
+func waitForCustomHostnameSSLStatus(ctx context.Context, client *cloudflare.API, zoneID, hostnameID, status string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		customHostname, err := client.CustomHostname(ctx, zoneID, hostnameID)
+		if err != nil {
+			return resource.NonRetryableError(errors.Wrap(err, "failed to fetch custom hostname"))
+		}
+		if customHostname.SSL == nil {
+			return nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("custom hostname ssl status %s", customHostname.SSL.Status))
+
+		if customHostname.SSL.Status == status {
+			return nil
+		}
+
+		if contains(customHostnameSSLFailedStatuses, customHostname.SSL.Status) {
+			messages := make([]string, 0, len(customHostname.SSL.ValidationErrors))
+			for _, e := range customHostname.SSL.ValidationErrors {
+				messages = append(messages, e.Message)
+			}
+			return resource.NonRetryableError(fmt.Errorf("hostname ssl sub-object is in %s status: %s", customHostname.SSL.Status, strings.Join(messages, "; ")))
+		}
+
+		return resource.RetryableError(fmt.Errorf("hostname ssl sub-object is not yet in %s status", status))
+	})
+}
+
 // buildCustomHostname takes the existing schema and returns a
 // `cloudflare.CustomHostname`.
 func buildCustomHostname(d *schema.ResourceData) cloudflare.CustomHostname {
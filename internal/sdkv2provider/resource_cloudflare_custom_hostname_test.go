@@ -2,16 +2,21 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -136,6 +141,48 @@ resource "cloudflare_custom_hostname" "%[2]s" {
 `, zoneID, rnd, domain)
 }
 
+func TestWaitForCustomHostnameSSLStatus(t *testing.T) {
+	testCases := map[string]struct {
+		statuses []string
+		err      string
+	}{
+		"becomes active":      {statuses: []string{"initializing", "pending_validation", "active"}},
+		"validation timeouts": {statuses: []string{"pending_validation", "validation_timed_out"}, err: "validation_timed_out status: TXT record not found"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				status := tc.statuses[requests]
+				if requests < len(tc.statuses)-1 {
+					requests++
+				}
+
+				testclient.WriteResult(w, json.RawMessage(fmt.Sprintf(`{
+					"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+					"hostname": "app.example.com",
+					"ssl": {
+						"status": %q,
+						"method": "txt",
+						"type": "dv",
+						"validation_errors": [{"message": "TXT record not found"}]
+					}
+				}`, status)))
+			}))
+
+			err := waitForCustomHostnameSSLStatus(context.Background(), client, "023e105f4ecef8ad9ca31a8372d0c353", "0d89c70d-ad9f-4843-b99f-6cc0252067e9", customHostnameSSLStatusActive, time.Minute)
+			if tc.err != "" {
+				assert.ErrorContains(t, err, tc.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, len(tc.statuses)-1, requests)
+		})
+	}
+}
+
 func TestAccCloudflareCustomHostname_WithCustomOriginServer(t *testing.T) {
 	t.Parallel()
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
@@ -143,8 +190,8 @@ func TestAccCloudflareCustomHostname_WithCustomOriginServer(t *testing.T) {
 	rnd := generateRandomResourceName()
 	resourceName := "cloudflare_custom_hostname." + rnd
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareCustomHostnameWithCustomOriginServer(zoneID, rnd, domain),
@@ -436,6 +483,7 @@ func TestAccCloudflareCustomHostname_Import(t *testing.T) {
 					"ssl.0.type",
 					"ssl.0.wildcard",
 					"wait_for_ssl_pending_validation",
+					"wait_for_ssl_active",
 				},
 			},
 		},
@@ -31,6 +31,10 @@ func resourceCloudflareCustomSsl() *schema.Resource {
 
 		Schema: resourceCloudflareCustomSslSchema(),
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceCloudflareCustomSSLV0().CoreConfigSchema().ImpliedType(),
@@ -61,27 +65,15 @@ func resourceCloudflareCustomSslCreate(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(fmt.Errorf("failed to find custom ssl in Create response: id was empty"))
 	}
 
-	retry := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		cert, err := client.SSLDetails(ctx, zoneID, res.ID)
-		if err != nil {
-			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom ssl cert: %w", err))
-		}
+	d.SetId(res.ID)
 
-		if cert.Status != "active" {
-			return resource.RetryableError(fmt.Errorf("waiting for certificate to become active"))
+	if d.Get("wait_for_active").(bool) {
+		if err := waitForCustomSSLActive(ctx, client, zoneID, res.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
 		}
-
-		d.SetId(res.ID)
-
-		resourceCloudflareCustomSslRead(ctx, d, meta)
-		return nil
-	})
-
-	if retry != nil {
-		return diag.FromErr(retry)
 	}
 
-	return nil
+	return resourceCloudflareCustomSslRead(ctx, d, meta)
 }
 
 func resourceCloudflareCustomSslUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -153,6 +145,11 @@ func resourceCloudflareCustomSslRead(ctx context.Context, d *schema.ResourceData
 	zcso.BundleMethod = record.BundleMethod
 	customSslOpts := flattenCustomSSLOptions(zcso)
 
+	// The API never returns the private key so the value in state is the only
+	// copy of it and must not be lost, otherwise the next plan would replace
+	// the certificate to upload the key again.
+	customSslOpts["private_key"] = d.Get("custom_ssl_options.0.private_key").(string)
+
 	d.SetId(record.ID)
 	d.Set("hosts", record.Hosts)
 	d.Set("issuer", record.Issuer)
@@ -194,6 +191,7 @@ func resourceCloudflareCustomSslImport(ctx context.Context, d *schema.ResourceDa
 	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Custom SSL Cert: id %s for zone %s", certID, zoneID))
 
 	d.Set("zone_id", zoneID)
+	d.Set("wait_for_active", true)
 	d.SetId(certID)
 
 	resourceCloudflareCustomSslRead(ctx, d, meta)
@@ -284,3 +282,41 @@ func flattenCustomSSLOptions(sslopt cloudflare.ZoneCustomSSLOptions) map[string]
 
 	return data
 }
+
+// suppressCustomSSLPrivateKeyDiff ignores a private key missing from the
+// state of an existing certificate, as can happen for certificates managed by
+// older versions of the provider, when the certificate itself is unchanged.
+// Changing custom_ssl_options replaces the certificate so the diff would
+// otherwise re-upload a key the API already has.
+func suppressCustomSSLPrivateKeyDiff(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == "" && new != "" && !d.HasChange("custom_ssl_options.0.certificate")
+}
+
+// customSSLPendingStatuses are the certificate states which are expected to
+// eventually transition to "active".
+var customSSLPendingStatuses = []string{"pending", "initializing", "pending_validation", "pending_issuance", "pending_deployment"}
+
+// waitForCustomSSLActive polls the custom certificate until it is active,
+// returning early if the certificate ends up in a state it will not recover
+// from (such as failing validation or being expired).
+func waitForCustomSSLActive(ctx context.Context, client *cloudflare.API, zoneID, certID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		cert, err := client.SSLDetails(ctx, zoneID, certID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("failed to fetch custom ssl cert: %w", err))
+		}
+
+		return customSSLStatusRetryError(certID, cert.Status)
+	})
+}
+
+func customSSLStatusRetryError(certID, status string) *resource.RetryError {
+	switch {
+	case status == "active":
+		return nil
+	case contains(customSSLPendingStatuses, status):
+		return resource.RetryableError(fmt.Errorf("waiting for custom ssl cert %s to become active, current status is %q", certID, status))
+	default:
+		return resource.NonRetryableError(fmt.Errorf("custom ssl cert %s failed to become active, current status is %q", certID, status))
+	}
+}
@@ -0,0 +1,131 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// d1Database is a D1 serverless SQL database.
+type d1Database struct {
+	UUID      string `json:"uuid,omitempty"`
+	Name      string `json:"name"`
+	Version   string `json:"version,omitempty"`
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+func resourceCloudflareD1Database() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareD1DatabaseSchema(),
+		CreateContext: resourceCloudflareD1DatabaseCreate,
+		ReadContext:   resourceCloudflareD1DatabaseRead,
+		DeleteContext: resourceCloudflareD1DatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareD1DatabaseImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare D1 database resource. D1 is Cloudflare's
+			serverless SQL database that can be queried from Workers.
+		`),
+	}
+}
+
+func resourceCloudflareD1DatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare D1 database %q", name))
+
+	res, err := client.Raw(ctx, http.MethodPost, d1DatabaseURI(accountID, ""), d1Database{Name: name}, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating D1 database %q for account %q: %w", name, accountID, err))
+	}
+
+	var database d1Database
+	if err := json.Unmarshal(res, &database); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal D1 database: %w", err))
+	}
+
+	d.SetId(database.UUID)
+
+	return resourceCloudflareD1DatabaseRead(ctx, d, meta)
+}
+
+func resourceCloudflareD1DatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, d1DatabaseURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("D1 database %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding D1 database %q: %w", d.Id(), err))
+	}
+
+	var database d1Database
+	if err := json.Unmarshal(res, &database); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal D1 database: %w", err))
+	}
+
+	d.Set("name", database.Name)
+	d.Set("uuid", database.UUID)
+	d.Set("version", database.Version)
+	d.Set("created_at", database.CreatedAt)
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare D1 database using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, d1DatabaseURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting D1 database %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareD1DatabaseImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/databaseUUID\"", d.Id())
+	}
+
+	accountID, databaseUUID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare D1 database: id %s for account %s", databaseUUID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(databaseUUID)
+
+	resourceCloudflareD1DatabaseRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func d1DatabaseURI(accountID, databaseUUID string) string {
+	uri := fmt.Sprintf("/accounts/%s/d1/database", accountID)
+	if databaseUUID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, databaseUUID)
+	}
+	return uri
+}
@@ -0,0 +1,72 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareD1Database_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_d1_database.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareD1DatabaseDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareD1DatabaseConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttrPair(name, "uuid", name, "id"),
+					resource.TestCheckResourceAttrSet(name, "version"),
+					resource.TestCheckResourceAttrSet(name, "created_at"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareD1DatabaseConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+`, rnd, accountID)
+}
+
+func testAccCheckCloudflareD1DatabaseDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_d1_database" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, d1DatabaseURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("D1 database still exists")
+		}
+	}
+
+	return nil
+}
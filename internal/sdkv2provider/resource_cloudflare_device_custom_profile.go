@@ -0,0 +1,100 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareDeviceCustomProfile() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareDeviceCustomProfileSchema(),
+		CreateContext: resourceCloudflareDeviceCustomProfileCreate,
+		ReadContext:   resourceCloudflareDeviceCustomProfileRead,
+		UpdateContext: resourceCloudflareDeviceCustomProfileUpdate,
+		DeleteContext: resourceCloudflareDeviceSettingsPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDeviceCustomProfileImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Device Custom Profile resource. Custom
+			profiles configure settings applied to WARP devices matching
+			the profile's ` + "`match`" + ` expression. The default account
+			profile is managed using ` + "`cloudflare_device_settings_policy`" + `.
+		`),
+	}
+}
+
+func resourceCloudflareDeviceCustomProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare device custom profile: accountID=%s", accountID))
+
+	req, err := buildDeviceSettingsPolicyRequest(d)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Cloudflare device custom profile request: %q: %w", accountID, err))
+	}
+
+	policy, err := client.CreateDeviceSettingsPolicy(ctx, accountID, req)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Cloudflare device custom profile %q: %w", accountID, err))
+	}
+
+	if policy.Result.PolicyID == nil {
+		return diag.FromErr(fmt.Errorf("error creating Cloudflare device custom profile: returned policyID was missing after creating profile for account: %q", accountID))
+	}
+	d.SetId(fmt.Sprintf("%s/%s", accountID, *policy.Result.PolicyID))
+
+	return resourceCloudflareDeviceCustomProfileRead(ctx, d, meta)
+}
+
+func resourceCloudflareDeviceCustomProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, policyID := parseDevicePolicyID(d.Id()); policyID == "" {
+		return diag.FromErr(fmt.Errorf("device custom profiles cannot manage the default account policy, use cloudflare_device_settings_policy instead"))
+	}
+
+	if diags := resourceCloudflareDeviceSettingsPolicyRead(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	if d.Get("default").(bool) {
+		return diag.FromErr(fmt.Errorf("device custom profiles cannot manage the default account policy, use cloudflare_device_settings_policy instead"))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDeviceCustomProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := resourceCloudflareDeviceSettingsPolicyUpdate(ctx, d, meta); diags.HasError() {
+		return diags
+	}
+
+	return resourceCloudflareDeviceCustomProfileRead(ctx, d, meta)
+}
+
+func resourceCloudflareDeviceCustomProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID, policyID, err := parseDeviceSettingsIDImport(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if policyID == "" || policyID == "default" || policyID == accountID {
+		return nil, fmt.Errorf("device custom profiles cannot manage the default account policy, use cloudflare_device_settings_policy instead")
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare device custom profile: id %s for account %s", policyID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
+
+	resourceCloudflareDeviceCustomProfileRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,117 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareDeviceCustomProfileLocalDomainFallback() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareDeviceCustomProfileLocalDomainFallbackSchema(),
+		ReadContext:   resourceCloudflareDeviceCustomProfileLocalDomainFallbackRead,
+		CreateContext: resourceCloudflareDeviceCustomProfileLocalDomainFallbackUpdate, // Intentionally identical to Update as the domains always exist for a policy
+		UpdateContext: resourceCloudflareDeviceCustomProfileLocalDomainFallbackUpdate,
+		DeleteContext: resourceCloudflareDeviceCustomProfileLocalDomainFallbackDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareDeviceCustomProfileLocalDomainFallbackImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Local Domain Fallback resource for a custom
+			device settings policy. Fallback domains are used to ignore DNS
+			requests to a given list of domains for devices matching the
+			policy. Use ` + "`cloudflare_fallback_domain`" + ` to manage the
+			fallback domains of the default policy.
+		`),
+	}
+}
+
+func resourceCloudflareDeviceCustomProfileLocalDomainFallbackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	policyID := deviceCustomProfilePolicyID(d.Get("policy_id").(string))
+
+	domains, err := client.ListFallbackDomainsDeviceSettingsPolicy(ctx, accountID, policyID)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Device settings policy %s no longer exists", policyID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Local Domain Fallback for policy %q: %w", policyID, err))
+	}
+
+	if err := d.Set("domains", flattenFallbackDomains(domains)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting domains attribute: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareDeviceCustomProfileLocalDomainFallbackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	policyID := deviceCustomProfilePolicyID(d.Get("policy_id").(string))
+
+	domainList := expandFallbackDomains(d.Get("domains").(*schema.Set))
+
+	_, err := client.UpdateFallbackDomainDeviceSettingsPolicy(ctx, accountID, policyID, domainList)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Local Domain Fallback for policy %q: %w", policyID, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
+
+	return resourceCloudflareDeviceCustomProfileLocalDomainFallbackRead(ctx, d, meta)
+}
+
+func resourceCloudflareDeviceCustomProfileLocalDomainFallbackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	policyID := deviceCustomProfilePolicyID(d.Get("policy_id").(string))
+
+	err := client.RestoreFallbackDomainDefaultsDeviceSettingsPolicy(ctx, accountID, policyID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error restoring Local Domain Fallback defaults for policy %q: %w", policyID, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareDeviceCustomProfileLocalDomainFallbackImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 || attributes[0] == "" || attributes[1] == "" {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/policyID\"", d.Id())
+	}
+
+	accountID, policyID := attributes[0], attributes[1]
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("policy_id", policyID)
+	d.SetId(fmt.Sprintf("%s/%s", accountID, policyID))
+
+	resourceCloudflareDeviceCustomProfileLocalDomainFallbackRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// deviceCustomProfilePolicyID returns the policy ID from either a bare policy
+// ID or the `<accountTag>/<policyID>` ID of a `cloudflare_device_settings_policy`.
+func deviceCustomProfilePolicyID(id string) string {
+	if _, policyID := parseDevicePolicyID(id); policyID != "" {
+		return policyID
+	}
+
+	return id
+}
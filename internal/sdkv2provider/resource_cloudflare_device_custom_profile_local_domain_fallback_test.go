@@ -0,0 +1,115 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	cloudflare "github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareDeviceCustomProfileLocalDomainFallback_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_device_custom_profile_local_domain_fallback.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		CheckDestroy: testAccCheckCloudflareDeviceCustomProfileLocalDomainFallbackDestroy,
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDeviceCustomProfileLocalDomainFallback(rnd, accountID, "example domain", "example.com", "1.0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttrPair(name, "policy_id", "cloudflare_device_settings_policy."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "domains.#", "1"),
+					resource.TestCheckResourceAttr(name, "domains.0.description", "example domain"),
+					resource.TestCheckResourceAttr(name, "domains.0.suffix", "example.com"),
+					resource.TestCheckResourceAttr(name, "domains.0.dns_server.0", "1.0.0.1"),
+				),
+			},
+			{
+				Config: testAccCloudflareDeviceCustomProfileLocalDomainFallback(rnd, accountID, "second example domain", "example.net", "1.1.1.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "domains.#", "1"),
+					resource.TestCheckResourceAttr(name, "domains.0.description", "second example domain"),
+					resource.TestCheckResourceAttr(name, "domains.0.suffix", "example.net"),
+					resource.TestCheckResourceAttr(name, "domains.0.dns_server.0", "1.1.1.1"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_id"},
+			},
+		},
+	})
+}
+
+func TestDeviceCustomProfilePolicyID(t *testing.T) {
+	t.Parallel()
+
+	if got := deviceCustomProfilePolicyID("0ad1e3ff/f174e90a"); got != "f174e90a" {
+		t.Errorf("expected policy ID %q, got %q", "f174e90a", got)
+	}
+
+	if got := deviceCustomProfilePolicyID("f174e90a"); got != "f174e90a" {
+		t.Errorf("expected policy ID %q, got %q", "f174e90a", got)
+	}
+}
+
+func testAccCloudflareDeviceCustomProfileLocalDomainFallback(rnd, accountID, description, suffix, dnsServer string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_device_settings_policy" "%[1]s" {
+	account_id = "%[2]s"
+	name       = "%[1]s"
+	enabled    = true
+	match      = "identity.email == \"foo@example.com\""
+	precedence = 10
+}
+
+resource "cloudflare_zero_trust_device_custom_profile_local_domain_fallback" "%[1]s" {
+	account_id = "%[2]s"
+	policy_id  = cloudflare_device_settings_policy.%[1]s.id
+
+	domains {
+		description = "%[3]s"
+		suffix      = "%[4]s"
+		dns_server  = ["%[5]s"]
+	}
+}
+`, rnd, accountID, description, suffix, dnsServer)
+}
+
+func testAccCheckCloudflareDeviceCustomProfileLocalDomainFallbackDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zero_trust_device_custom_profile_local_domain_fallback" {
+			continue
+		}
+
+		// The fallback domains are removed along with the device settings
+		// policy so only the deletion of the policy needs to be checked.
+		accountID, policyID := parseDevicePolicyID(rs.Primary.ID)
+		_, err := client.GetDeviceSettingsPolicy(context.Background(), accountID, policyID)
+		if err == nil {
+			return fmt.Errorf("device settings policy still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareDeviceCustomProfile_Create(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_device_custom_profile.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareDeviceCustomProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDeviceCustomProfile(rnd, accountID, 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "default", "false"),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "match", "identity.email == \"foo@example.com\""),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "precedence", "10"),
+					resource.TestCheckResourceAttr(name, "support_url", "https://cloudflare.com"),
+				),
+			},
+			{
+				Config: testAccCloudflareDeviceCustomProfile(rnd, accountID, 20),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "default", "false"),
+					resource.TestCheckResourceAttr(name, "precedence", "20"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  name,
+				ImportState:   true,
+				ImportStateId: fmt.Sprintf("%s/default", accountID),
+				ExpectError:   regexp.MustCompile("device custom profiles cannot manage the default account policy"),
+			},
+		},
+	})
+}
+
+func TestDeviceCustomProfileSchema(t *testing.T) {
+	t.Parallel()
+
+	custom := resourceCloudflareDeviceCustomProfileSchema()
+	settings := resourceCloudflareDeviceSettingsPolicySchema()
+
+	// Custom profiles always require a match expression and precedence.
+	assert.True(t, custom["match"].Required)
+	assert.True(t, custom["precedence"].Required)
+	assert.False(t, settings["match"].Required)
+	assert.False(t, settings["precedence"].Required)
+
+	// Only the device settings policy resource may manage the default policy.
+	assert.True(t, custom["default"].Computed)
+	assert.False(t, custom["default"].Optional)
+	assert.True(t, settings["default"].Optional)
+
+	assert.NoError(t, resourceCloudflareDeviceCustomProfile().InternalValidate(nil, true))
+}
+
+func testAccCloudflareDeviceCustomProfile(rnd, accountID string, precedence int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_device_custom_profile" "%[1]s" {
+	account_id  = "%[2]s"
+	name        = "%[1]s"
+	match       = "identity.email == \"foo@example.com\""
+	precedence  = %[3]d
+	support_url = "https://cloudflare.com"
+}
+`, rnd, accountID, precedence)
+}
+
+func testAccCheckCloudflareDeviceCustomProfileDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zero_trust_device_custom_profile" {
+			continue
+		}
+
+		_, policyID := parseDevicePolicyID(rs.Primary.ID)
+		_, err := client.GetDeviceSettingsPolicy(context.Background(), rs.Primary.Attributes["account_id"], policyID)
+		if err == nil {
+			return fmt.Errorf("device custom profile still exists")
+		}
+	}
+
+	return nil
+}
@@ -5,16 +5,84 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// devicePostureRuleInputRequiredAttributes are the input attributes the API
+// requires for each device posture rule type.
+var devicePostureRuleInputRequiredAttributes = map[string][]string{
+	"serial_number":   {"id"},
+	"file":            {"path"},
+	"application":     {"path"},
+	"os_version":      {"version", "operator"},
+	"workspace_one":   {"connection_id", "compliance_status"},
+	"crowdstrike_s2s": {"connection_id"},
+	"intune":          {"connection_id", "compliance_status"},
+	"kolide":          {"connection_id", "count_operator", "issue_count"},
+	"tanium":          {"connection_id"},
+	"sentinelone_s2s": {"connection_id"},
+}
+
+// devicePostureRule is a device posture rule. cloudflare.DevicePostureRule
+// is not used as its input doesn't support every rule type.
+type devicePostureRule struct {
+	ID          string                              `json:"id,omitempty"`
+	Type        string                              `json:"type"`
+	Name        string                              `json:"name"`
+	Description string                              `json:"description,omitempty"`
+	Schedule    string                              `json:"schedule,omitempty"`
+	Match       []cloudflare.DevicePostureRuleMatch `json:"match,omitempty"`
+	Input       devicePostureRuleInput              `json:"input,omitempty"`
+	Expiration  string                              `json:"expiration,omitempty"`
+}
+
+// devicePostureRuleInput holds the type specific values a device posture
+// rule checks.
+type devicePostureRuleInput struct {
+	ID               string   `json:"id,omitempty"`
+	Path             string   `json:"path,omitempty"`
+	Exists           bool     `json:"exists,omitempty"`
+	Thumbprint       string   `json:"thumbprint,omitempty"`
+	Sha256           string   `json:"sha256,omitempty"`
+	Running          bool     `json:"running,omitempty"`
+	RequireAll       bool     `json:"requireAll,omitempty"`
+	CheckDisks       []string `json:"checkDisks,omitempty"`
+	Enabled          bool     `json:"enabled,omitempty"`
+	Version          string   `json:"version,omitempty"`
+	VersionOperator  string   `json:"versionOperator,omitempty"`
+	Overall          string   `json:"overall,omitempty"`
+	SensorConfig     string   `json:"sensor_config,omitempty"`
+	Os               string   `json:"os,omitempty"`
+	OsDistroName     string   `json:"os_distro_name,omitempty"`
+	OsDistroRevision string   `json:"os_distro_revision,omitempty"`
+	Operator         string   `json:"operator,omitempty"`
+	Domain           string   `json:"domain,omitempty"`
+	ComplianceStatus string   `json:"compliance_status,omitempty"`
+	ConnectionID     string   `json:"connection_id,omitempty"`
+	LastSeen         string   `json:"last_seen,omitempty"`
+	State            string   `json:"state,omitempty"`
+	IssueCount       string   `json:"issue_count,omitempty"`
+	CountOperator    string   `json:"countOperator,omitempty"`
+	TotalScore       int      `json:"total_score,omitempty"`
+	ScoreOperator    string   `json:"scoreOperator,omitempty"`
+	RiskLevel        string   `json:"risk_level,omitempty"`
+	EidLastSeen      string   `json:"eid_last_seen,omitempty"`
+	ActiveThreats    int      `json:"active_threats,omitempty"`
+	Infected         bool     `json:"infected,omitempty"`
+	IsActive         bool     `json:"is_active,omitempty"`
+	NetworkStatus    string   `json:"network_status,omitempty"`
+	OperationalState string   `json:"operational_state,omitempty"`
+}
+
 func resourceCloudflareDevicePostureRule() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareDevicePostureRuleSchema(),
@@ -25,6 +93,7 @@ func resourceCloudflareDevicePostureRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareDevicePostureRuleImport,
 		},
+		CustomizeDiff: resourceCloudflareDevicePostureRuleCustomizeDiff,
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Device Posture Rule resource. Device posture rules configure security policies for device posture checks.
 		`),
@@ -35,7 +104,7 @@ func resourceCloudflareDevicePostureRuleCreate(ctx context.Context, d *schema.Re
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
 
-	newDevicePostureRule := cloudflare.DevicePostureRule{
+	newDevicePostureRule := devicePostureRule{
 		Name:        d.Get("name").(string),
 		Type:        d.Get("type").(string),
 		Description: d.Get("description").(string),
@@ -51,11 +120,16 @@ func resourceCloudflareDevicePostureRuleCreate(ctx context.Context, d *schema.Re
 	setDevicePostureRuleInput(&newDevicePostureRule, d)
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device Posture Rule from struct: %+v", newDevicePostureRule))
 
-	rule, err := client.CreateDevicePostureRule(ctx, accountID, newDevicePostureRule)
+	res, err := client.Raw(ctx, http.MethodPost, devicePostureRuleURI(accountID, ""), newDevicePostureRule, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Device Posture Rule for account %q: %w", accountID, err))
 	}
 
+	var rule devicePostureRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Device Posture Rule: %w", err))
+	}
+
 	d.SetId(rule.ID)
 
 	return resourceCloudflareDevicePostureRuleRead(ctx, d, meta)
@@ -65,7 +139,7 @@ func resourceCloudflareDevicePostureRuleRead(ctx context.Context, d *schema.Reso
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
 
-	devicePostureRule, err := client.DevicePostureRule(ctx, accountID, d.Id())
+	res, err := client.Raw(ctx, http.MethodGet, devicePostureRuleURI(accountID, d.Id()), nil, nil)
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -76,13 +150,20 @@ func resourceCloudflareDevicePostureRuleRead(ctx context.Context, d *schema.Reso
 		return diag.FromErr(fmt.Errorf("error finding Device Posture Rule %q: %w", d.Id(), err))
 	}
 
-	d.Set("name", devicePostureRule.Name)
-	d.Set("description", devicePostureRule.Description)
-	d.Set("type", devicePostureRule.Type)
-	d.Set("schedule", devicePostureRule.Schedule)
-	d.Set("expiration", devicePostureRule.Expiration)
-	d.Set("match", convertMatchToSchema(devicePostureRule.Match))
-	d.Set("input", convertInputToSchema(devicePostureRule.Input))
+	var rule devicePostureRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Device Posture Rule: %w", err))
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("description", rule.Description)
+	d.Set("type", rule.Type)
+	d.Set("schedule", rule.Schedule)
+	d.Set("expiration", rule.Expiration)
+	d.Set("match", convertMatchToSchema(rule.Match))
+	if err := d.Set("input", convertInputToSchema(rule.Input)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set input: %w", err))
+	}
 
 	return nil
 }
@@ -91,7 +172,7 @@ func resourceCloudflareDevicePostureRuleUpdate(ctx context.Context, d *schema.Re
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
 
-	updatedDevicePostureRule := cloudflare.DevicePostureRule{
+	updatedDevicePostureRule := devicePostureRule{
 		ID:          d.Id(),
 		Name:        d.Get("name").(string),
 		Type:        d.Get("type").(string),
@@ -108,12 +189,17 @@ func resourceCloudflareDevicePostureRuleUpdate(ctx context.Context, d *schema.Re
 	setDevicePostureRuleInput(&updatedDevicePostureRule, d)
 	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Device Posture Rule from struct: %+v", updatedDevicePostureRule))
 
-	devicePostureRule, err := client.UpdateDevicePostureRule(ctx, accountID, updatedDevicePostureRule)
+	res, err := client.Raw(ctx, http.MethodPut, devicePostureRuleURI(accountID, d.Id()), updatedDevicePostureRule, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Device Posture Rule for account %q: %w", accountID, err))
 	}
 
-	if devicePostureRule.ID == "" {
+	var rule devicePostureRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Device Posture Rule: %w", err))
+	}
+
+	if rule.ID == "" {
 		return diag.FromErr(fmt.Errorf("failed to find Device Posture Rule ID in update response; resource was empty"))
 	}
 
@@ -156,9 +242,64 @@ func resourceCloudflareDevicePostureRuleImport(ctx context.Context, d *schema.Re
 	return []*schema.ResourceData{d}, nil
 }
 
-func setDevicePostureRuleInput(rule *cloudflare.DevicePostureRule, d *schema.ResourceData) {
+// resourceCloudflareDevicePostureRuleCustomizeDiff validates at plan time
+// that the input attributes required by the rule type are configured.
+func resourceCloudflareDevicePostureRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	inputs := config.GetAttr("input")
+	if !inputs.IsWhollyKnown() {
+		return nil
+	}
+
+	var configured []string
+	if !inputs.IsNull() && inputs.LengthInt() > 0 {
+		input := inputs.Index(cty.NumberIntVal(0))
+		for attr := range input.Type().AttributeTypes() {
+			if !input.GetAttr(attr).IsNull() {
+				configured = append(configured, attr)
+			}
+		}
+	}
+
+	return validateDevicePostureRuleInput(d.Get("type").(string), configured)
+}
+
+// validateDevicePostureRuleInput ensures that every input attribute required
+// by the rule type is configured.
+func validateDevicePostureRuleInput(ruleType string, configuredAttributes []string) error {
+	var missing []string
+	for _, attr := range devicePostureRuleInputRequiredAttributes[ruleType] {
+		if !contains(configuredAttributes, attr) {
+			missing = append(missing, attr)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%s device posture rules require input %s to be set", ruleType, strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func devicePostureRuleURI(accountID, ruleID string) string {
+	uri := fmt.Sprintf("/accounts/%s/devices/posture", accountID)
+	if ruleID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, ruleID)
+	}
+	return uri
+}
+
+func setDevicePostureRuleInput(rule *devicePostureRule, d *schema.ResourceData) {
 	if _, ok := d.GetOk("input"); ok {
-		input := cloudflare.DevicePostureRuleInput{}
+		input := devicePostureRuleInput{}
 		if inputID, ok := d.GetOk("input.0.id"); ok {
 			input.ID = inputID.(string)
 		}
@@ -180,6 +321,9 @@ func setDevicePostureRuleInput(rule *cloudflare.DevicePostureRule, d *schema.Res
 		if require_all, ok := d.GetOk("input.0.require_all"); ok {
 			input.RequireAll = require_all.(bool)
 		}
+		if checkDisks, ok := d.GetOk("input.0.check_disks"); ok {
+			input.CheckDisks = expandInterfaceToStringList(checkDisks.(*schema.Set).List())
+		}
 		if enabled, ok := d.GetOk("input.0.enabled"); ok {
 			input.Enabled = enabled.(bool)
 		}
@@ -216,11 +360,50 @@ func setDevicePostureRuleInput(rule *cloudflare.DevicePostureRule, d *schema.Res
 		if versionOperator, ok := d.GetOk("input.0.version_operator"); ok {
 			input.VersionOperator = versionOperator.(string)
 		}
+		if lastSeen, ok := d.GetOk("input.0.last_seen"); ok {
+			input.LastSeen = lastSeen.(string)
+		}
+		if state, ok := d.GetOk("input.0.state"); ok {
+			input.State = state.(string)
+		}
+		if issueCount, ok := d.GetOk("input.0.issue_count"); ok {
+			input.IssueCount = issueCount.(string)
+		}
+		if countOperator, ok := d.GetOk("input.0.count_operator"); ok {
+			input.CountOperator = countOperator.(string)
+		}
+		if totalScore, ok := d.GetOk("input.0.total_score"); ok {
+			input.TotalScore = totalScore.(int)
+		}
+		if scoreOperator, ok := d.GetOk("input.0.score_operator"); ok {
+			input.ScoreOperator = scoreOperator.(string)
+		}
+		if riskLevel, ok := d.GetOk("input.0.risk_level"); ok {
+			input.RiskLevel = riskLevel.(string)
+		}
+		if eidLastSeen, ok := d.GetOk("input.0.eid_last_seen"); ok {
+			input.EidLastSeen = eidLastSeen.(string)
+		}
+		if activeThreats, ok := d.GetOk("input.0.active_threats"); ok {
+			input.ActiveThreats = activeThreats.(int)
+		}
+		if infected, ok := d.GetOk("input.0.infected"); ok {
+			input.Infected = infected.(bool)
+		}
+		if isActive, ok := d.GetOk("input.0.is_active"); ok {
+			input.IsActive = isActive.(bool)
+		}
+		if networkStatus, ok := d.GetOk("input.0.network_status"); ok {
+			input.NetworkStatus = networkStatus.(string)
+		}
+		if operationalState, ok := d.GetOk("input.0.operational_state"); ok {
+			input.OperationalState = operationalState.(string)
+		}
 		rule.Input = input
 	}
 }
 
-func setDevicePostureRuleMatch(rule *cloudflare.DevicePostureRule, d *schema.ResourceData) error {
+func setDevicePostureRuleMatch(rule *devicePostureRule, d *schema.ResourceData) error {
 	if _, ok := d.GetOk("match"); ok {
 		match := d.Get("match").([]interface{})
 		for _, v := range match {
@@ -251,7 +434,7 @@ func convertMatchToSchema(matches []cloudflare.DevicePostureRuleMatch) []map[str
 	return matchSchema
 }
 
-func convertInputToSchema(input cloudflare.DevicePostureRuleInput) []map[string]interface{} {
+func convertInputToSchema(input devicePostureRuleInput) []map[string]interface{} {
 	m := map[string]interface{}{
 		"id":                 input.ID,
 		"path":               input.Path,
@@ -260,6 +443,7 @@ func convertInputToSchema(input cloudflare.DevicePostureRuleInput) []map[string]
 		"sha256":             input.Sha256,
 		"running":            input.Running,
 		"require_all":        input.RequireAll,
+		"check_disks":        flattenStringList(input.CheckDisks),
 		"enabled":            input.Enabled,
 		"version":            input.Version,
 		"os_distro_name":     input.OsDistroName,
@@ -272,6 +456,19 @@ func convertInputToSchema(input cloudflare.DevicePostureRuleInput) []map[string]
 		"overall":            input.Overall,
 		"sensor_config":      input.SensorConfig,
 		"version_operator":   input.VersionOperator,
+		"last_seen":          input.LastSeen,
+		"state":              input.State,
+		"issue_count":        input.IssueCount,
+		"count_operator":     input.CountOperator,
+		"total_score":        input.TotalScore,
+		"score_operator":     input.ScoreOperator,
+		"risk_level":         input.RiskLevel,
+		"eid_last_seen":      input.EidLastSeen,
+		"active_threats":     input.ActiveThreats,
+		"infected":           input.Infected,
+		"is_active":          input.IsActive,
+		"network_status":     input.NetworkStatus,
+		"operational_state":  input.OperationalState,
 	}
 
 	return []map[string]interface{}{m}
@@ -2,13 +2,17 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareDevicePostureRule_SerialNumber(t *testing.T) {
@@ -216,6 +220,154 @@ func TestAccCloudflareDevicePostureRule_DiskEncryption(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareDevicePostureRule_DiskEncryptionCheckDisks(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_device_posture_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareDevicePostureRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareDevicePostureRuleConfigDiskEncryptionCheckDisks(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "type", "disk_encryption"),
+					resource.TestCheckResourceAttr(name, "input.0.require_all", "false"),
+					resource.TestCheckResourceAttr(name, "input.0.check_disks.#", "2"),
+					resource.TestCheckTypeSetElemAttr(name, "input.0.check_disks.*", "C"),
+					resource.TestCheckTypeSetElemAttr(name, "input.0.check_disks.*", "D"),
+				),
+			},
+			{
+				Config:   testAccCloudflareDevicePostureRuleConfigDiskEncryptionCheckDisks(rnd, accountID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareDevicePostureRule_MissingRequiredInput(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareDevicePostureRuleConfigKolide(rnd, accountID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("kolide device posture rules require input count_operator, issue_count to be set")),
+			},
+		},
+	})
+}
+
+func TestValidateDevicePostureRuleInput(t *testing.T) {
+	testCases := map[string]struct {
+		ruleType   string
+		configured []string
+		err        string
+	}{
+		"no required attributes": {ruleType: "disk_encryption"},
+		"crowdstrike":            {ruleType: "crowdstrike_s2s", configured: []string{"connection_id", "overall"}},
+		"crowdstrike without id": {ruleType: "crowdstrike_s2s", configured: []string{"overall"}, err: "crowdstrike_s2s device posture rules require input connection_id to be set"},
+		"intune":                 {ruleType: "intune", configured: []string{"connection_id", "compliance_status"}},
+		"intune without status":  {ruleType: "intune", configured: []string{"connection_id"}, err: "intune device posture rules require input compliance_status to be set"},
+		"kolide without input":   {ruleType: "kolide", err: "kolide device posture rules require input connection_id, count_operator, issue_count to be set"},
+		"tanium":                 {ruleType: "tanium", configured: []string{"connection_id", "total_score", "score_operator"}},
+		"sentinelone without id": {ruleType: "sentinelone_s2s", configured: []string{"infected"}, err: "sentinelone_s2s device posture rules require input connection_id to be set"},
+		"os version":             {ruleType: "os_version", configured: []string{"operator", "version"}},
+		"os version without op":  {ruleType: "os_version", configured: []string{"version"}, err: "os_version device posture rules require input operator to be set"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateDevicePostureRuleInput(tc.ruleType, tc.configured)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDevicePostureRuleInputRoundTrip(t *testing.T) {
+	testCases := map[string]struct {
+		ruleType string
+		input    map[string]interface{}
+		json     string
+	}{
+		"disk_encryption": {
+			ruleType: "disk_encryption",
+			input:    map[string]interface{}{"check_disks": []interface{}{"C"}},
+			json:     `{"checkDisks":["C"]}`,
+		},
+		"crowdstrike_s2s": {
+			ruleType: "crowdstrike_s2s",
+			input:    map[string]interface{}{"connection_id": "cs", "overall": "90", "version_operator": ">=", "last_seen": "1h", "state": "online"},
+			json:     `{"versionOperator":">=","overall":"90","connection_id":"cs","last_seen":"1h","state":"online"}`,
+		},
+		"intune": {
+			ruleType: "intune",
+			input:    map[string]interface{}{"connection_id": "in", "compliance_status": "ingraceperiod"},
+			json:     `{"compliance_status":"ingraceperiod","connection_id":"in"}`,
+		},
+		"kolide": {
+			ruleType: "kolide",
+			input:    map[string]interface{}{"connection_id": "ko", "count_operator": "<", "issue_count": "1"},
+			json:     `{"connection_id":"ko","issue_count":"1","countOperator":"<"}`,
+		},
+		"tanium": {
+			ruleType: "tanium",
+			input:    map[string]interface{}{"connection_id": "ta", "total_score": 50, "score_operator": ">", "risk_level": "low", "eid_last_seen": "2023-01-01T00:00:00Z"},
+			json:     `{"connection_id":"ta","total_score":50,"scoreOperator":">","risk_level":"low","eid_last_seen":"2023-01-01T00:00:00Z"}`,
+		},
+		"sentinelone_s2s": {
+			ruleType: "sentinelone_s2s",
+			input:    map[string]interface{}{"connection_id": "s1", "active_threats": 1, "infected": true, "is_active": true, "network_status": "connected", "operational_state": "na"},
+			json:     `{"connection_id":"s1","active_threats":1,"infected":true,"is_active":true,"network_status":"connected","operational_state":"na"}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudflareDevicePostureRuleSchema(), map[string]interface{}{
+				"account_id": "f037e56e89293a057740de681ac9abbe",
+				"type":       tc.ruleType,
+				"input":      []interface{}{tc.input},
+			})
+
+			var rule devicePostureRule
+			setDevicePostureRuleInput(&rule, d)
+
+			body, err := json.Marshal(rule.Input)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tc.json, string(body))
+
+			var input devicePostureRuleInput
+			assert.NoError(t, json.Unmarshal(body, &input))
+			assert.NoError(t, d.Set("input", convertInputToSchema(input)))
+
+			var roundTripped devicePostureRule
+			setDevicePostureRuleInput(&roundTripped, d)
+			assert.Equal(t, rule.Input, roundTripped.Input)
+		})
+	}
+}
+
 func testAccCloudflareDevicePostureRuleConfigSerialNumber(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_device_posture_rule" "%[1]s" {
@@ -315,6 +467,44 @@ resource "cloudflare_device_posture_rule" "%[1]s" {
 `, rnd, accountID)
 }
 
+func testAccCloudflareDevicePostureRuleConfigDiskEncryptionCheckDisks(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_device_posture_rule" "%[1]s" {
+	account_id                = "%[2]s"
+	name                      = "%[1]s"
+	type                      = "disk_encryption"
+	description               = "My description"
+	schedule                  = "24h"
+	expiration                = "24h"
+	match {
+		platform = "windows"
+	}
+	input {
+		check_disks = ["C", "D"]
+	}
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareDevicePostureRuleConfigKolide(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_device_posture_rule" "%[1]s" {
+	account_id                = "%[2]s"
+	name                      = "%[1]s"
+	type                      = "kolide"
+	description               = "My description"
+	schedule                  = "24h"
+	expiration                = "24h"
+	match {
+		platform = "mac"
+	}
+	input {
+		connection_id = "bc7cbfbb-600a-42e4-8a23-45b5e85f804f"
+	}
+}
+`, rnd, accountID)
+}
+
 func testAccCloudflareDevicePostureRuleConfigFirewall(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_device_posture_rule" "%[1]s" {
@@ -3,6 +3,7 @@ package sdkv2provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
@@ -18,12 +19,82 @@ func resourceCloudflareEmailRoutingRule() *schema.Resource {
 		CreateContext: resourceCloudflareEmailRoutingRuleCreate,
 		UpdateContext: resourceCloudflareEmailRoutingRuleUpdate,
 		DeleteContext: resourceCloudflareEmailRoutingRuleDelete,
+		CustomizeDiff: resourceCloudflareEmailRoutingRuleCustomizeDiff,
 		Description: heredoc.Doc(`
 			Provides a resource for managing Email Routing rules.
 		`),
 	}
 }
 
+var (
+	emailRoutingRuleMatcherTypes = []string{"literal", "all"}
+	emailRoutingRuleActionTypes  = []string{"forward", "worker", "drop"}
+)
+
+// resourceCloudflareEmailRoutingRuleCustomizeDiff validates the combinations
+// of matcher and action attributes at plan time, which the API otherwise only
+// rejects when the rule is applied.
+func resourceCloudflareEmailRoutingRuleCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if config := d.GetRawConfig(); !config.IsNull() {
+		if !config.GetAttr("matcher").IsWhollyKnown() || !config.GetAttr("action").IsWhollyKnown() {
+			return nil
+		}
+	}
+
+	var matchers []cloudflare.EmailRoutingRuleMatcher
+	for _, item := range d.Get("matcher").(*schema.Set).List() {
+		matcher := item.(map[string]interface{})
+		matchers = append(matchers, cloudflare.EmailRoutingRuleMatcher{
+			Type:  matcher["type"].(string),
+			Field: matcher["field"].(string),
+			Value: matcher["value"].(string),
+		})
+	}
+
+	var actions []cloudflare.EmailRoutingRuleAction
+	for _, item := range d.Get("action").(*schema.Set).List() {
+		action := item.(map[string]interface{})
+		actions = append(actions, cloudflare.EmailRoutingRuleAction{
+			Type:  action["type"].(string),
+			Value: expandInterfaceToStringList(action["value"]),
+		})
+	}
+
+	return validateEmailRoutingRule(matchers, actions)
+}
+
+// validateEmailRoutingRule returns an error if a matcher or action is missing
+// the attributes its type requires, or sets ones the type doesn't take.
+func validateEmailRoutingRule(matchers []cloudflare.EmailRoutingRuleMatcher, actions []cloudflare.EmailRoutingRuleAction) error {
+	for _, matcher := range matchers {
+		switch strings.ToLower(matcher.Type) {
+		case "literal":
+			if matcher.Field == "" || matcher.Value == "" {
+				return fmt.Errorf("matcher of type %q requires both `field` and `value`", matcher.Type)
+			}
+		case "all":
+			if matcher.Field != "" || matcher.Value != "" {
+				return fmt.Errorf("matcher of type %q doesn't take a `field` or `value`", matcher.Type)
+			}
+		}
+	}
+
+	for _, action := range actions {
+		switch strings.ToLower(action.Type) {
+		case "forward", "worker":
+			if len(action.Value) == 0 {
+				return fmt.Errorf("action of type %q requires a `value`", action.Type)
+			}
+		case "drop":
+			if len(action.Value) > 0 {
+				return fmt.Errorf("action of type %q doesn't take a `value`", action.Type)
+			}
+		}
+	}
+
+	return nil
+}
+
 func buildMatchersAndActions(d *schema.ResourceData) (matchers []cloudflare.EmailRoutingRuleMatcher, actions []cloudflare.EmailRoutingRuleAction) {
 	if items, ok := d.GetOk("matcher"); ok {
 		for _, item := range items.(*schema.Set).List() {
@@ -69,9 +140,40 @@ func resourceCloudflareEmailRoutingRuleRead(ctx context.Context, d *schema.Resou
 	d.Set("enabled", cloudflare.Bool(res.Enabled))
 	d.Set("priority", res.Priority)
 
+	if err := d.Set("matcher", flattenEmailRoutingRuleMatchers(res.Matchers)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set matcher: %w", err))
+	}
+
+	if err := d.Set("action", flattenEmailRoutingRuleActions(res.Actions)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set action: %w", err))
+	}
+
 	return nil
 }
 
+func flattenEmailRoutingRuleMatchers(matchers []cloudflare.EmailRoutingRuleMatcher) []interface{} {
+	flattened := make([]interface{}, 0, len(matchers))
+	for _, matcher := range matchers {
+		flattened = append(flattened, map[string]interface{}{
+			"type":  matcher.Type,
+			"field": matcher.Field,
+			"value": matcher.Value,
+		})
+	}
+	return flattened
+}
+
+func flattenEmailRoutingRuleActions(actions []cloudflare.EmailRoutingRuleAction) []interface{} {
+	flattened := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		flattened = append(flattened, map[string]interface{}{
+			"type":  action.Type,
+			"value": action.Value,
+		})
+	}
+	return flattened
+}
+
 func resourceCloudflareEmailRoutingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
@@ -1,11 +1,20 @@
 package sdkv2provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
 func testEmailRoutingRuleConfig(resourceID, zoneID string, enabled bool, priority int) string {
@@ -58,3 +67,177 @@ func TestAccTestEmailRoutingRule(t *testing.T) {
 		},
 	})
 }
+
+func TestAccTestEmailRoutingRule_MultipleForwardAddresses(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_email_routing_rule." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testEmailRoutingRuleConfigMultipleForwardAddresses(rnd, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "action.#", "1"),
+					resource.TestCheckResourceAttr(name, "action.0.value.#", "2"),
+					resource.TestCheckResourceAttr(name, "action.0.value.0", "destinationaddress@example.net"),
+					resource.TestCheckResourceAttr(name, "action.0.value.1", "otherdestinationaddress@example.net"),
+				),
+			},
+			{
+				Config:   testEmailRoutingRuleConfigMultipleForwardAddresses(rnd, zoneID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccTestEmailRoutingRule_InvalidAction(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testEmailRoutingRuleConfigAction(rnd, zoneID, "forward", "[]"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("action of type \"forward\" requires a `value`"),
+			},
+			{
+				Config:      testEmailRoutingRuleConfigAction(rnd, zoneID, "redirect", `["destinationaddress@example.net"]`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected .*type to be one of`),
+			},
+		},
+	})
+}
+
+func TestValidateEmailRoutingRule(t *testing.T) {
+	literal := cloudflare.EmailRoutingRuleMatcher{Type: "literal", Field: "to", Value: "test@example.com"}
+	forward := cloudflare.EmailRoutingRuleAction{Type: "forward", Value: []string{"destinationaddress@example.net"}}
+
+	testCases := map[string]struct {
+		matchers []cloudflare.EmailRoutingRuleMatcher
+		actions  []cloudflare.EmailRoutingRuleAction
+		err      string
+	}{
+		"literal forward": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{literal},
+			actions:  []cloudflare.EmailRoutingRuleAction{forward},
+		},
+		"catch-all drop": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{{Type: "all"}},
+			actions:  []cloudflare.EmailRoutingRuleAction{{Type: "drop"}},
+		},
+		"worker": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{literal},
+			actions:  []cloudflare.EmailRoutingRuleAction{{Type: "worker", Value: []string{"my-worker"}}},
+		},
+		"literal without field": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{{Type: "literal", Value: "test@example.com"}},
+			actions:  []cloudflare.EmailRoutingRuleAction{forward},
+			err:      "matcher of type \"literal\" requires both `field` and `value`",
+		},
+		"all with value": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{{Type: "all", Field: "to", Value: "test@example.com"}},
+			actions:  []cloudflare.EmailRoutingRuleAction{forward},
+			err:      "matcher of type \"all\" doesn't take a `field` or `value`",
+		},
+		"forward without value": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{literal},
+			actions:  []cloudflare.EmailRoutingRuleAction{{Type: "forward"}},
+			err:      "action of type \"forward\" requires a `value`",
+		},
+		"worker without value": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{literal},
+			actions:  []cloudflare.EmailRoutingRuleAction{{Type: "Worker"}},
+			err:      "action of type \"Worker\" requires a `value`",
+		},
+		"drop with value": {
+			matchers: []cloudflare.EmailRoutingRuleMatcher{literal},
+			actions:  []cloudflare.EmailRoutingRuleAction{{Type: "drop", Value: []string{"destinationaddress@example.net"}}},
+			err:      "action of type \"drop\" doesn't take a `value`",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateEmailRoutingRule(tc.matchers, tc.actions)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestResourceCloudflareEmailRoutingRuleReadMultipleForwardAddresses(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/0da42c8d2132a9ddaf714f9e7c920711/email/routing/rules/a7e6fb77503c41d8a7f3113c6918f10c", r.URL.Path)
+		testclient.WriteResult(w, json.RawMessage(`{
+			"tag": "a7e6fb77503c41d8a7f3113c6918f10c",
+			"name": "terraform rule",
+			"priority": 10,
+			"enabled": true,
+			"matchers": [{"type": "literal", "field": "to", "value": "test@example.com"}],
+			"actions": [{"type": "forward", "value": ["destinationaddress@example.net", "otherdestinationaddress@example.net"]}]
+		}`))
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareEmailRoutingRule().Schema, map[string]interface{}{
+		consts.ZoneIDSchemaKey: "0da42c8d2132a9ddaf714f9e7c920711",
+	})
+	d.SetId("a7e6fb77503c41d8a7f3113c6918f10c")
+
+	diags := resourceCloudflareEmailRoutingRuleRead(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	_, actions := buildMatchersAndActions(d)
+	assert.Equal(t, []cloudflare.EmailRoutingRuleAction{{
+		Type:  "forward",
+		Value: []string{"destinationaddress@example.net", "otherdestinationaddress@example.net"},
+	}}, actions)
+}
+
+func testEmailRoutingRuleConfigMultipleForwardAddresses(resourceID, zoneID string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_email_routing_rule" "%[1]s" {
+		  zone_id = "%[2]s"
+		  name = "terraform rule"
+		  matcher {
+			field  = "to"
+			type = "literal"
+			value = "test@example.com"
+		  }
+
+		  action {
+			type = "forward"
+			value = ["destinationaddress@example.net", "otherdestinationaddress@example.net"]
+		  }
+	}
+		`, resourceID, zoneID)
+}
+
+func testEmailRoutingRuleConfigAction(resourceID, zoneID, actionType, actionValue string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_email_routing_rule" "%[1]s" {
+		  zone_id = "%[2]s"
+		  name = "terraform rule"
+		  matcher {
+			field  = "to"
+			type = "literal"
+			value = "test@example.com"
+		  }
+
+		  action {
+			type = "%[3]s"
+			value = %[4]s
+		  }
+	}
+		`, resourceID, zoneID, actionType, actionValue)
+}
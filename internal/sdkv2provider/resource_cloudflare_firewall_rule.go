@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -119,6 +120,8 @@ func resourceCloudflareFirewallRuleRead(ctx context.Context, d *schema.ResourceD
 	d.Set("priority", firewallRule.Priority)
 	d.Set("filter_id", firewallRule.Filter.ID)
 	d.Set("products", products)
+	d.Set("created_on", firewallRule.CreatedOn.Format(time.RFC3339Nano))
+	d.Set("modified_on", firewallRule.ModifiedOn.Format(time.RFC3339Nano))
 
 	return nil
 }
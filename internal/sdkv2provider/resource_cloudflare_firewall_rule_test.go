@@ -62,6 +62,8 @@ func TestAccFirewallRuleSimple(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "action", "allow"),
 					resource.TestCheckResourceAttr(name, "priority", "1"),
 					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+					resource.TestCheckResourceAttrSet(name, "modified_on"),
 				),
 			},
 		},
@@ -0,0 +1,197 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const gatewayCertificateTypeGatewayManaged = "gateway_managed"
+
+// gatewayCertificate is a root CA used by Gateway to inspect HTTPS traffic.
+type gatewayCertificate struct {
+	ID            string     `json:"id,omitempty"`
+	Type          string     `json:"type,omitempty"`
+	Certificate   string     `json:"certificate,omitempty"`
+	PrivateKey    string     `json:"private_key,omitempty"`
+	BindingStatus string     `json:"binding_status,omitempty"`
+	ExpiresOn     *time.Time `json:"expires_on,omitempty"`
+}
+
+func resourceCloudflareGatewayCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareGatewayCertificateSchema(),
+		CreateContext: resourceCloudflareGatewayCertificateCreate,
+		ReadContext:   resourceCloudflareGatewayCertificateRead,
+		UpdateContext: resourceCloudflareGatewayCertificateUpdate,
+		DeleteContext: resourceCloudflareGatewayCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareGatewayCertificateImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Gateway certificate resource. A Gateway
+			certificate is the root CA used to inspect HTTPS traffic and can
+			either be generated by Gateway or uploaded.
+		`),
+	}
+}
+
+func resourceCloudflareGatewayCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	newCertificate := gatewayCertificate{}
+	if custom, ok := d.GetOk("custom"); ok {
+		customCert := custom.([]interface{})[0].(map[string]interface{})
+		newCertificate.Type = "custom"
+		newCertificate.Certificate = customCert["certificate"].(string)
+		newCertificate.PrivateKey = customCert["private_key"].(string)
+	} else {
+		newCertificate.Type = gatewayCertificateTypeGatewayManaged
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Gateway certificate of type %q", newCertificate.Type))
+
+	res, err := client.Raw(ctx, http.MethodPost, gatewayCertificateURI(accountID, ""), newCertificate, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Gateway certificate for account %q: %w", accountID, err))
+	}
+
+	var certificate gatewayCertificate
+	if err := json.Unmarshal(res, &certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Gateway certificate: %w", err))
+	}
+
+	d.SetId(certificate.ID)
+
+	if d.Get("activate").(bool) {
+		if err := setGatewayCertificateActivation(ctx, client, accountID, d.Id(), true); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareGatewayCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareGatewayCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, gatewayCertificateURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Gateway certificate %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Gateway certificate %q: %w", d.Id(), err))
+	}
+
+	var certificate gatewayCertificate
+	if err := json.Unmarshal(res, &certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Gateway certificate: %w", err))
+	}
+
+	d.Set("gateway_managed", certificate.Type == gatewayCertificateTypeGatewayManaged)
+	d.Set("certificate", certificate.Certificate)
+	d.Set("binding_status", certificate.BindingStatus)
+	d.Set("activate", gatewayCertificateActive(certificate.BindingStatus))
+
+	if certificate.ExpiresOn != nil {
+		d.Set("expires_on", certificate.ExpiresOn.Format(time.RFC3339Nano))
+	}
+
+	return nil
+}
+
+func resourceCloudflareGatewayCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	if d.HasChange("activate") {
+		if err := setGatewayCertificateActivation(ctx, client, accountID, d.Id(), d.Get("activate").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCloudflareGatewayCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareGatewayCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Gateway certificate using ID: %s", d.Id()))
+
+	// Certificates must be deactivated before they can be removed.
+	if gatewayCertificateActive(d.Get("binding_status").(string)) {
+		if err := setGatewayCertificateActivation(ctx, client, accountID, d.Id(), false); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if _, err := client.Raw(ctx, http.MethodDelete, gatewayCertificateURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Gateway certificate for account %q: %w", accountID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareGatewayCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/certificateID\"", d.Id())
+	}
+
+	accountID, certificateID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Gateway certificate: id %s for account %s", certificateID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(certificateID)
+
+	resourceCloudflareGatewayCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setGatewayCertificateActivation(ctx context.Context, client *cloudflare.API, accountID, certificateID string, activate bool) error {
+	action := "deactivate"
+	if activate {
+		action = "activate"
+	}
+
+	uri := fmt.Sprintf("%s/%s", gatewayCertificateURI(accountID, certificateID), action)
+	if _, err := client.Raw(ctx, http.MethodPost, uri, nil, nil); err != nil {
+		return fmt.Errorf("failed to %s Gateway certificate %q: %w", action, certificateID, err)
+	}
+
+	return nil
+}
+
+// gatewayCertificateActive returns whether the binding status reflects a
+// certificate that is, or is in the process of being, deployed to the edge.
+func gatewayCertificateActive(bindingStatus string) bool {
+	return bindingStatus == "pending_deployment" || bindingStatus == "available"
+}
+
+func gatewayCertificateURI(accountID, certificateID string) string {
+	uri := fmt.Sprintf("/accounts/%s/gateway/certificates", accountID)
+	if certificateID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, certificateID)
+	}
+	return uri
+}
@@ -0,0 +1,86 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareGatewayCertificate_GatewayManaged(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Gateway
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_gateway_certificate.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareGatewayCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareGatewayCertificateConfig(rnd, accountID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "gateway_managed", "true"),
+					resource.TestCheckResourceAttr(name, "activate", "false"),
+					resource.TestCheckResourceAttrSet(name, "certificate"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+					resource.TestCheckResourceAttrSet(name, "binding_status"),
+				),
+			},
+			{
+				Config: testAccCloudflareGatewayCertificateConfig(rnd, accountID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "activate", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareGatewayCertificateConfig(rnd, accountID string, activate bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_gateway_certificate" "%[1]s" {
+  account_id      = "%[2]s"
+  gateway_managed = true
+  activate        = %[3]t
+}
+`, rnd, accountID, activate)
+}
+
+func testAccCheckCloudflareGatewayCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_gateway_certificate" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, gatewayCertificateURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Gateway certificate still exists")
+		}
+	}
+
+	return nil
+}
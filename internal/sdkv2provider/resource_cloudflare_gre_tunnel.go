@@ -23,7 +23,8 @@ func resourceCloudflareGRETunnel() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareGRETunnelImport,
 		},
-		Description: "Provides a resource, that manages GRE tunnels for Magic Transit.",
+		CustomizeDiff: resourceCloudflareGRETunnelCustomizeDiff,
+		Description:   "Provides a resource, that manages GRE tunnels for Magic Transit.",
 	}
 }
 
@@ -83,9 +84,15 @@ func resourceCloudflareGRETunnelRead(ctx context.Context, d *schema.ResourceData
 	d.Set("interface_address", tunnel.InterfaceAddress)
 	d.Set("ttl", int(tunnel.TTL))
 	d.Set("mtu", int(tunnel.MTU))
-	d.Set("health_check_enabled", tunnel.HealthCheck.Enabled)
-	d.Set("health_check_target", tunnel.HealthCheck.Target)
-	d.Set("health_check_type", tunnel.HealthCheck.Type)
+
+	// Tunnels without any health check data have health checks disabled.
+	healthcheck := tunnel.HealthCheck
+	if healthcheck == nil {
+		healthcheck = &cloudflare.MagicTransitGRETunnelHealthcheck{}
+	}
+	d.Set("health_check_enabled", healthcheck.Enabled)
+	d.Set("health_check_target", healthcheck.Target)
+	d.Set("health_check_type", healthcheck.Type)
 
 	if len(tunnel.Description) > 0 {
 		d.Set("description", tunnel.Description)
@@ -120,6 +127,26 @@ func resourceCloudflareGRETunnelDelete(ctx context.Context, d *schema.ResourceDa
 	return nil
 }
 
+// greTunnelReplaceAttributes are the attributes that recreate the tunnel when
+// changed and `replace` is enabled.
+var greTunnelReplaceAttributes = []string{"customer_gre_endpoint", "cloudflare_gre_endpoint", "interface_address"}
+
+func resourceCloudflareGRETunnelCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("replace").(bool) {
+		return nil
+	}
+
+	for _, attr := range greTunnelReplaceAttributes {
+		if d.HasChange(attr) {
+			if err := d.ForceNew(attr); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func GRETunnelFromResource(d *schema.ResourceData) cloudflare.MagicTransitGRETunnel {
 	tunnel := cloudflare.MagicTransitGRETunnel{
 		Name:                  d.Get("name").(string),
@@ -159,9 +186,9 @@ func GRETunnelHealthcheckFromResource(d *schema.ResourceData) *cloudflare.MagicT
 		healthcheck.Enabled = healthcheckEnabled.(bool)
 	}
 
-	healthcheckTarget, healthcheckTargetOk := d.GetOk("health_check_target")
+	healthcheckTarget, healthcheckTargetOk := tunnelHealthCheckTarget(d)
 	if healthcheckTargetOk {
-		healthcheck.Target = healthcheckTarget.(string)
+		healthcheck.Target = healthcheckTarget
 	}
 
 	healthcheckType, healthcheckTypeOk := d.GetOk("health_check_type")
@@ -175,3 +202,16 @@ func GRETunnelHealthcheckFromResource(d *schema.ResourceData) *cloudflare.MagicT
 
 	return nil
 }
+
+// tunnelHealthCheckTarget returns the health check target of a tunnel when it
+// is part of the configuration. Cloudflare derives the target from the
+// customer endpoint when it is omitted so the derived value kept in state is
+// never sent back, letting the API derive it again if the endpoint changes.
+func tunnelHealthCheckTarget(d *schema.ResourceData) (string, bool) {
+	if config := d.GetRawConfig(); !config.IsNull() && config.GetAttr("health_check_target").IsNull() {
+		return "", false
+	}
+
+	target, ok := d.GetOk("health_check_target")
+	return target.(string), ok
+}
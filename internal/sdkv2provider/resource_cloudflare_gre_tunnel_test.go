@@ -9,7 +9,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareGRETunnelExists(t *testing.T) {
@@ -39,6 +41,7 @@ func TestAccCloudflareGRETunnelExists(t *testing.T) {
 					resource.TestCheckResourceAttr(name, "health_check_enabled", "true"),
 					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.1"),
 					resource.TestCheckResourceAttr(name, "health_check_type", "request"),
+					resource.TestCheckResourceAttr(name, "replace", "false"),
 				),
 			},
 		},
@@ -148,6 +151,112 @@ func TestAccCloudflareGRETunnelUpdateMulti(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareGRETunnelReplace(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_gre_tunnel.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var before, after cloudflare.MagicTransitGRETunnel
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareGRETunnelReplace(rnd, accountID, "203.0.113.1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareGRETunnelExists(name, &before),
+					resource.TestCheckResourceAttr(name, "replace", "true"),
+					resource.TestCheckResourceAttr(name, "customer_gre_endpoint", "203.0.113.1"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareGRETunnelReplace(rnd, accountID, "203.0.113.2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareGRETunnelExists(name, &after),
+					resource.TestCheckResourceAttr(name, "customer_gre_endpoint", "203.0.113.2"),
+					func(s *terraform.State) error {
+						if before.ID == after.ID {
+							return fmt.Errorf("expected GRE tunnel %s to be replaced", before.ID)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareGRETunnelDerivedHealthCheckTarget(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_gre_tunnel.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareGRETunnelWithoutHealthCheckTarget(rnd, accountID, "203.0.113.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.1"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareGRETunnelWithoutHealthCheckTarget(rnd, accountID, "203.0.113.1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckCloudflareGRETunnelWithoutHealthCheckTarget(rnd, accountID, "203.0.113.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "customer_gre_endpoint", "203.0.113.2"),
+					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.2"),
+				),
+			},
+		},
+	})
+}
+
+func TestGRETunnelHealthcheckFromResourceTarget(t *testing.T) {
+	testCases := map[string]struct {
+		configTarget cty.Value
+		expected     *cloudflare.MagicTransitGRETunnelHealthcheck
+	}{
+		"configured target": {
+			configTarget: cty.StringVal("203.0.113.9"),
+			expected:     &cloudflare.MagicTransitGRETunnelHealthcheck{Enabled: true, Target: "203.0.113.9", Type: "reply"},
+		},
+		"derived target": {
+			configTarget: cty.NullVal(cty.String),
+			expected:     &cloudflare.MagicTransitGRETunnelHealthcheck{Enabled: true, Type: "reply"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			// The state holds the target Cloudflare derived from the customer
+			// endpoint when none was configured.
+			d := resourceCloudflareGRETunnel().Data(&terraform.InstanceState{
+				ID: "c4a7362d577a6c3019a474fd6f485821",
+				Attributes: map[string]string{
+					"health_check_enabled": "true",
+					"health_check_target":  "203.0.113.9",
+					"health_check_type":    "reply",
+				},
+				RawConfig: cty.ObjectVal(map[string]cty.Value{
+					"health_check_target": tc.configTarget,
+				}),
+			})
+
+			assert.Equal(t, tc.expected, GRETunnelHealthcheckFromResource(d))
+		})
+	}
+}
+
 func testAccCheckCloudflareGRETunnelSimple(ID, name, description, accountID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_gre_tunnel" "%[1]s" {
@@ -180,3 +289,31 @@ func testAccCheckCloudflareGRETunnelMultiUpdate(ID, name, description, accountID
     health_check_type = "reply"
   }`, ID, name, description, accountID)
 }
+
+func testAccCheckCloudflareGRETunnelReplace(ID, accountID, customerEndpoint string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_gre_tunnel" "%[1]s" {
+	account_id = "%[2]s"
+	name = "%[1]s"
+	customer_gre_endpoint = "%[3]s"
+	cloudflare_gre_endpoint = "162.159.64.41"
+	interface_address = "10.212.0.9/31"
+    health_check_enabled = true
+    health_check_target = "%[3]s"
+    health_check_type = "reply"
+    replace = true
+  }`, ID, accountID, customerEndpoint)
+}
+
+func testAccCheckCloudflareGRETunnelWithoutHealthCheckTarget(ID, accountID, customerEndpoint string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_gre_tunnel" "%[1]s" {
+	account_id = "%[2]s"
+	name = "%[1]s"
+	customer_gre_endpoint = "%[3]s"
+	cloudflare_gre_endpoint = "162.159.64.41"
+	interface_address = "10.212.0.9/31"
+    health_check_enabled = true
+    health_check_type = "reply"
+  }`, ID, accountID, customerEndpoint)
+}
@@ -27,7 +27,8 @@ func resourceCloudflareHealthcheck() *schema.Resource {
 			StateContext: resourceCloudflareHealthcheckImport,
 		},
 
-		Schema: resourceCloudflareHealthcheckSchema(),
+		Schema:        resourceCloudflareHealthcheckSchema(),
+		CustomizeDiff: resourceCloudflareHealthcheckCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Second),
 		},
@@ -181,12 +182,16 @@ func healthcheckSetStruct(d *schema.ResourceData) (cloudflare.Healthcheck, error
 		healthcheck.Description = description.(string)
 	}
 
-	if suspended, ok := d.GetOk("suspended"); ok {
-		healthcheck.Suspended = suspended.(bool)
-	}
+	// Always send the suspended value so that a suspended health check can
+	// be resumed by toggling the attribute back to false.
+	healthcheck.Suspended = d.Get("suspended").(bool)
 
 	if region, ok := d.GetOk("check_regions"); ok {
-		healthcheck.CheckRegions = expandInterfaceToStringList(region)
+		regions := expandInterfaceToStringList(region)
+		if err := validateHealthcheckRegions(regions); err != nil {
+			return cloudflare.Healthcheck{}, err
+		}
+		healthcheck.CheckRegions = regions
 	}
 
 	switch healthcheck.Type {
@@ -253,6 +258,85 @@ func healthcheckSetStruct(d *schema.ResourceData) (cloudflare.Healthcheck, error
 	return healthcheck, nil
 }
 
+// resourceCloudflareHealthcheckCustomizeDiff validates at plan time that the
+// configured attributes match the health check type. When the type changes
+// without an explicit method, the method of the previous type is dropped so
+// that the default of the new type is used instead.
+func resourceCloudflareHealthcheckCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	var configured []string
+	for _, attr := range healthcheckHTTPAttributes {
+		if v := config.GetAttr(attr); !v.IsNull() {
+			configured = append(configured, attr)
+		}
+	}
+
+	var method string
+	if v := config.GetAttr("method"); v.IsKnown() && !v.IsNull() {
+		method = v.AsString()
+	}
+
+	if err := validateHealthcheckTypeAttributes(d.Get("type").(string), method, configured); err != nil {
+		return err
+	}
+
+	if method == "" && d.HasChange("type") && d.Id() != "" {
+		return d.SetNewComputed("method")
+	}
+
+	return nil
+}
+
+// validateHealthcheckTypeAttributes ensures the explicitly configured method
+// and HTTP only attributes are valid for the health check type.
+func validateHealthcheckTypeAttributes(healthcheckType, method string, configuredHTTPAttributes []string) error {
+	switch healthcheckType {
+	case "TCP":
+		if len(configuredHTTPAttributes) > 0 {
+			return fmt.Errorf("%s can only be set for HTTP and HTTPS health checks", strings.Join(configuredHTTPAttributes, ", "))
+		}
+		if method != "" && method != "connection_established" {
+			return fmt.Errorf("cannot use %s as method for TCP healthchecks", method)
+		}
+	case "HTTP", "HTTPS":
+		if method != "" && method != "GET" && method != "HEAD" {
+			return fmt.Errorf("cannot use %s as method for HTTP/HTTPS healthchecks", method)
+		}
+	}
+
+	return nil
+}
+
+// validateHealthcheckRegions ensures the regions are known, not duplicated
+// and that `ALL_REGIONS` is not combined with any other region.
+func validateHealthcheckRegions(regions []string) error {
+	seen := make(map[string]bool, len(regions))
+	for _, region := range regions {
+		if !contains(healthcheckRegions, region) {
+			return fmt.Errorf("%q is not a valid health check region, expected one of %s", region, strings.Join(healthcheckRegions, ", "))
+		}
+
+		if seen[region] {
+			return fmt.Errorf("health check region %q is specified more than once", region)
+		}
+		seen[region] = true
+	}
+
+	if seen["ALL_REGIONS"] && len(regions) > 1 {
+		return fmt.Errorf("health check region \"ALL_REGIONS\" cannot be combined with other regions")
+	}
+
+	return nil
+}
+
 func flattenHealthcheckHeader(header map[string][]string) *schema.Set {
 	flattened := make([]interface{}, 0)
 	for k, v := range header {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
@@ -119,6 +120,205 @@ func TestAccCloudflareHealthcheckHTTPExists(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareHealthcheckSwitchType(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Healthcheck
+	// service does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_healthcheck.%s", rnd)
+	var healthcheck cloudflare.Healthcheck
+	var initialID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareHealthcheckTCP(zoneID, rnd, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareHealthcheckExists(name, zoneID, &healthcheck),
+					resource.TestCheckResourceAttr(name, "type", "TCP"),
+					resource.TestCheckResourceAttr(name, "method", "connection_established"),
+				),
+			},
+			{
+				PreConfig: func() {
+					initialID = healthcheck.ID
+				},
+				Config: testAccCheckCloudflareHealthcheckHTTP(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareHealthcheckExists(name, zoneID, &healthcheck),
+					func(state *terraform.State) error {
+						if initialID != healthcheck.ID {
+							return fmt.Errorf("wanted update but healthcheck got recreated (id changed %q -> %q)", initialID, healthcheck.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(name, "type", "HTTP"),
+					resource.TestCheckResourceAttr(name, "method", "GET"),
+					resource.TestCheckResourceAttr(name, "expected_codes.0", "200"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareHealthcheckTCPWithHTTPAttributes(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareHealthcheckTCPWithPath(zoneID, rnd),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("path can only be set for HTTP and HTTPS health checks")),
+			},
+		},
+	})
+}
+
+func TestValidateHealthcheckTypeAttributes(t *testing.T) {
+	testCases := map[string]struct {
+		healthcheckType string
+		method          string
+		httpAttributes  []string
+		err             bool
+	}{
+		"tcp defaults":               {healthcheckType: "TCP"},
+		"tcp connection established": {healthcheckType: "TCP", method: "connection_established"},
+		"tcp with http method":       {healthcheckType: "TCP", method: "GET", err: true},
+		"tcp with http attributes":   {healthcheckType: "TCP", httpAttributes: []string{"path", "header"}, err: true},
+		"http defaults":              {healthcheckType: "HTTP"},
+		"http head":                  {healthcheckType: "HTTP", method: "HEAD", httpAttributes: []string{"path"}},
+		"https with tcp method":      {healthcheckType: "HTTPS", method: "connection_established", err: true},
+		"https with http attributes": {healthcheckType: "HTTPS", httpAttributes: []string{"expected_codes", "allow_insecure"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateHealthcheckTypeAttributes(tc.healthcheckType, tc.method, tc.httpAttributes)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAccCloudflareHealthcheckSuspended(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Healthcheck
+	// service does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_healthcheck.%s", rnd)
+	var healthcheck cloudflare.Healthcheck
+	var initialID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareHealthcheckSuspended(zoneID, rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareHealthcheckExists(name, zoneID, &healthcheck),
+					resource.TestCheckResourceAttr(name, "suspended", "false"),
+					resource.TestCheckResourceAttr(name, "check_regions.#", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					initialID = healthcheck.ID
+				},
+				Config: testAccCheckCloudflareHealthcheckSuspended(zoneID, rnd, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareHealthcheckExists(name, zoneID, &healthcheck),
+					func(state *terraform.State) error {
+						if initialID != healthcheck.ID {
+							return fmt.Errorf("wanted update but healthcheck got recreated (id changed %q -> %q)", initialID, healthcheck.ID)
+						}
+						if !healthcheck.Suspended {
+							return fmt.Errorf("expected healthcheck %q to be suspended", healthcheck.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(name, "suspended", "true"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareHealthcheckSuspended(zoneID, rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareHealthcheckExists(name, zoneID, &healthcheck),
+					func(state *terraform.State) error {
+						if healthcheck.Suspended {
+							return fmt.Errorf("expected healthcheck %q to be resumed", healthcheck.ID)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(name, "suspended", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareHealthcheckInvalidCheckRegions(t *testing.T) {
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareHealthcheckCheckRegions(zoneID, rnd, `"ALL_REGIONS", "WEU"`),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(`"ALL_REGIONS" cannot be combined with other regions`)),
+			},
+		},
+	})
+}
+
+func TestValidateHealthcheckRegions(t *testing.T) {
+	testCases := map[string]struct {
+		regions []string
+		err     bool
+	}{
+		"empty":                    {regions: []string{}},
+		"single region":            {regions: []string{"WEU"}},
+		"multiple regions":         {regions: []string{"WEU", "ENAM", "OC"}},
+		"all regions":              {regions: []string{"ALL_REGIONS"}},
+		"unknown region":           {regions: []string{"MARS"}, err: true},
+		"lowercase region":         {regions: []string{"weu"}, err: true},
+		"duplicate region":         {regions: []string{"WEU", "WEU"}, err: true},
+		"all regions with another": {regions: []string{"ALL_REGIONS", "WEU"}, err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateHealthcheckRegions(tc.regions)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAccCloudflareHealthcheckMissingRequired(t *testing.T) {
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
 
@@ -171,6 +371,33 @@ func testAccCheckCloudflareHealthcheckTCP(zoneID, name, ID string) string {
   }`, zoneID, name, ID)
 }
 
+func testAccCheckCloudflareHealthcheckSuspended(zoneID, ID string, suspended bool) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_healthcheck" "%[2]s" {
+    zone_id = "%[1]s"
+    name = "%[2]s"
+    address = "example.com"
+    type = "TCP"
+    method = "connection_established"
+    port = 80
+    suspended = %[3]t
+    check_regions = ["WEU", "ENAM"]
+  }`, zoneID, ID, suspended)
+}
+
+func testAccCheckCloudflareHealthcheckCheckRegions(zoneID, ID, regions string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_healthcheck" "%[2]s" {
+    zone_id = "%[1]s"
+    name = "%[2]s"
+    address = "example.com"
+    type = "TCP"
+    method = "connection_established"
+    port = 80
+    check_regions = [%[3]s]
+  }`, zoneID, ID, regions)
+}
+
 func testAccCheckCloudflareHealthcheckHTTP(zoneID, ID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_healthcheck" "%[2]s" {
@@ -184,6 +411,17 @@ func testAccCheckCloudflareHealthcheckHTTP(zoneID, ID string) string {
   }`, zoneID, ID)
 }
 
+func testAccCheckCloudflareHealthcheckTCPWithPath(zoneID, ID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_healthcheck" "%[2]s" {
+    zone_id = "%[1]s"
+    name = "%[2]s"
+    address = "example.com"
+    type = "TCP"
+    path = "/health"
+  }`, zoneID, ID)
+}
+
 func testAccCheckHealthcheckConfigMissingRequired(zoneID, ID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_healthcheck" "%[2]s" {
@@ -0,0 +1,238 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var hyperdriveConfigOriginSchemes = []string{"postgres", "postgresql"}
+
+// hyperdriveConfig is a Hyperdrive database connection pooling
+// configuration.
+type hyperdriveConfig struct {
+	ID      string                   `json:"id,omitempty"`
+	Name    string                   `json:"name"`
+	Origin  hyperdriveConfigOrigin   `json:"origin"`
+	Caching *hyperdriveConfigCaching `json:"caching,omitempty"`
+}
+
+type hyperdriveConfigOrigin struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Database string `json:"database"`
+	Scheme   string `json:"scheme,omitempty"`
+	User     string `json:"user"`
+	Password string `json:"password,omitempty"`
+}
+
+type hyperdriveConfigCaching struct {
+	Disabled             bool `json:"disabled"`
+	MaxAge               *int `json:"max_age,omitempty"`
+	StaleWhileRevalidate *int `json:"stale_while_revalidate,omitempty"`
+}
+
+func resourceCloudflareHyperdriveConfig() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareHyperdriveConfigSchema(),
+		CreateContext: resourceCloudflareHyperdriveConfigCreate,
+		ReadContext:   resourceCloudflareHyperdriveConfigRead,
+		UpdateContext: resourceCloudflareHyperdriveConfigUpdate,
+		DeleteContext: resourceCloudflareHyperdriveConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareHyperdriveConfigImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Hyperdrive configuration resource.
+			Hyperdrive pools connections to an origin database and can cache
+			the results of queries made through it.
+		`),
+	}
+}
+
+func resourceCloudflareHyperdriveConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Hyperdrive config %q", d.Get("name").(string)))
+
+	config, err := writeHyperdriveConfig(ctx, client, http.MethodPost, hyperdriveConfigURI(accountID, ""), buildHyperdriveConfig(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Hyperdrive config for account %q: %w", accountID, err))
+	}
+
+	d.SetId(config.ID)
+
+	return resourceCloudflareHyperdriveConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareHyperdriveConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, hyperdriveConfigURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Hyperdrive config %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Hyperdrive config %q: %w", d.Id(), err))
+	}
+
+	var config hyperdriveConfig
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Hyperdrive config: %w", err))
+	}
+
+	d.Set("name", config.Name)
+
+	// The API never returns the password so keep the one already in state.
+	if err := d.Set("origin", flattenHyperdriveConfigOrigin(config.Origin, d.Get("origin.0.password").(string))); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set origin: %w", err))
+	}
+
+	if err := d.Set("caching", flattenHyperdriveConfigCaching(config.Caching)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set caching: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareHyperdriveConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Hyperdrive config using ID: %s", d.Id()))
+
+	if _, err := writeHyperdriveConfig(ctx, client, http.MethodPut, hyperdriveConfigURI(accountID, d.Id()), buildHyperdriveConfig(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Hyperdrive config %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareHyperdriveConfigRead(ctx, d, meta)
+}
+
+func resourceCloudflareHyperdriveConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Hyperdrive config using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, hyperdriveConfigURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Hyperdrive config %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareHyperdriveConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/configID\"", d.Id())
+	}
+
+	accountID, configID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Hyperdrive config: id %s for account %s", configID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(configID)
+
+	resourceCloudflareHyperdriveConfigRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// writeHyperdriveConfig sends a configuration containing the origin password
+// to the API. Request and response dumps of the API client are disabled for
+// the call so that the password never ends up in the debug logs.
+func writeHyperdriveConfig(ctx context.Context, client *cloudflare.API, method, uri string, config hyperdriveConfig) (hyperdriveConfig, error) {
+	var result hyperdriveConfig
+	res, err := quietClient(client).Raw(ctx, method, uri, config, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal Hyperdrive config: %w", err)
+	}
+
+	return result, nil
+}
+
+func buildHyperdriveConfig(d *schema.ResourceData) hyperdriveConfig {
+	config := hyperdriveConfig{
+		Name: d.Get("name").(string),
+		Origin: hyperdriveConfigOrigin{
+			Host:     d.Get("origin.0.host").(string),
+			Port:     d.Get("origin.0.port").(int),
+			Database: d.Get("origin.0.database").(string),
+			Scheme:   d.Get("origin.0.scheme").(string),
+			User:     d.Get("origin.0.user").(string),
+			Password: d.Get("origin.0.password").(string),
+		},
+	}
+
+	if _, ok := d.GetOk("caching"); ok {
+		config.Caching = &hyperdriveConfigCaching{
+			Disabled: d.Get("caching.0.disabled").(bool),
+		}
+		if maxAge, ok := d.GetOk("caching.0.max_age"); ok {
+			config.Caching.MaxAge = cloudflare.IntPtr(maxAge.(int))
+		}
+		if staleWhileRevalidate, ok := d.GetOk("caching.0.stale_while_revalidate"); ok {
+			config.Caching.StaleWhileRevalidate = cloudflare.IntPtr(staleWhileRevalidate.(int))
+		}
+	}
+
+	return config
+}
+
+func flattenHyperdriveConfigOrigin(origin hyperdriveConfigOrigin, password string) []map[string]interface{} {
+	return []map[string]interface{}{{
+		"host":     origin.Host,
+		"port":     origin.Port,
+		"database": origin.Database,
+		"scheme":   origin.Scheme,
+		"user":     origin.User,
+		"password": password,
+	}}
+}
+
+func flattenHyperdriveConfigCaching(caching *hyperdriveConfigCaching) []map[string]interface{} {
+	if caching == nil {
+		return nil
+	}
+
+	flattened := map[string]interface{}{
+		"disabled": caching.Disabled,
+	}
+	if caching.MaxAge != nil {
+		flattened["max_age"] = *caching.MaxAge
+	}
+	if caching.StaleWhileRevalidate != nil {
+		flattened["stale_while_revalidate"] = *caching.StaleWhileRevalidate
+	}
+
+	return []map[string]interface{}{flattened}
+}
+
+func hyperdriveConfigURI(accountID, configID string) string {
+	uri := fmt.Sprintf("/accounts/%s/hyperdrive/configs", accountID)
+	if configID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, configID)
+	}
+	return uri
+}
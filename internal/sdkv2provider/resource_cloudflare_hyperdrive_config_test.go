@@ -0,0 +1,133 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareHyperdriveConfig_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_hyperdrive_config.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckHyperdrive(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareHyperdriveConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareHyperdriveConfig(rnd, accountID, rnd, false, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "origin.0.host", os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_HOSTNAME")),
+					resource.TestCheckResourceAttr(name, "origin.0.port", os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_PORT")),
+					resource.TestCheckResourceAttr(name, "origin.0.database", os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_NAME")),
+					resource.TestCheckResourceAttr(name, "origin.0.user", os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_USER")),
+					resource.TestCheckResourceAttr(name, "origin.0.scheme", "postgres"),
+					resource.TestCheckResourceAttr(name, "caching.0.disabled", "false"),
+					resource.TestCheckResourceAttr(name, "caching.0.max_age", "60"),
+				),
+			},
+			{
+				Config: testAccCloudflareHyperdriveConfig(rnd, accountID, rnd+"-updated", true, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "name", rnd+"-updated"),
+					resource.TestCheckResourceAttr(name, "caching.0.disabled", "true"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"origin.0.password"},
+			},
+		},
+	})
+}
+
+func TestFlattenHyperdriveConfigKeepsPassword(t *testing.T) {
+	config := hyperdriveConfig{
+		Name: "example",
+		Origin: hyperdriveConfigOrigin{
+			Host:     "db.example.com",
+			Port:     5432,
+			Database: "postgres",
+			Scheme:   "postgres",
+			User:     "admin",
+		},
+		Caching: &hyperdriveConfigCaching{
+			MaxAge:               cloudflare.IntPtr(60),
+			StaleWhileRevalidate: cloudflare.IntPtr(15),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareHyperdriveConfigSchema(), map[string]interface{}{})
+	d.Set("name", config.Name)
+	assert.NoError(t, d.Set("origin", flattenHyperdriveConfigOrigin(config.Origin, "secret")))
+	assert.NoError(t, d.Set("caching", flattenHyperdriveConfigCaching(config.Caching)))
+
+	built := buildHyperdriveConfig(d)
+	config.Origin.Password = "secret"
+	config.Caching.Disabled = false
+
+	assert.Equal(t, config, built)
+}
+
+func testAccCloudflareHyperdriveConfig(rnd, accountID, name string, cachingDisabled bool, maxAge int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_hyperdrive_config" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[3]s"
+
+  origin {
+    host     = "%[6]s"
+    port     = %[7]s
+    database = "%[8]s"
+    user     = "%[9]s"
+    password = "%[10]s"
+  }
+
+  caching {
+    disabled = %[4]t
+    max_age  = %[5]d
+  }
+}
+`, rnd, accountID, name, cachingDisabled, maxAge,
+		os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_HOSTNAME"),
+		os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_PORT"),
+		os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_NAME"),
+		os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_USER"),
+		os.Getenv("CLOUDFLARE_HYPERDRIVE_DATABASE_PASSWORD"),
+	)
+}
+
+func testAccCheckCloudflareHyperdriveConfigDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_hyperdrive_config" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, hyperdriveConfigURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Hyperdrive config still exists")
+		}
+	}
+
+	return nil
+}
@@ -97,9 +97,15 @@ func resourceCloudflareIPsecTunnelRead(ctx context.Context, d *schema.ResourceDa
 	d.Set("customer_endpoint", tunnel.CustomerEndpoint)
 	d.Set("cloudflare_endpoint", tunnel.CloudflareEndpoint)
 	d.Set("interface_address", tunnel.InterfaceAddress)
-	d.Set("health_check_enabled", tunnel.HealthCheck.Enabled)
-	d.Set("health_check_target", tunnel.HealthCheck.Target)
-	d.Set("health_check_type", tunnel.HealthCheck.Type)
+
+	// Tunnels without any health check data have health checks disabled.
+	healthcheck := tunnel.HealthCheck
+	if healthcheck == nil {
+		healthcheck = &cloudflare.MagicTransitTunnelHealthcheck{}
+	}
+	d.Set("health_check_enabled", healthcheck.Enabled)
+	d.Set("health_check_target", healthcheck.Target)
+	d.Set("health_check_type", healthcheck.Type)
 	d.Set("allow_null_cipher", tunnel.AllowNullCipher)
 
 	// Set Remote Identities
@@ -176,5 +182,32 @@ func IPsecTunnelFromResource(d *schema.ResourceData) cloudflare.MagicTransitIPse
 		tunnel.AllowNullCipher = allowNullCipher.(bool)
 	}
 
+	tunnel.HealthCheck = IPsecTunnelHealthcheckFromResource(d)
+
 	return tunnel
 }
+
+func IPsecTunnelHealthcheckFromResource(d *schema.ResourceData) *cloudflare.MagicTransitTunnelHealthcheck {
+	healthcheck := cloudflare.MagicTransitTunnelHealthcheck{}
+
+	healthcheckEnabled, healthcheckEnabledOk := d.GetOk("health_check_enabled")
+	if healthcheckEnabledOk {
+		healthcheck.Enabled = healthcheckEnabled.(bool)
+	}
+
+	healthcheckTarget, healthcheckTargetOk := tunnelHealthCheckTarget(d)
+	if healthcheckTargetOk {
+		healthcheck.Target = healthcheckTarget
+	}
+
+	healthcheckType, healthcheckTypeOk := d.GetOk("health_check_type")
+	if healthcheckTypeOk {
+		healthcheck.Type = healthcheckType.(string)
+	}
+
+	if healthcheckEnabledOk || healthcheckTargetOk || healthcheckTypeOk {
+		return &healthcheck
+	}
+
+	return nil
+}
@@ -134,6 +134,38 @@ func TestAccCloudflareIPsecTunnelUpdatePsk(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareIPsecTunnelDerivedHealthCheckTarget(t *testing.T) {
+	skipMagicTransitTestForNonConfiguredDefaultZone(t)
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_ipsec_tunnel.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheckAccount(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareIPsecTunnelWithoutHealthCheckTarget(rnd, accountID, "203.0.113.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.1"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareIPsecTunnelWithoutHealthCheckTarget(rnd, accountID, "203.0.113.1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckCloudflareIPsecTunnelWithoutHealthCheckTarget(rnd, accountID, "203.0.113.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "customer_endpoint", "203.0.113.2"),
+					resource.TestCheckResourceAttr(name, "health_check_target", "203.0.113.2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCloudflareIPsecTunnelSimple(ID, description, accountID, psk string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ipsec_tunnel" "%[1]s" {
@@ -150,3 +182,17 @@ func testAccCheckCloudflareIPsecTunnelSimple(ID, description, accountID, psk str
 	allow_null_cipher = false
   }`, ID, description, accountID, psk)
 }
+
+func testAccCheckCloudflareIPsecTunnelWithoutHealthCheckTarget(ID, accountID, customerEndpoint string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ipsec_tunnel" "%[1]s" {
+	account_id = "%[2]s"
+	name = "%[1]s"
+	customer_endpoint = "%[3]s"
+	cloudflare_endpoint = "162.159.64.41"
+	interface_address = "10.212.0.9/31"
+	health_check_enabled = true
+	health_check_type = "reply"
+	psk = "asdf1234"
+  }`, ID, accountID, customerEndpoint)
+}
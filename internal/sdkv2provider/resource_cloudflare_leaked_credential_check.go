@@ -0,0 +1,109 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// leakedCredentialCheck is the WAF leaked credential checks status of a zone.
+type leakedCredentialCheck struct {
+	Enabled bool `json:"enabled"`
+}
+
+func resourceCloudflareLeakedCredentialCheck() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLeakedCredentialCheckSchema(),
+		CreateContext: resourceCloudflareLeakedCredentialCheckUpdate,
+		ReadContext:   resourceCloudflareLeakedCredentialCheckRead,
+		UpdateContext: resourceCloudflareLeakedCredentialCheckUpdate,
+		DeleteContext: resourceCloudflareLeakedCredentialCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLeakedCredentialCheckImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare WAF leaked credential checks resource.
+			Leaked credential checks detect requests using credentials that
+			were previously leaked. Deleting the resource disables the checks.
+		`),
+	}
+}
+
+func resourceCloudflareLeakedCredentialCheckRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, leakedCredentialCheckURI(zoneID), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Leaked credential checks status for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading leaked credential checks status for zone %q: %w", zoneID, err))
+	}
+
+	var status leakedCredentialCheck
+	if err := json.Unmarshal(res, &status); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal leaked credential checks status for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("enabled", status.Enabled)
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	status := leakedCredentialCheck{Enabled: d.Get("enabled").(bool)}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating leaked credential checks status for zone %q: %+v", zoneID, status))
+
+	if _, err := client.Raw(ctx, http.MethodPost, leakedCredentialCheckURI(zoneID), status, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating leaked credential checks status for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareLeakedCredentialCheckRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Disabling leaked credential checks for zone %q", zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodPost, leakedCredentialCheckURI(zoneID), leakedCredentialCheck{Enabled: false}, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling leaked credential checks for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare leaked credential checks status for zone %q", zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+
+	resourceCloudflareLeakedCredentialCheckRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func leakedCredentialCheckURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/leaked-credential-checks", zoneID)
+}
@@ -0,0 +1,168 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// leakedCredentialCheckRule is a custom detection locating the credentials of
+// a request for the leaked credential checks.
+type leakedCredentialCheckRule struct {
+	ID       string `json:"id,omitempty"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+func resourceCloudflareLeakedCredentialCheckRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareLeakedCredentialCheckRuleSchema(),
+		CreateContext: resourceCloudflareLeakedCredentialCheckRuleCreate,
+		ReadContext:   resourceCloudflareLeakedCredentialCheckRuleRead,
+		UpdateContext: resourceCloudflareLeakedCredentialCheckRuleUpdate,
+		DeleteContext: resourceCloudflareLeakedCredentialCheckRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareLeakedCredentialCheckRuleImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare WAF leaked credential checks custom
+			detection rule resource. Custom detection rules tell the leaked
+			credential checks where to find the username and password of a
+			request.
+		`),
+	}
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating leaked credential checks rule for zone %q", zoneID))
+
+	rule, err := writeLeakedCredentialCheckRule(ctx, client, http.MethodPost, leakedCredentialCheckRuleURI(zoneID, ""), buildLeakedCredentialCheckRule(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating leaked credential checks rule for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, leakedCredentialCheckRuleURI(zoneID, ""), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Leaked credential checks rules for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error listing leaked credential checks rules for zone %q: %w", zoneID, err))
+	}
+
+	var rules []leakedCredentialCheckRule
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal leaked credential checks rules: %w", err))
+	}
+
+	for _, rule := range rules {
+		if rule.ID == d.Id() {
+			d.Set("username", rule.Username)
+			d.Set("password", rule.Password)
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Leaked credential checks rule %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating leaked credential checks rule %q", d.Id()))
+
+	if _, err := writeLeakedCredentialCheckRule(ctx, client, http.MethodPut, leakedCredentialCheckRuleURI(zoneID, d.Id()), buildLeakedCredentialCheckRule(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating leaked credential checks rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting leaked credential checks rule %q", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, leakedCredentialCheckRuleURI(zoneID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting leaked credential checks rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareLeakedCredentialCheckRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/ruleID\"", d.Id())
+	}
+
+	zoneID, ruleID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare leaked credential checks rule: id %s for zone %s", ruleID, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(ruleID)
+
+	resourceCloudflareLeakedCredentialCheckRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildLeakedCredentialCheckRule(d *schema.ResourceData) leakedCredentialCheckRule {
+	return leakedCredentialCheckRule{
+		Username: d.Get("username").(string),
+		Password: d.Get("password").(string),
+	}
+}
+
+func writeLeakedCredentialCheckRule(ctx context.Context, client *cloudflare.API, method, uri string, rule leakedCredentialCheckRule) (leakedCredentialCheckRule, error) {
+	var result leakedCredentialCheckRule
+	res, err := client.Raw(ctx, method, uri, rule, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal leaked credential checks rule: %w", err)
+	}
+
+	return result, nil
+}
+
+func leakedCredentialCheckRuleURI(zoneID, ruleID string) string {
+	uri := fmt.Sprintf("%s/detections", leakedCredentialCheckURI(zoneID))
+	if ruleID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, ruleID)
+	}
+	return uri
+}
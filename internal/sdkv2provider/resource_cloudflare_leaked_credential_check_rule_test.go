@@ -0,0 +1,108 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareLeakedCredentialCheckRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_leaked_credential_check_rule." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLeakedCredentialCheckRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, "user", "secret"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "username", `lookup_json_string(http.request.body.raw, "user")`),
+					resource.TestCheckResourceAttr(name, "password", `lookup_json_string(http.request.body.raw, "secret")`),
+				),
+			},
+			{
+				Config: testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, "email", "secret"),
+				Check:  resource.TestCheckResourceAttr(name, "username", `lookup_json_string(http.request.body.raw, "email")`),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareLeakedCredentialCheckRuleRead(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testclient.WriteResult(w, json.RawMessage(`[
+			{"id": "18a14bafaa8eb1df04ce683ec18c765e", "username": "lookup_json_string(http.request.body.raw, \"user\")", "password": "lookup_json_string(http.request.body.raw, \"secret\")"}
+		]`))
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLeakedCredentialCheckRuleSchema(), map[string]interface{}{
+		"zone_id": "0da42c8d2132a9ddaf714f9e7c920711",
+	})
+
+	d.SetId("18a14bafaa8eb1df04ce683ec18c765e")
+	assert.False(t, resourceCloudflareLeakedCredentialCheckRuleRead(context.Background(), d, client).HasError())
+	assert.Equal(t, `lookup_json_string(http.request.body.raw, "user")`, d.Get("username"))
+	assert.Equal(t, `lookup_json_string(http.request.body.raw, "secret")`, d.Get("password"))
+
+	d.SetId("4ab5e40ca8394c8c9fb1cd2b04d2c2f6")
+	assert.False(t, resourceCloudflareLeakedCredentialCheckRuleRead(context.Background(), d, client).HasError())
+	assert.Equal(t, "", d.Id())
+}
+
+func testAccCloudflareLeakedCredentialCheckRuleConfig(rnd, zoneID, usernameField, passwordField string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_leaked_credential_check_rule" "%[1]s" {
+  zone_id  = "%[2]s"
+  username = "lookup_json_string(http.request.body.raw, \"%[3]s\")"
+  password = "lookup_json_string(http.request.body.raw, \"%[4]s\")"
+}
+`, rnd, zoneID, usernameField, passwordField)
+}
+
+func testAccCheckCloudflareLeakedCredentialCheckRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_leaked_credential_check_rule" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, leakedCredentialCheckRuleURI(rs.Primary.Attributes["zone_id"], ""), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var rules []leakedCredentialCheckRule
+		if err := json.Unmarshal(res, &rules); err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if rule.ID == rs.Primary.ID {
+				return fmt.Errorf("leaked credential checks rule %s still exists", rs.Primary.ID)
+			}
+		}
+	}
+
+	return nil
+}
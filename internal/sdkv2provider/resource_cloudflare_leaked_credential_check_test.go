@@ -0,0 +1,78 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareLeakedCredentialCheck_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_leaked_credential_check." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLeakedCredentialCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareLeakedCredentialCheckConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareLeakedCredentialCheckConfig(rnd, zoneID, false),
+				Check:  resource.TestCheckResourceAttr(name, "enabled", "false"),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareLeakedCredentialCheckConfig(rnd, zoneID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_leaked_credential_check" "%[1]s" {
+  zone_id = "%[2]s"
+  enabled = %[3]t
+}
+`, rnd, zoneID, enabled)
+}
+
+func testAccCheckCloudflareLeakedCredentialCheckDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_leaked_credential_check" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, leakedCredentialCheckURI(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var status leakedCredentialCheck
+		if err := json.Unmarshal(res, &status); err != nil {
+			return err
+		}
+		if status.Enabled {
+			return fmt.Errorf("leaked credential checks for zone %s are still enabled", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
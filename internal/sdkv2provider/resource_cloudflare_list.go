@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/pkg/errors"
 )
@@ -24,6 +26,10 @@ func resourceCloudflareList() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareListImport,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
 		Description: heredoc.Doc(`
 			Provides Lists (IPs, Redirects) to be used in Edge Rules Engine
 			across all zones within the same account.
@@ -48,13 +54,17 @@ func resourceCloudflareListCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if itemData, ok := d.GetOk("item"); ok {
 		items := buildListItemsCreateRequest(itemData.(*schema.Set).List())
-		_, err = client.CreateListItems(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListCreateItemsParams{
+		operation, err := client.CreateListItemsAsync(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListCreateItemsParams{
 			ID:    d.Id(),
 			Items: items,
 		})
 		if err != nil {
 			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
 		}
+
+		if err := waitForListBulkOperation(ctx, client, accountID, operation.Result.OperationID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+		}
 	}
 
 	return resourceCloudflareListRead(ctx, d, meta)
@@ -101,6 +111,85 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error reading List Items")))
 	}
 
+	d.Set("item", flattenListItems(items))
+
+	return nil
+}
+
+func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	if d.HasChange("description") {
+		_, err := client.UpdateList(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListUpdateParams{
+			ID:          d.Id(),
+			Description: d.Get("description").(string),
+		})
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating List description")))
+		}
+	}
+
+	// Only replace the items when they have actually changed to avoid
+	// needlessly churning every item in the list on unrelated updates.
+	if d.HasChange("item") {
+		items := buildListItemsCreateRequest(d.Get("item").(*schema.Set).List())
+		if items == nil {
+			items = []cloudflare.ListItemCreateRequest{}
+		}
+		operation, err := client.ReplaceListItemsAsync(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListReplaceItemsParams{
+			ID:    d.Id(),
+			Items: items,
+		})
+		if err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+		}
+
+		if err := waitForListBulkOperation(ctx, client, accountID, operation.Result.OperationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
+		}
+	}
+
+	return resourceCloudflareListRead(ctx, d, meta)
+}
+
+func resourceCloudflareListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	_, err := client.DeleteList(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting List with ID %q", d.Id())))
+	}
+
+	return nil
+}
+
+// waitForListBulkOperation polls an asynchronous List items operation until it
+// has completed. Failed operations return the error reported by the API.
+func waitForListBulkOperation(ctx context.Context, client *cloudflare.API, accountID, operationID string, timeout time.Duration) error {
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		operation, err := client.GetListBulkOperation(ctx, cloudflare.AccountIdentifier(accountID), operationID)
+		if err != nil {
+			return resource.NonRetryableError(errors.Wrap(err, fmt.Sprintf("failed to fetch List bulk operation %s", operationID)))
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("List bulk operation %s status %s", operationID, operation.Status))
+
+		switch operation.Status {
+		case "completed":
+			return nil
+		case "pending", "running":
+			return resource.RetryableError(fmt.Errorf("List bulk operation %s is %s", operationID, operation.Status))
+		case "failed":
+			return resource.NonRetryableError(fmt.Errorf("List bulk operation %s failed: %s", operationID, operation.Error))
+		default:
+			return resource.NonRetryableError(fmt.Errorf("List bulk operation %s has unexpected status %q", operationID, operation.Status))
+		}
+	})
+}
+
+func flattenListItems(items []cloudflare.ListItem) []map[string]interface{} {
 	var itemData []map[string]interface{}
 	var item map[string]interface{}
 
@@ -146,47 +235,7 @@ func resourceCloudflareListRead(ctx context.Context, d *schema.ResourceData, met
 		itemData = append(itemData, item)
 	}
 
-	d.Set("item", itemData)
-
-	return nil
-}
-
-func resourceCloudflareListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get(consts.AccountIDSchemaKey).(string)
-
-	_, err := client.UpdateList(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListUpdateParams{
-		ID:          d.Id(),
-		Description: d.Get("description").(string),
-	})
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error updating List description")))
-	}
-
-	if itemData, ok := d.GetOk("item"); ok {
-		items := buildListItemsCreateRequest(itemData.(*schema.Set).List())
-		_, err = client.ReplaceListItems(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListReplaceItemsParams{
-			ID:    d.Id(),
-			Items: items,
-		})
-		if err != nil {
-			return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error creating List Items")))
-		}
-	}
-
-	return resourceCloudflareListRead(ctx, d, meta)
-}
-
-func resourceCloudflareListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*cloudflare.API)
-	accountID := d.Get(consts.AccountIDSchemaKey).(string)
-
-	_, err := client.DeleteList(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
-	if err != nil {
-		return diag.FromErr(errors.Wrap(err, fmt.Sprintf("error deleting List with ID %q", d.Id())))
-	}
-
-	return nil
+	return itemData
 }
 
 func buildListItemsCreateRequest(items []interface{}) []cloudflare.ListItemCreateRequest {
@@ -198,7 +247,7 @@ func buildListItemsCreateRequest(items []interface{}) []cloudflare.ListItemCreat
 		var ip *string = nil
 
 		if field, ok := value["ip"]; ok {
-			if field, ok := field.(string); ok {
+			if field, ok := field.(string); ok && field != "" {
 				ip = &field
 			}
 		}
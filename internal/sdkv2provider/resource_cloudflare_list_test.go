@@ -3,12 +3,20 @@ package sdkv2provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"reflect"
+	"regexp"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareList_Exists(t *testing.T) {
@@ -92,6 +100,59 @@ func TestAccCloudflareList_UpdateDescription(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareList_UpdateDescriptionWithItems(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var list cloudflare.List
+	var initialItemIDs, updatedItemIDs []string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareListIPListOrdered(rnd, rnd, rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareListExists(name, &list),
+					testAccCheckCloudflareListItemIDs(name, &initialItemIDs),
+					resource.TestCheckResourceAttr(name, "description", rnd),
+					resource.TestCheckResourceAttr(name, "item.#", "4"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareListIPListOrdered(rnd, rnd, rnd+"-updated", accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareListExists(name, &list),
+					testAccCheckCloudflareListItemIDs(name, &updatedItemIDs),
+					func(state *terraform.State) error {
+						if !reflect.DeepEqual(initialItemIDs, updatedItemIDs) {
+							return fmt.Errorf("wanted only the description to be updated but List items were replaced (%v -> %v)", initialItemIDs, updatedItemIDs)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr(name, "description", rnd+"-updated"),
+					resource.TestCheckResourceAttr(name, "item.#", "4"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareListIPListOrdered(rnd, rnd, rnd+"-updated", accountID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccCloudflareList_Update(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
 	// endpoint does not yet support the API tokens.
@@ -185,6 +246,80 @@ func TestAccCloudflareList_Update(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareList_RedirectAllFields(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
+	// endpoint does not yet support the API tokens.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_list.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	var list cloudflare.List
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareListRedirectAllFields(rnd, accountID, 308),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareListExists(name, &list),
+					resource.TestCheckResourceAttr(name, "kind", "redirect"),
+					resource.TestCheckResourceAttr(name, "item.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "item.*", map[string]string{
+						"value.0.redirect.0.source_url":            "example.com/foo",
+						"value.0.redirect.0.target_url":            "https://foo.example.com",
+						"value.0.redirect.0.status_code":           "308",
+						"value.0.redirect.0.include_subdomains":    "enabled",
+						"value.0.redirect.0.subpath_matching":      "enabled",
+						"value.0.redirect.0.preserve_query_string": "disabled",
+						"value.0.redirect.0.preserve_path_suffix":  "enabled",
+					}),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareListRedirectAllFields(rnd, accountID, 308),
+				PlanOnly: true,
+			},
+			{
+				Config:      testAccCheckCloudflareListRedirectAllFields(rnd, accountID, 200),
+				ExpectError: regexp.MustCompile(`expected value\.0\.redirect\.0\.status_code to be one of \[301 302 307 308\]`),
+			},
+		},
+	})
+}
+
+func TestListItemsRedirectRoundTrip(t *testing.T) {
+	items := []cloudflare.ListItem{{
+		Comment: "redirect",
+		Redirect: &cloudflare.Redirect{
+			SourceUrl:           "example.com/foo",
+			TargetUrl:           "https://foo.example.com",
+			StatusCode:          cloudflare.IntPtr(307),
+			IncludeSubdomains:   cloudflare.BoolPtr(true),
+			SubpathMatching:     cloudflare.BoolPtr(false),
+			PreserveQueryString: cloudflare.BoolPtr(true),
+			PreservePathSuffix:  cloudflare.BoolPtr(false),
+		},
+	}}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareListSchema(), map[string]interface{}{})
+	assert.NoError(t, d.Set("item", flattenListItems(items)))
+
+	requests := buildListItemsCreateRequest(d.Get("item").(*schema.Set).List())
+	if assert.Len(t, requests, 1) {
+		assert.Nil(t, requests[0].IP)
+		assert.Equal(t, "redirect", requests[0].Comment)
+		assert.Equal(t, items[0].Redirect, requests[0].Redirect)
+	}
+}
+
 func TestAccCloudflareList_UpdateIgnoreIPOrdering(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the IP List
 	// endpoint does not yet support the API tokens.
@@ -312,6 +447,34 @@ func testAccCheckCloudflareListExists(n string, list *cloudflare.List) resource.
 	}
 }
 
+func testAccCheckCloudflareListItemIDs(n string, ids *[]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		items, err := client.ListListItems(context.Background(), cloudflare.AccountIdentifier(accountID), cloudflare.ListListItemsParams{
+			ID: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		found := make([]string, 0, len(items))
+		for _, item := range items {
+			found = append(found, item.ID)
+		}
+		sort.Strings(found)
+
+		*ids = found
+
+		return nil
+	}
+}
+
 func testAccCheckCloudflareList(ID, name, description, accountID, kind string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_list" "%[1]s" {
@@ -399,3 +562,67 @@ func testAccCheckCloudflareListRedirectUpdateTargetUrl(ID, name, description, ac
     }
   }`, ID, name, description, accountID)
 }
+
+func testAccCheckCloudflareListRedirectAllFields(ID, accountID string, statusCode int) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_list" "%[1]s" {
+    account_id = "%[2]s"
+    name = "%[1]s"
+    description = "%[1]s"
+    kind = "redirect"
+
+    item {
+      value {
+        redirect {
+          source_url = "example.com/foo"
+          target_url = "https://foo.example.com"
+          status_code = %[3]d
+          include_subdomains = "enabled"
+          subpath_matching = "enabled"
+          preserve_query_string = "disabled"
+          preserve_path_suffix = "enabled"
+        }
+      }
+      comment = "all fields"
+    }
+  }`, ID, accountID, statusCode)
+}
+
+func TestWaitForListBulkOperation(t *testing.T) {
+	testCases := map[string]struct {
+		statuses         []string
+		expectedRequests int
+		err              string
+	}{
+		"pending then completed": {statuses: []string{"pending", "running", "completed"}, expectedRequests: 3},
+		"failed":                 {statuses: []string{"pending", "failed"}, expectedRequests: 2, err: "List bulk operation 4da8780eeb215e6cb7f48dd981c4ea02 failed: invalid item"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", r.URL.Path)
+
+				operation := map[string]interface{}{
+					"id":     "4da8780eeb215e6cb7f48dd981c4ea02",
+					"status": tc.statuses[requests],
+				}
+				if tc.statuses[requests] == "failed" {
+					operation["error"] = "invalid item"
+				}
+				requests++
+
+				testclient.WriteResult(w, operation)
+			}))
+
+			err := waitForListBulkOperation(context.Background(), client, "f037e56e89293a057740de681ac9abbe", "4da8780eeb215e6cb7f48dd981c4ea02", time.Minute)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.expectedRequests, requests)
+		})
+	}
+}
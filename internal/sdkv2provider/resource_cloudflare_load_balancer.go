@@ -17,6 +17,14 @@ import (
 	"github.com/pkg/errors"
 )
 
+// loadBalancerDefaultTTL is the TTL Cloudflare uses for non-proxied load
+// balancers that don't set one.
+const loadBalancerDefaultTTL = 30
+
+// loadBalancerGeoPoolAttributes are the attributes mapping locations to pools
+// which are used by the `geo` steering policy.
+var loadBalancerGeoPoolAttributes = []string{"pop_pools", "country_pools", "region_pools"}
+
 func resourceCloudflareLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceCloudflareLoadBalancerCreate,
@@ -29,7 +37,8 @@ func resourceCloudflareLoadBalancer() *schema.Resource {
 
 		SchemaVersion: 1,
 
-		Schema: resourceCloudflareLoadBalancerSchema(),
+		Schema:        resourceCloudflareLoadBalancerSchema(),
+		CustomizeDiff: resourceCloudflareLoadBalancerCustomizeDiff,
 
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -824,3 +833,67 @@ func expandRandomSteering(set interface{}) *cloudflare.RandomSteering {
 
 	return &cfRandomSteering
 }
+
+// resourceCloudflareLoadBalancerCustomizeDiff validates at plan time that the
+// ttl is only configured for non-proxied load balancers and that the
+// attributes required by the steering policy are configured. When the ttl
+// isn't configured, the value Cloudflare will use is planned instead.
+func resourceCloudflareLoadBalancerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	ttlConfigured := !config.GetAttr("ttl").IsNull()
+
+	var steeringPolicy string
+	if v := config.GetAttr("steering_policy"); v.IsKnown() && !v.IsNull() {
+		steeringPolicy = v.AsString()
+	}
+
+	var geoPools []string
+	for _, attr := range loadBalancerGeoPoolAttributes {
+		if v := config.GetAttr(attr); !v.IsNull() && (!v.IsKnown() || v.LengthInt() > 0) {
+			geoPools = append(geoPools, attr)
+		}
+	}
+
+	if !d.NewValueKnown("proxied") {
+		if !ttlConfigured {
+			return d.SetNewComputed("ttl")
+		}
+		return nil
+	}
+
+	proxied := d.Get("proxied").(bool)
+	if err := validateLoadBalancerSteering(proxied, ttlConfigured, steeringPolicy, geoPools); err != nil {
+		return err
+	}
+
+	if !ttlConfigured {
+		ttl := loadBalancerDefaultTTL
+		if proxied {
+			ttl = 0
+		}
+		if d.Get("ttl").(int) != ttl {
+			return d.SetNew("ttl", ttl)
+		}
+	}
+
+	return nil
+}
+
+// validateLoadBalancerSteering ensures the ttl is only configured for
+// non-proxied load balancers and that the `geo` steering policy has pools
+// mapped to locations to steer traffic to.
+func validateLoadBalancerSteering(proxied, ttlConfigured bool, steeringPolicy string, configuredGeoPools []string) error {
+	if proxied && ttlConfigured {
+		return fmt.Errorf("ttl cannot be set for proxied load balancers, remove it or set proxied to false")
+	}
+
+	if steeringPolicy == "geo" && len(configuredGeoPools) == 0 {
+		return fmt.Errorf("steering_policy \"geo\" requires at least one of %s", strings.Join(loadBalancerGeoPoolAttributes, ", "))
+	}
+
+	return nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -378,6 +379,82 @@ func TestAccCloudflareLoadBalancer_DuplicatePool(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareLoadBalancer_ProxiedWithTTL(t *testing.T) {
+	t.Parallel()
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareLoadBalancerConfigProxiedWithTTL(zoneID, zone, rnd, true),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("ttl cannot be set for proxied load balancers")),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareLoadBalancer_NonProxiedWithTTL(t *testing.T) {
+	t.Parallel()
+	var loadBalancer cloudflare.LoadBalancer
+	zone := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_load_balancer." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareLoadBalancerConfigProxiedWithTTL(zoneID, zone, rnd, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareLoadBalancerExists(name, &loadBalancer),
+					resource.TestCheckResourceAttr(name, "proxied", "false"),
+					resource.TestCheckResourceAttr(name, "ttl", "60"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareLoadBalancerConfigBasic(zoneID, zone, rnd),
+				Check:  resource.TestCheckResourceAttr(name, "ttl", "30"),
+			},
+		},
+	})
+}
+
+func TestValidateLoadBalancerSteering(t *testing.T) {
+	testCases := map[string]struct {
+		proxied        bool
+		ttlConfigured  bool
+		steeringPolicy string
+		geoPools       []string
+		err            bool
+	}{
+		"proxied":               {proxied: true},
+		"proxied with ttl":      {proxied: true, ttlConfigured: true, err: true},
+		"not proxied with ttl":  {ttlConfigured: true},
+		"geo with region pools": {steeringPolicy: "geo", geoPools: []string{"region_pools"}},
+		"geo without pools":     {steeringPolicy: "geo", err: true},
+		"random without pools":  {steeringPolicy: "random"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateLoadBalancerSteering(tc.proxied, tc.ttlConfigured, tc.steeringPolicy, tc.geoPools)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestAccCloudflareLoadBalancer_Update(t *testing.T) {
 	t.Parallel()
 	var loadBalancer cloudflare.LoadBalancer
@@ -682,6 +759,18 @@ resource "cloudflare_load_balancer" "%[3]s" {
 }`, zoneID, zone, id)
 }
 
+func testAccCheckCloudflareLoadBalancerConfigProxiedWithTTL(zoneID, zone, id string, proxied bool) string {
+	return testAccCheckCloudflareLoadBalancerPoolConfigBasic(id) + fmt.Sprintf(`
+resource "cloudflare_load_balancer" "%[3]s" {
+  zone_id = "%[1]s"
+  name = "tf-testacc-lb-%[3]s.%[2]s"
+  fallback_pool_id = "${cloudflare_load_balancer_pool.%[3]s.id}"
+  default_pool_ids = ["${cloudflare_load_balancer_pool.%[3]s.id}"]
+  proxied = %[4]t
+  ttl = 60
+}`, zoneID, zone, id, proxied)
+}
+
 func testAccCheckCloudflareLoadBalancerConfigDuplicatePool(zoneID, zone, id string) string {
 	return testAccCheckCloudflareLoadBalancerPoolConfigBasic(id) + fmt.Sprintf(`
 resource "cloudflare_load_balancer" "%[3]s" {
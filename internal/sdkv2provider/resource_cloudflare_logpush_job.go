@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -16,6 +17,65 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+var logpushJobTimestampFormats = []string{"unixnano", "unix", "rfc3339"}
+
+// logpushJob extends cloudflare.LogpushJob with the structured output options
+// which are not yet modelled by cloudflare-go.
+type logpushJob struct {
+	cloudflare.LogpushJob
+	OutputOptions *logpushJobOutputOptions `json:"output_options,omitempty"`
+}
+
+type logpushJobOutputOptions struct {
+	FieldNames      []string `json:"field_names,omitempty"`
+	TimestampFormat string   `json:"timestamp_format,omitempty"`
+	SampleRate      *float64 `json:"sample_rate,omitempty"`
+	// The API names this option after the CVE with a digit missing.
+	CVE202144228    bool   `json:"CVE-2021-4428"`
+	BatchPrefix     string `json:"batch_prefix,omitempty"`
+	BatchSuffix     string `json:"batch_suffix,omitempty"`
+	RecordDelimiter string `json:"record_delimiter,omitempty"`
+}
+
+// MarshalJSON adds the output options to the payload produced by the custom
+// marshaller of the embedded cloudflare.LogpushJob, which would otherwise be
+// promoted and drop them.
+func (j logpushJob) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(j.LogpushJob)
+	if err != nil || j.OutputOptions == nil {
+		return b, err
+	}
+
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(b, &payload); err != nil {
+		return nil, err
+	}
+
+	outputOptions, err := json.Marshal(j.OutputOptions)
+	if err != nil {
+		return nil, err
+	}
+	payload["output_options"] = outputOptions
+
+	return json.Marshal(payload)
+}
+
+func (j *logpushJob) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &j.LogpushJob); err != nil {
+		return err
+	}
+
+	var aux struct {
+		OutputOptions *logpushJobOutputOptions `json:"output_options"`
+	}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	j.OutputOptions = aux.OutputOptions
+
+	return nil
+}
+
 func resourceCloudflareLogpushJob() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareLogpushJobSchema(),
@@ -41,18 +101,18 @@ func resourceCloudflareLogpushJob() *schema.Resource {
 	}
 }
 
-func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessIdentifier, error) {
+func getJobFromResource(d *schema.ResourceData) (logpushJob, *AccessIdentifier, error) {
 	id := 0
 
 	identifier, err := initIdentifier(d)
 	if err != nil {
-		return cloudflare.LogpushJob{}, identifier, err
+		return logpushJob{}, identifier, err
 	}
 
 	if d.Id() != "" {
 		var err error
 		if id, err = strconv.Atoi(d.Id()); err != nil {
-			return cloudflare.LogpushJob{}, identifier, fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err)
+			return logpushJob{}, identifier, fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err)
 		}
 	}
 
@@ -61,10 +121,10 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessI
 	var re = regexp.MustCompile(`^((datadog|splunk|https|r2)://|s3://.+endpoint=)`)
 
 	if ownershipChallenge == "" && !re.MatchString(destConf) {
-		return cloudflare.LogpushJob{}, identifier, fmt.Errorf("ownership_challenge must be set for the provided destination_conf")
+		return logpushJob{}, identifier, fmt.Errorf("ownership_challenge must be set for the provided destination_conf")
 	}
 
-	job := cloudflare.LogpushJob{
+	job := logpushJob{LogpushJob: cloudflare.LogpushJob{
 		ID:                 id,
 		Enabled:            d.Get("enabled").(bool),
 		Kind:               d.Get("kind").(string),
@@ -74,13 +134,13 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessI
 		DestinationConf:    destConf,
 		OwnershipChallenge: ownershipChallenge,
 		Frequency:          d.Get("frequency").(string),
-	}
+	}}
 
 	filter := d.Get("filter")
 	if filter != "" {
 		var jobFilter cloudflare.LogpushJobFilters
 		if err := json.Unmarshal([]byte(filter.(string)), &jobFilter); err != nil {
-			return cloudflare.LogpushJob{}, identifier, err
+			return logpushJob{}, identifier, err
 		}
 		err := jobFilter.Where.Validate()
 		if err != nil {
@@ -89,9 +149,53 @@ func getJobFromResource(d *schema.ResourceData) (cloudflare.LogpushJob, *AccessI
 		job.Filter = &jobFilter
 	}
 
+	if _, ok := d.GetOk("output_options"); ok {
+		job.OutputOptions = &logpushJobOutputOptions{
+			TimestampFormat: d.Get("output_options.0.timestamp_format").(string),
+			CVE202144228:    d.Get("output_options.0.cve_2021_44228").(bool),
+			BatchPrefix:     d.Get("output_options.0.batch_prefix").(string),
+			BatchSuffix:     d.Get("output_options.0.batch_suffix").(string),
+			RecordDelimiter: d.Get("output_options.0.record_delimiter").(string),
+		}
+		for _, fieldName := range d.Get("output_options.0.field_names").([]interface{}) {
+			job.OutputOptions.FieldNames = append(job.OutputOptions.FieldNames, fieldName.(string))
+		}
+		if sampleRate, ok := d.GetOk("output_options.0.sample_rate"); ok {
+			job.OutputOptions.SampleRate = cloudflare.Float64Ptr(sampleRate.(float64))
+		}
+	}
+
 	return job, identifier, nil
 }
 
+func flattenLogpushJobOutputOptions(outputOptions *logpushJobOutputOptions) []map[string]interface{} {
+	if outputOptions == nil {
+		return nil
+	}
+
+	flattened := map[string]interface{}{
+		"field_names":      outputOptions.FieldNames,
+		"timestamp_format": outputOptions.TimestampFormat,
+		"cve_2021_44228":   outputOptions.CVE202144228,
+		"batch_prefix":     outputOptions.BatchPrefix,
+		"batch_suffix":     outputOptions.BatchSuffix,
+		"record_delimiter": outputOptions.RecordDelimiter,
+	}
+	if outputOptions.SampleRate != nil {
+		flattened["sample_rate"] = *outputOptions.SampleRate
+	}
+
+	return []map[string]interface{}{flattened}
+}
+
+func logpushJobURI(identifier *AccessIdentifier, jobID int) string {
+	uri := fmt.Sprintf("/%ss/%s/logpush/jobs", identifier.Type, identifier.Value)
+	if jobID != 0 {
+		uri = fmt.Sprintf("%s/%d", uri, jobID)
+	}
+	return uri
+}
+
 func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	jobID, err := strconv.Atoi(d.Id())
@@ -99,16 +203,12 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("could not extract Logpush job from resource - invalid identifier (%s): %w", d.Id(), err))
 	}
 
-	var job cloudflare.LogpushJob
 	identifier, err := initIdentifier(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	if identifier.Type == AccountType {
-		job, err = client.GetAccountLogpushJob(ctx, identifier.Value, jobID)
-	} else {
-		job, err = client.GetZoneLogpushJob(ctx, identifier.Value, jobID)
-	}
+
+	res, err := client.Raw(ctx, http.MethodGet, logpushJobURI(identifier, jobID), nil, nil)
 	if err != nil {
 		var notFoundError *cloudflare.NotFoundError
 		if errors.As(err, &notFoundError) {
@@ -119,6 +219,11 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("error reading logpush job %q for %s: %w", jobID, identifier, err))
 	}
 
+	var job logpushJob
+	if err := json.Unmarshal(res, &job); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal logpush job %q for %s: %w", jobID, identifier, err))
+	}
+
 	if job.ID == 0 {
 		d.SetId("")
 		return nil
@@ -144,6 +249,10 @@ func resourceCloudflareLogpushJobRead(ctx context.Context, d *schema.ResourceDat
 	d.Set("frequency", job.Frequency)
 	d.Set("filter", filter)
 
+	if err := d.Set("output_options", flattenLogpushJobOutputOptions(job.OutputOptions)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set output_options: %w", err))
+	}
+
 	return nil
 }
 
@@ -157,15 +266,15 @@ func resourceCloudflareLogpushJobCreate(ctx context.Context, d *schema.ResourceD
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Logpush job for %s from struct: %+v", identifier, job))
 
-	var j *cloudflare.LogpushJob
-	if identifier.Type == AccountType {
-		j, err = client.CreateAccountLogpushJob(ctx, identifier.Value, job)
-	} else {
-		j, err = client.CreateZoneLogpushJob(ctx, identifier.Value, job)
-	}
+	res, err := client.Raw(ctx, http.MethodPost, logpushJobURI(identifier, 0), job, nil)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating logpush job for %s: %w", identifier, err))
 	}
+
+	var j logpushJob
+	if err := json.Unmarshal(res, &j); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal logpush job for %s: %w", identifier, err))
+	}
 	if j.ID == 0 {
 		return diag.FromErr(fmt.Errorf("failed to find ID in Create response; resource was empty"))
 	}
@@ -187,13 +296,7 @@ func resourceCloudflareLogpushJobUpdate(ctx context.Context, d *schema.ResourceD
 
 	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Logpush job for %s from struct: %+v", identifier, job))
 
-	if identifier.Type == AccountType {
-		err = client.UpdateAccountLogpushJob(ctx, identifier.Value, job.ID, job)
-	} else {
-		err = client.UpdateZoneLogpushJob(ctx, identifier.Value, job.ID, job)
-	}
-
-	if err != nil {
+	if _, err := client.Raw(ctx, http.MethodPut, logpushJobURI(identifier, job.ID), job, nil); err != nil {
 		return diag.FromErr(fmt.Errorf("error updating logpush job id %q for %s: %w", job.ID, identifier, err))
 	}
 
@@ -0,0 +1,68 @@
+package sdkv2provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogpushJobOutputOptionsRoundTrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareLogpushJobSchema(), map[string]interface{}{
+		"zone_id":          "0da42c8d2132a9ddaf714f9e7c920711",
+		"dataset":          "http_requests",
+		"destination_conf": "https://logs.example.com",
+		"filter":           `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`,
+		"output_options": []interface{}{map[string]interface{}{
+			"field_names":      []interface{}{"ClientIP", "RayID"},
+			"timestamp_format": "rfc3339",
+			"sample_rate":      0.5,
+			"cve_2021_44228":   true,
+			"batch_prefix":     "[",
+			"batch_suffix":     "]",
+			"record_delimiter": ",",
+		}},
+	})
+
+	job, _, err := getJobFromResource(d)
+	assert.NoError(t, err)
+
+	b, err := json.Marshal(job)
+	assert.NoError(t, err)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &payload))
+	assert.Equal(t, `{"where":{"key":"ClientRequestHost","operator":"eq","value":"example.com"}}`, payload["filter"])
+	assert.Equal(t, map[string]interface{}{
+		"field_names":      []interface{}{"ClientIP", "RayID"},
+		"timestamp_format": "rfc3339",
+		"sample_rate":      0.5,
+		"CVE-2021-4428":    true,
+		"batch_prefix":     "[",
+		"batch_suffix":     "]",
+		"record_delimiter": ",",
+	}, payload["output_options"])
+
+	var decoded logpushJob
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Equal(t, job, decoded)
+
+	assert.NoError(t, d.Set("output_options", flattenLogpushJobOutputOptions(decoded.OutputOptions)))
+	rebuilt, _, err := getJobFromResource(d)
+	assert.NoError(t, err)
+	assert.Equal(t, job.OutputOptions, rebuilt.OutputOptions)
+}
+
+func TestLogpushJobWithoutOutputOptions(t *testing.T) {
+	job := logpushJob{LogpushJob: cloudflare.LogpushJob{Dataset: "http_requests"}}
+
+	b, err := json.Marshal(job)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(b), "output_options")
+
+	var decoded logpushJob
+	assert.NoError(t, json.Unmarshal(b, &decoded))
+	assert.Nil(t, decoded.OutputOptions)
+}
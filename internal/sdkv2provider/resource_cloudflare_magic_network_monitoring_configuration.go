@@ -0,0 +1,137 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// magicNetworkMonitoringConfiguration is the account wide Magic Network
+// Monitoring configuration describing the routers sending flow data.
+type magicNetworkMonitoringConfiguration struct {
+	Name            string   `json:"name"`
+	DefaultSampling int      `json:"default_sampling"`
+	RouterIPs       []string `json:"router_ips"`
+}
+
+func resourceCloudflareMagicNetworkMonitoringConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicNetworkMonitoringConfigurationSchema(),
+		CreateContext: resourceCloudflareMagicNetworkMonitoringConfigurationCreate,
+		ReadContext:   resourceCloudflareMagicNetworkMonitoringConfigurationRead,
+		UpdateContext: resourceCloudflareMagicNetworkMonitoringConfigurationUpdate,
+		DeleteContext: resourceCloudflareMagicNetworkMonitoringConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicNetworkMonitoringConfigurationImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Magic Network Monitoring configuration
+			resource. Each account has a single configuration describing the
+			routers that send flow data to Cloudflare.
+		`),
+	}
+}
+
+func resourceCloudflareMagicNetworkMonitoringConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Magic Network Monitoring configuration for account %s", accountID))
+
+	if _, err := client.Raw(ctx, http.MethodPost, magicNetworkMonitoringConfigurationURI(accountID), buildMagicNetworkMonitoringConfiguration(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Magic Network Monitoring configuration for account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareMagicNetworkMonitoringConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicNetworkMonitoringConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, magicNetworkMonitoringConfigurationURI(accountID), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Magic Network Monitoring configuration for account %s no longer exists", accountID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Magic Network Monitoring configuration for account %q: %w", accountID, err))
+	}
+
+	var config magicNetworkMonitoringConfiguration
+	if err := json.Unmarshal(res, &config); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Magic Network Monitoring configuration: %w", err))
+	}
+
+	d.Set("name", config.Name)
+	d.Set("default_sampling", config.DefaultSampling)
+	if err := d.Set("router_ips", config.RouterIPs); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set router_ips: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicNetworkMonitoringConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Magic Network Monitoring configuration for account %s", accountID))
+
+	if _, err := client.Raw(ctx, http.MethodPut, magicNetworkMonitoringConfigurationURI(accountID), buildMagicNetworkMonitoringConfiguration(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Magic Network Monitoring configuration for account %q: %w", accountID, err))
+	}
+
+	return resourceCloudflareMagicNetworkMonitoringConfigurationRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicNetworkMonitoringConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Magic Network Monitoring configuration for account %s", accountID))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, magicNetworkMonitoringConfigurationURI(accountID), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Magic Network Monitoring configuration for account %q: %w", accountID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicNetworkMonitoringConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Magic Network Monitoring configuration for account %s", accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(accountID)
+
+	resourceCloudflareMagicNetworkMonitoringConfigurationRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildMagicNetworkMonitoringConfiguration(d *schema.ResourceData) magicNetworkMonitoringConfiguration {
+	return magicNetworkMonitoringConfiguration{
+		Name:            d.Get("name").(string),
+		DefaultSampling: d.Get("default_sampling").(int),
+		RouterIPs:       expandInterfaceToStringList(d.Get("router_ips").(*schema.Set).List()),
+	}
+}
+
+func magicNetworkMonitoringConfigurationURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/mnm/config", accountID)
+}
@@ -0,0 +1,81 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareMagicNetworkMonitoringConfiguration_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_magic_network_monitoring_configuration.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareMagicNetworkMonitoringConfigurationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicNetworkMonitoringConfiguration(rnd, accountID, 1, "203.0.113.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "default_sampling", "1"),
+					resource.TestCheckResourceAttr(name, "router_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "router_ips.*", "203.0.113.1"),
+				),
+			},
+			{
+				Config: testAccCloudflareMagicNetworkMonitoringConfiguration(rnd, accountID, 5, "203.0.113.2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "default_sampling", "5"),
+					resource.TestCheckResourceAttr(name, "router_ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "router_ips.*", "203.0.113.2"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareMagicNetworkMonitoringConfiguration(rnd, accountID string, defaultSampling int, routerIP string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_network_monitoring_configuration" "%[1]s" {
+  account_id       = "%[2]s"
+  name             = "%[1]s"
+  default_sampling = %[3]d
+  router_ips       = ["%[4]s"]
+}
+`, rnd, accountID, defaultSampling, routerIP)
+}
+
+func testAccCheckCloudflareMagicNetworkMonitoringConfigurationDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_magic_network_monitoring_configuration" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, magicNetworkMonitoringConfigurationURI(rs.Primary.Attributes["account_id"]), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Magic Network Monitoring configuration still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var magicNetworkMonitoringRuleDurations = []string{"1m", "5m", "10m", "15m", "20m", "30m", "45m", "60m"}
+
+// magicNetworkMonitoringRule is a Magic Network Monitoring rule alerting on
+// traffic towards a set of prefixes.
+type magicNetworkMonitoringRule struct {
+	ID                     string   `json:"id,omitempty"`
+	Name                   string   `json:"name"`
+	AutomaticAdvertisement bool     `json:"automatic_advertisement"`
+	BandwidthThreshold     float64  `json:"bandwidth_threshold"`
+	Duration               string   `json:"duration"`
+	Prefixes               []string `json:"prefixes"`
+}
+
+func resourceCloudflareMagicNetworkMonitoringRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMagicNetworkMonitoringRuleSchema(),
+		CreateContext: resourceCloudflareMagicNetworkMonitoringRuleCreate,
+		ReadContext:   resourceCloudflareMagicNetworkMonitoringRuleRead,
+		UpdateContext: resourceCloudflareMagicNetworkMonitoringRuleUpdate,
+		DeleteContext: resourceCloudflareMagicNetworkMonitoringRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMagicNetworkMonitoringRuleImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Magic Network Monitoring rule resource. Rules
+			alert when the traffic towards a set of prefixes exceeds a
+			bandwidth threshold for a given duration.
+		`),
+	}
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Magic Network Monitoring rule %q", d.Get("name").(string)))
+
+	res, err := client.Raw(ctx, http.MethodPost, magicNetworkMonitoringRuleURI(accountID, ""), buildMagicNetworkMonitoringRule(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Magic Network Monitoring rule for account %q: %w", accountID, err))
+	}
+
+	var rule magicNetworkMonitoringRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Magic Network Monitoring rule: %w", err))
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceCloudflareMagicNetworkMonitoringRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, magicNetworkMonitoringRuleURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Magic Network Monitoring rule %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Magic Network Monitoring rule %q: %w", d.Id(), err))
+	}
+
+	var rule magicNetworkMonitoringRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Magic Network Monitoring rule: %w", err))
+	}
+
+	d.Set("name", rule.Name)
+	d.Set("automatic_advertisement", rule.AutomaticAdvertisement)
+	d.Set("bandwidth_threshold", rule.BandwidthThreshold)
+	d.Set("duration", rule.Duration)
+	if err := d.Set("prefixes", rule.Prefixes); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set prefixes: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Magic Network Monitoring rule using ID: %s", d.Id()))
+
+	// Rules are updated through the collection endpoint with the rule
+	// identifier in the body.
+	rule := buildMagicNetworkMonitoringRule(d)
+	rule.ID = d.Id()
+
+	if _, err := client.Raw(ctx, http.MethodPut, magicNetworkMonitoringRuleURI(accountID, ""), rule, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Magic Network Monitoring rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareMagicNetworkMonitoringRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Magic Network Monitoring rule using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, magicNetworkMonitoringRuleURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Magic Network Monitoring rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMagicNetworkMonitoringRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/ruleID\"", d.Id())
+	}
+
+	accountID, ruleID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Magic Network Monitoring rule: id %s for account %s", ruleID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(ruleID)
+
+	resourceCloudflareMagicNetworkMonitoringRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildMagicNetworkMonitoringRule(d *schema.ResourceData) magicNetworkMonitoringRule {
+	return magicNetworkMonitoringRule{
+		Name:                   d.Get("name").(string),
+		AutomaticAdvertisement: d.Get("automatic_advertisement").(bool),
+		BandwidthThreshold:     d.Get("bandwidth_threshold").(float64),
+		Duration:               d.Get("duration").(string),
+		Prefixes:               expandInterfaceToStringList(d.Get("prefixes").(*schema.Set).List()),
+	}
+}
+
+func magicNetworkMonitoringRuleURI(accountID, ruleID string) string {
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules", accountID)
+	if ruleID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, ruleID)
+	}
+	return uri
+}
@@ -0,0 +1,131 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareMagicNetworkMonitoringRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_magic_network_monitoring_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareMagicNetworkMonitoringRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMagicNetworkMonitoringRule(rnd, accountID, 1000, "1m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "automatic_advertisement", "false"),
+					resource.TestCheckResourceAttr(name, "bandwidth_threshold", "1000"),
+					resource.TestCheckResourceAttr(name, "duration", "1m"),
+					resource.TestCheckResourceAttr(name, "prefixes.#", "1"),
+					resource.TestCheckTypeSetElemAttr(name, "prefixes.*", "192.0.2.0/24"),
+				),
+			},
+			{
+				Config: testAccCloudflareMagicNetworkMonitoringRule(rnd, accountID, 2000, "5m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "bandwidth_threshold", "2000"),
+					resource.TestCheckResourceAttr(name, "duration", "5m"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareMagicNetworkMonitoringRuleUpdate(t *testing.T) {
+	var updateBody map[string]interface{}
+	rule := `{"id":"2890e6fa406311ed9b5a23f70f6fb8cf","name":"example","automatic_advertisement":true,"bandwidth_threshold":2000,"duration":"5m","prefixes":["192.0.2.0/24"]}`
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/mnm/rules", r.URL.Path)
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &updateBody))
+		case http.MethodGet:
+			assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/mnm/rules/2890e6fa406311ed9b5a23f70f6fb8cf", r.URL.Path)
+		}
+		testclient.WriteResult(w, json.RawMessage(rule))
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareMagicNetworkMonitoringRuleSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+		"name":                    "example",
+		"automatic_advertisement": true,
+		"bandwidth_threshold":     2000.0,
+		"duration":                "5m",
+		"prefixes":                []interface{}{"192.0.2.0/24"},
+	})
+	d.SetId("2890e6fa406311ed9b5a23f70f6fb8cf")
+
+	diags := resourceCloudflareMagicNetworkMonitoringRuleUpdate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+
+	assert.Equal(t, map[string]interface{}{
+		"id":                      "2890e6fa406311ed9b5a23f70f6fb8cf",
+		"name":                    "example",
+		"automatic_advertisement": true,
+		"bandwidth_threshold":     2000.0,
+		"duration":                "5m",
+		"prefixes":                []interface{}{"192.0.2.0/24"},
+	}, updateBody)
+	assert.Equal(t, true, d.Get("automatic_advertisement"))
+	assert.Equal(t, 2000.0, d.Get("bandwidth_threshold"))
+}
+
+func testAccCloudflareMagicNetworkMonitoringRule(rnd, accountID string, bandwidthThreshold int, duration string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_magic_network_monitoring_rule" "%[1]s" {
+  account_id          = "%[2]s"
+  name                = "%[1]s"
+  bandwidth_threshold = %[3]d
+  duration            = "%[4]s"
+  prefixes            = ["192.0.2.0/24"]
+}
+`, rnd, accountID, bandwidthThreshold, duration)
+}
+
+func testAccCheckCloudflareMagicNetworkMonitoringRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_magic_network_monitoring_rule" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, magicNetworkMonitoringRuleURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Magic Network Monitoring rule still exists")
+		}
+	}
+
+	return nil
+}
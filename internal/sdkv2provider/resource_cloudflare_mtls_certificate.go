@@ -0,0 +1,156 @@
+package sdkv2provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareMTLSCertificate() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareMTLSCertificateSchema(),
+		CreateContext: resourceCloudflareMTLSCertificateCreate,
+		ReadContext:   resourceCloudflareMTLSCertificateRead,
+		DeleteContext: resourceCloudflareMTLSCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareMTLSCertificateImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare mTLS certificate resource. These certificates
+			may be used with mTLS enabled Cloudflare services.
+		`),
+	}
+}
+
+func resourceCloudflareMTLSCertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	params := cloudflare.CreateMTLSCertificateParams{
+		Name:         d.Get("name").(string),
+		Certificates: d.Get("certificates").(string),
+		PrivateKey:   d.Get("private_key").(string),
+		CA:           d.Get("ca").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare mTLS certificate %q", params.Name))
+
+	cert, err := client.CreateMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), params)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating mTLS certificate: %w", err))
+	}
+
+	d.SetId(cert.ID)
+
+	return resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+}
+
+func resourceCloudflareMTLSCertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	cert, err := client.GetMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("mTLS certificate %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	d.Set("name", cert.Name)
+	d.Set("certificates", cert.Certificates)
+	d.Set("ca", cert.CA)
+	d.Set("issuer", cert.Issuer)
+	d.Set("signature", cert.Signature)
+	d.Set("serial_number", cert.SerialNumber)
+	d.Set("uploaded_on", cert.UploadedOn.Format(time.RFC3339Nano))
+	d.Set("expires_on", cert.ExpiresOn.Format(time.RFC3339Nano))
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare mTLS certificate using ID: %s", d.Id()))
+
+	_, err := client.DeleteMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting mTLS certificate %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareMTLSCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/mtlsCertificateID\"", d.Id())
+	}
+
+	accountID, certID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare mTLS certificate: id %s for account %s", certID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(certID)
+
+	resourceCloudflareMTLSCertificateRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// suppressEquivalentPEMDiff ignores differences in how the same PEM encoded
+// certificates are formatted, such as line endings or surrounding whitespace,
+// since the API returns the certificates it was given reformatted.
+func suppressEquivalentPEMDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldBlocks, ok := decodePEMBlocks(old)
+	if !ok {
+		return false
+	}
+	newBlocks, ok := decodePEMBlocks(new)
+	if !ok || len(oldBlocks) != len(newBlocks) {
+		return false
+	}
+
+	for i := range oldBlocks {
+		if oldBlocks[i].Type != newBlocks[i].Type || !bytes.Equal(oldBlocks[i].Bytes, newBlocks[i].Bytes) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodePEMBlocks decodes every PEM block of s, reporting false if s holds
+// no blocks or anything other than whitespace outside of them.
+func decodePEMBlocks(s string) ([]*pem.Block, bool) {
+	var blocks []*pem.Block
+	rest := []byte(s)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, len(blocks) > 0 && len(bytes.TrimSpace(rest)) == 0
+}
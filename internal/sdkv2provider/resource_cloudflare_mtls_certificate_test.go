@@ -0,0 +1,135 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	resource.AddTestSweepers("cloudflare_mtls_certificate", &resource.Sweeper{
+		Name: "cloudflare_mtls_certificate",
+		F:    testSweepCloudflareMTLSCertificate,
+	})
+}
+
+func testSweepCloudflareMTLSCertificate(r string) error {
+	ctx := context.Background()
+
+	client, clientErr := sharedClient()
+	if clientErr != nil {
+		tflog.Error(ctx, fmt.Sprintf("Failed to create Cloudflare client: %s", clientErr))
+	}
+
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	certs, _, err := client.ListMTLSCertificates(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListMTLSCertificatesParams{})
+	if err != nil {
+		tflog.Error(ctx, fmt.Sprintf("Failed to fetch Cloudflare mTLS certificates: %s", err))
+	}
+
+	for _, cert := range certs {
+		_, err := client.DeleteMTLSCertificate(ctx, cloudflare.AccountIdentifier(accountID), cert.ID)
+		if err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Failed to delete Cloudflare mTLS certificate (%s) in account ID: %s", cert.ID, accountID))
+		}
+	}
+
+	return nil
+}
+
+func TestSuppressEquivalentPEMDiff(t *testing.T) {
+	cert := "-----BEGIN CERTIFICATE-----\nTUlJQm1EQ0NBVDZnQXdJQkFnSVVjZXJ0\naWZpY2F0ZQ==\n-----END CERTIFICATE-----\n"
+	other := "-----BEGIN CERTIFICATE-----\nTUlJQm1EQ0NBVDZnQXdJQkFnSVVvdGhl\ncg==\n-----END CERTIFICATE-----\n"
+
+	testCases := map[string]struct {
+		old, new string
+		expected bool
+	}{
+		"identical":                 {old: cert, new: cert, expected: true},
+		"different line endings":    {old: cert, new: strings.ReplaceAll(cert, "\n", "\r\n"), expected: true},
+		"surrounding whitespace":    {old: cert, new: "\n\n" + strings.TrimSpace(cert) + " \n", expected: true},
+		"different line wrapping":   {old: cert, new: strings.Replace(cert, "SVVjZXJ0\naWZp", "SVVjZXJ0aWZp", 1), expected: true},
+		"different certificate":     {old: cert, new: other, expected: false},
+		"additional certificate":    {old: cert, new: cert + other, expected: false},
+		"text outside certificates": {old: cert, new: cert + "trailing", expected: false},
+		"not PEM encoded":           {old: "certificate", new: "certificate", expected: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, suppressEquivalentPEMDiff("certificates", tc.old, tc.new, nil))
+		})
+	}
+}
+
+func TestAccCloudflareMTLSCertificate_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_mtls_certificate.%s", rnd)
+	cert := os.Getenv("CLOUDFLARE_MUTUAL_TLS_CERTIFICATE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareMTLSCertificateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareMTLSCertificateConfig(rnd, accountID, cert),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "ca", "true"),
+					resource.TestCheckResourceAttrSet(name, "certificates"),
+					resource.TestCheckResourceAttrSet(name, "serial_number"),
+					resource.TestCheckResourceAttrSet(name, "signature"),
+					resource.TestCheckResourceAttrSet(name, "expires_on"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCheckCloudflareMTLSCertificateDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_mtls_certificate" {
+			continue
+		}
+
+		_, err := client.GetMTLSCertificate(context.Background(), cloudflare.AccountIdentifier(rs.Primary.Attributes["account_id"]), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("mTLS certificate still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCloudflareMTLSCertificateConfig(rnd, accountID, cert string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_mtls_certificate" "%[1]s" {
+	account_id   = "%[2]s"
+	name         = "%[1]s"
+	certificates = "%[3]s"
+	ca           = true
+}
+`, rnd, accountID, cert)
+}
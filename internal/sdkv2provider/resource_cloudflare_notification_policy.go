@@ -23,6 +23,7 @@ func resourceCloudflareNotificationPolicy() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceNotificationPolicyImport,
 		},
+		CustomizeDiff: resourceCloudflareNotificationPolicyValidateAlertType,
 		Description: heredoc.Doc(`
 			Provides a resource, that manages a notification policy for
 			Cloudflare's products. The delivery mechanisms supported are email,
@@ -31,6 +32,24 @@ func resourceCloudflareNotificationPolicy() *schema.Resource {
 	}
 }
 
+func resourceCloudflareNotificationPolicyValidateAlertType(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("skip_alert_type_validation").(bool) {
+		return nil
+	}
+
+	alertType := d.Get("alert_type").(string)
+	if alertType == "" || contains(notificationPolicyAlertTypes, alertType) {
+		return nil
+	}
+
+	msg := fmt.Sprintf("unknown alert_type %q", alertType)
+	if suggestions := closestStringMatches(alertType, notificationPolicyAlertTypes, 3); len(suggestions) > 0 {
+		msg = fmt.Sprintf("%s (did you mean %s?)", msg, strings.Join(suggestions, ", "))
+	}
+
+	return fmt.Errorf("%s. Set skip_alert_type_validation to use an alert type the provider doesn't know about yet", msg)
+}
+
 func resourceCloudflareNotificationPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
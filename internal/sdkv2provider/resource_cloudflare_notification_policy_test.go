@@ -3,6 +3,7 @@ package sdkv2provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"sort"
 	"testing"
 
@@ -173,6 +174,56 @@ func testCheckCloudflareNotificationPolicyWithFiltersAttributeUpdated(name, poli
   }`, name, policyName, policyDesc, accountID)
 }
 
+func TestAccCloudflareNotificationPolicy_InvalidAlertType(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testCheckCloudflareNotificationPolicyAlertType(rnd, accountID, "dos_atack_l7"),
+				ExpectError: regexp.MustCompile(`unknown alert_type "dos_atack_l7" \(did you mean dos_attack_l7`),
+			},
+		},
+	})
+}
+
+func testCheckCloudflareNotificationPolicyAlertType(name, accountID, alertType string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_notification_policy" "%[1]s" {
+    name        = "%[1]s"
+    account_id  = "%[2]s"
+    enabled     = true
+    alert_type  = "%[3]s"
+    email_integration {
+      id = "test@example.com"
+    }
+  }`, name, accountID, alertType)
+}
+
+func TestNotificationPolicyAlertTypeSuggestions(t *testing.T) {
+	testCases := map[string]struct {
+		alertType string
+		expected  []string
+	}{
+		"known type":          {alertType: "g6_health_alert", expected: []string{"g6_health_alert"}},
+		"typo":                {alertType: "dos_atack_l7", expected: []string{"dos_attack_l7", "dos_attack_l4"}},
+		"partial name":        {alertType: "clickhouse_alert_fw", expected: []string{"clickhouse_alert_fw_anomaly", "clickhouse_alert_fw_ent_anomaly"}},
+		"nothing close by":    {alertType: "xyz", expected: nil},
+		"health check status": {alertType: "health_check_status", expected: []string{"health_check_status_notification"}},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, closestStringMatches(tc.alertType, notificationPolicyAlertTypes, 3))
+		})
+	}
+}
+
 func TestFlattenExpandFilters(t *testing.T) {
 	filters := map[string][]string{
 		"services": {"waf", "firewallrules"},
@@ -0,0 +1,147 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var (
+	observatoryScheduleFrequencies = []string{"DAILY", "WEEKLY"}
+	observatoryRegions             = []string{
+		"asia-east1", "asia-northeast1", "asia-northeast2", "asia-south1", "asia-southeast1",
+		"australia-southeast1", "europe-north1", "europe-southwest1", "europe-west1", "europe-west2",
+		"europe-west3", "europe-west4", "europe-west8", "europe-west9", "me-west1",
+		"southamerica-east1", "us-central1", "us-east1", "us-east4", "us-south1", "us-west1",
+	}
+)
+
+// observatorySchedule is an Observatory test scheduled to run for a page of
+// a zone.
+type observatorySchedule struct {
+	URL       string `json:"url"`
+	Region    string `json:"region"`
+	Frequency string `json:"frequency"`
+}
+
+func resourceCloudflareObservatoryScheduledTest() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareObservatoryScheduledTestSchema(),
+		CreateContext: resourceCloudflareObservatoryScheduledTestCreate,
+		ReadContext:   resourceCloudflareObservatoryScheduledTestRead,
+		DeleteContext: resourceCloudflareObservatoryScheduledTestDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareObservatoryScheduledTestImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Observatory scheduled test resource. Scheduled
+			tests periodically run a Lighthouse test against a page of a zone.
+		`),
+	}
+}
+
+func resourceCloudflareObservatoryScheduledTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	pageURL := d.Get("url").(string)
+
+	params := url.Values{}
+	params.Set("region", d.Get("region").(string))
+	params.Set("frequency", d.Get("frequency").(string))
+
+	tflog.Debug(ctx, fmt.Sprintf("Scheduling Cloudflare Observatory test for %s", pageURL))
+
+	if _, err := client.Raw(ctx, http.MethodPost, observatoryScheduleURI(zoneID, pageURL, params), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error scheduling Observatory test for %q: %w", pageURL, err))
+	}
+
+	d.SetId(pageURL)
+
+	return resourceCloudflareObservatoryScheduledTestRead(ctx, d, meta)
+}
+
+func resourceCloudflareObservatoryScheduledTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	params := url.Values{}
+	if region, ok := d.GetOk("region"); ok {
+		params.Set("region", region.(string))
+	}
+
+	res, err := client.Raw(ctx, http.MethodGet, observatoryScheduleURI(zoneID, d.Id(), params), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Observatory scheduled test for %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Observatory scheduled test for %q: %w", d.Id(), err))
+	}
+
+	var schedule observatorySchedule
+	if err := json.Unmarshal(res, &schedule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Observatory scheduled test: %w", err))
+	}
+
+	d.Set("url", schedule.URL)
+	d.Set("region", schedule.Region)
+	d.Set("frequency", schedule.Frequency)
+
+	return nil
+}
+
+func resourceCloudflareObservatoryScheduledTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	params := url.Values{}
+	params.Set("region", d.Get("region").(string))
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Observatory scheduled test for %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, observatoryScheduleURI(zoneID, d.Id(), params), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Observatory scheduled test for %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareObservatoryScheduledTestImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/url\"", d.Id())
+	}
+
+	zoneID, pageURL := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Observatory scheduled test: url %s for zone %s", pageURL, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(pageURL)
+
+	resourceCloudflareObservatoryScheduledTestRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func observatoryScheduleURI(zoneID, pageURL string, params url.Values) string {
+	uri := fmt.Sprintf("/zones/%s/speed_api/schedule/%s", zoneID, url.PathEscape(pageURL))
+	if len(params) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, params.Encode())
+	}
+	return uri
+}
@@ -0,0 +1,119 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareObservatoryScheduledTest_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	name := fmt.Sprintf("cloudflare_observatory_scheduled_test.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareObservatoryScheduledTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareObservatoryScheduledTest(rnd, zoneID, domain+"/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, consts.ZoneIDSchemaKey, zoneID),
+					resource.TestCheckResourceAttr(name, "url", domain+"/"),
+					resource.TestCheckResourceAttr(name, "frequency", "DAILY"),
+					resource.TestCheckResourceAttr(name, "region", "us-central1"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareObservatoryScheduledTestCreate(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/zones/023e105f4ecef8ad9ca31a8372d0c353/speed_api/schedule/example.com%2Fblog", r.URL.EscapedPath())
+		assert.Equal(t, "us-central1", r.URL.Query().Get("region"))
+
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "DAILY", r.URL.Query().Get("frequency"))
+			testclient.WriteResult(w, json.RawMessage(`{"schedule":{"url":"example.com/blog","region":"us-central1","frequency":"DAILY"}}`))
+		case http.MethodGet:
+			testclient.WriteResult(w, json.RawMessage(`{"url":"example.com/blog","region":"us-central1","frequency":"DAILY"}`))
+		default:
+			t.Errorf("unexpected %s request", r.Method)
+		}
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareObservatoryScheduledTestSchema(), map[string]interface{}{
+		consts.ZoneIDSchemaKey: "023e105f4ecef8ad9ca31a8372d0c353",
+		"url":                  "example.com/blog",
+		"frequency":            "DAILY",
+		"region":               "us-central1",
+	})
+
+	diags := resourceCloudflareObservatoryScheduledTestCreate(context.Background(), d, client)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, "example.com/blog", d.Id())
+	assert.Equal(t, "DAILY", d.Get("frequency"))
+}
+
+func TestObservatoryScheduleURI(t *testing.T) {
+	params := url.Values{}
+	params.Set("region", "europe-west1")
+
+	assert.Equal(t, "/zones/zone/speed_api/schedule/example.com%2F", observatoryScheduleURI("zone", "example.com/", nil))
+	assert.Equal(t, "/zones/zone/speed_api/schedule/example.com?region=europe-west1", observatoryScheduleURI("zone", "example.com", params))
+}
+
+func testAccCloudflareObservatoryScheduledTest(rnd, zoneID, pageURL string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_observatory_scheduled_test" "%[1]s" {
+  zone_id   = "%[2]s"
+  url       = "%[3]s"
+  frequency = "DAILY"
+  region    = "us-central1"
+}
+`, rnd, zoneID, pageURL)
+}
+
+func testAccCheckCloudflareObservatoryScheduledTestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_observatory_scheduled_test" {
+			continue
+		}
+
+		params := url.Values{}
+		params.Set("region", rs.Primary.Attributes["region"])
+
+		_, err := client.Raw(context.Background(), http.MethodGet, observatoryScheduleURI(rs.Primary.Attributes[consts.ZoneIDSchemaKey], rs.Primary.ID, params), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Observatory scheduled test still exists")
+		}
+	}
+
+	return nil
+}
@@ -35,17 +35,19 @@ func resourceCloudflareOriginCACertificate() *schema.Resource {
 
 func mustRenew(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 	// Check when the cert will expire
-	expiresonRaw := d.Get("expires_on")
-	if (expiresonRaw == nil) || (expiresonRaw == "") {
+	expiresOn := d.Get("expires_on").(string)
+	if expiresOn == "" {
 		return false
 	}
-	expireson, _ := time.Parse(time.RFC3339, expiresonRaw.(string))
 
-	// Calculate when we should renew
-	earlyExpiration := expireson.AddDate(0, 0, -1*d.Get("min_days_for_renewal").(int))
+	renewAfter, err := originCACertificateRenewalDate(expiresOn, d.Get("min_days_for_renewal").(int))
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("unable to determine when to renew the certificate: %s", err))
+		return false
+	}
 
-	if time.Now().After(earlyExpiration) {
-		tflog.Info(ctx, fmt.Sprintf("We will renew the certificate as we passed the expected date (%s)", earlyExpiration))
+	if time.Now().After(renewAfter) {
+		tflog.Info(ctx, fmt.Sprintf("We will renew the certificate as we passed the expected date (%s)", renewAfter))
 		err := d.SetNewComputed("expires_on")
 		if err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("error setting to renew the certificate: %s", err))
@@ -57,6 +59,17 @@ func mustRenew(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bo
 	return false
 }
 
+// originCACertificateRenewalDate returns the date after which a certificate
+// expiring on expiresOn has fewer than minDaysForRenewal days remaining.
+func originCACertificateRenewalDate(expiresOn string, minDaysForRenewal int) (time.Time, error) {
+	expires, err := time.Parse(time.RFC3339, expiresOn)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expires_on %q: %w", expiresOn, err)
+	}
+
+	return expires.AddDate(0, 0, -1*minDaysForRenewal), nil
+}
+
 func resourceCloudflareOriginCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
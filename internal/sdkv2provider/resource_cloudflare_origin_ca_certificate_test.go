@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareOriginCACertificate_Basic(t *testing.T) {
@@ -103,6 +104,19 @@ func TestCalculateRequestedValidityFromCertificate(t *testing.T) {
 	}
 }
 
+func TestOriginCACertificateRenewalDate(t *testing.T) {
+	renewAfter, err := originCACertificateRenewalDate("2024-03-31T10:48:00Z", 30)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 1, 10, 48, 0, 0, time.UTC), renewAfter.UTC())
+
+	renewAfter, err = originCACertificateRenewalDate("2024-03-31T10:48:00Z", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 3, 31, 10, 48, 0, 0, time.UTC), renewAfter.UTC())
+
+	_, err = originCACertificateRenewalDate("2024-03-31 10:48:00 +0000 UTC", 30)
+	assert.Error(t, err)
+}
+
 func testAccCheckCloudflareOriginCACertificateDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
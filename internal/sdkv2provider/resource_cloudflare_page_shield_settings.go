@@ -0,0 +1,126 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pageShieldSettings are the zone level Page Shield settings.
+type pageShieldSettings struct {
+	Enabled                        bool `json:"enabled"`
+	UseCloudflareReportingEndpoint bool `json:"use_cloudflare_reporting_endpoint"`
+	UseConnectionURLPath           bool `json:"use_connection_url_path"`
+}
+
+// defaultPageShieldSettings are the settings of a zone that never had Page
+// Shield configured and what the resource restores on delete.
+var defaultPageShieldSettings = pageShieldSettings{
+	Enabled:                        false,
+	UseCloudflareReportingEndpoint: true,
+	UseConnectionURLPath:           false,
+}
+
+func resourceCloudflarePageShieldSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflarePageShieldSettingsSchema(),
+		CreateContext: resourceCloudflarePageShieldSettingsUpdate,
+		ReadContext:   resourceCloudflarePageShieldSettingsRead,
+		UpdateContext: resourceCloudflarePageShieldSettingsUpdate,
+		DeleteContext: resourceCloudflarePageShieldSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflarePageShieldSettingsImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Page Shield settings resource. Page Shield
+			monitors the scripts and connections loaded by the pages of a zone.
+			Deleting the resource restores the default settings of the zone.
+		`),
+	}
+}
+
+func resourceCloudflarePageShieldSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, pageShieldSettingsURI(zoneID), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Page Shield settings for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Page Shield settings for zone %q: %w", zoneID, err))
+	}
+
+	var settings pageShieldSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Page Shield settings for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("enabled", settings.Enabled)
+	d.Set("use_cloudflare_reporting_endpoint", settings.UseCloudflareReportingEndpoint)
+	d.Set("use_connection_url_path", settings.UseConnectionURLPath)
+
+	return nil
+}
+
+func resourceCloudflarePageShieldSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	settings := pageShieldSettings{
+		Enabled:                        d.Get("enabled").(bool),
+		UseCloudflareReportingEndpoint: d.Get("use_cloudflare_reporting_endpoint").(bool),
+		UseConnectionURLPath:           d.Get("use_connection_url_path").(bool),
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Page Shield settings for zone %q: %+v", zoneID, settings))
+
+	if _, err := client.Raw(ctx, http.MethodPut, pageShieldSettingsURI(zoneID), settings, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Page Shield settings for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflarePageShieldSettingsRead(ctx, d, meta)
+}
+
+func resourceCloudflarePageShieldSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Resetting Page Shield settings for zone %q", zoneID))
+
+	if _, err := client.Raw(ctx, http.MethodPut, pageShieldSettingsURI(zoneID), defaultPageShieldSettings, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error resetting Page Shield settings for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflarePageShieldSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Page Shield settings for zone %q", zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+
+	resourceCloudflarePageShieldSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func pageShieldSettingsURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/page_shield", zoneID)
+}
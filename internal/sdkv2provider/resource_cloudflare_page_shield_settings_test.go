@@ -0,0 +1,84 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflarePageShieldSettings_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_page_shield_settings." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflarePageShieldSettingsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflarePageShieldSettingsConfig(rnd, zoneID, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "use_cloudflare_reporting_endpoint", "true"),
+					resource.TestCheckResourceAttr(name, "use_connection_url_path", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflarePageShieldSettingsConfig(rnd, zoneID, true, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "use_connection_url_path", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflarePageShieldSettingsConfig(rnd, zoneID string, enabled, useConnectionURLPath bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_page_shield_settings" "%[1]s" {
+  zone_id                 = "%[2]s"
+  enabled                 = %[3]t
+  use_connection_url_path = %[4]t
+}
+`, rnd, zoneID, enabled, useConnectionURLPath)
+}
+
+func testAccCheckCloudflarePageShieldSettingsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_page_shield_settings" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, pageShieldSettingsURI(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var settings pageShieldSettings
+		if err := json.Unmarshal(res, &settings); err != nil {
+			return err
+		}
+		if settings != defaultPageShieldSettings {
+			return fmt.Errorf("Page Shield settings for zone %s were not reset: %+v", rs.Primary.ID, settings)
+		}
+	}
+
+	return nil
+}
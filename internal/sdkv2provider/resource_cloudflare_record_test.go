@@ -14,7 +14,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
-	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -66,9 +65,9 @@ func TestAccCloudflareRecord_Basic(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, "tf-acctest-basic", rnd),
@@ -102,9 +101,9 @@ func TestAccCloudflareRecord_CaseInsensitive(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, "tf-acctest-case-insensitive", rnd),
@@ -135,9 +134,9 @@ func TestAccCloudflareRecord_Apex(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigApex(zoneID, rnd),
@@ -161,9 +160,9 @@ func TestAccCloudflareRecord_LOC(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigLOC(zoneID, "tf-acctest-loc", rnd),
@@ -199,9 +198,9 @@ func TestAccCloudflareRecord_SRV(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigSRV(zoneID, rnd, domain),
@@ -212,6 +211,7 @@ func TestAccCloudflareRecord_SRV(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "value", "0	5222	talk.l.google.com"),
 					resource.TestCheckResourceAttr(resourceName, "proxiable", "false"),
 					resource.TestCheckResourceAttr(resourceName, "data.0.priority", "5"),
+					resource.TestCheckNoResourceAttr(resourceName, "priority"),
 					resource.TestCheckResourceAttr(resourceName, "data.0.weight", "0"),
 					resource.TestCheckResourceAttr(resourceName, "data.0.port", "5222"),
 					resource.TestCheckResourceAttr(resourceName, "data.0.target", "talk.l.google.com"),
@@ -224,6 +224,58 @@ func TestAccCloudflareRecord_SRV(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_URI(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigURI(zoneID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "URI"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.weight", "20"),
+					resource.TestCheckResourceAttr(resourceName, "data.0.target", "https://example.com/"),
+					resource.TestCheckNoResourceAttr(resourceName, "data.0.priority"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRecordConfigURI(zoneID, rnd),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_PriorityPlacement(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRecordConfigMXDataPriority(zoneID, rnd),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("MX records must set the top-level `priority` instead of `data.priority`")),
+			},
+			{
+				Config:      testAccCheckCloudflareRecordConfigSRVTopLevelPriority(zoneID, rnd),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta("SRV records must set `data.priority` instead of the top-level `priority`")),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_CAA(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -233,9 +285,9 @@ func TestAccCloudflareRecord_CAA(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigCAA(rnd, zoneID, fmt.Sprintf("tf-acctest-caa.%s", domain), 600),
@@ -259,6 +311,51 @@ func TestAccCloudflareRecord_CAA(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_CAATags(t *testing.T) {
+	t.Parallel()
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	testCases := map[string]struct {
+		tag   string
+		value string
+	}{
+		"issue":     {tag: "issue", value: "letsencrypt.org"},
+		"issuewild": {tag: "issuewild", value: ";"},
+		"iodef":     {tag: "iodef", value: "mailto:security@example.com"},
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var record cloudflare.DNSRecord
+			rnd := generateRandomResourceName()
+			resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+			resource.Test(t, resource.TestCase{
+				PreCheck:                 func() { testAccPreCheck(t) },
+				ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+				CheckDestroy:             testAccCheckCloudflareRecordDestroy,
+				Steps: []resource.TestStep{
+					{
+						Config: testAccCheckCloudflareRecordConfigCAATag(rnd, zoneID, fmt.Sprintf("tf-acctest-caa-%s.%s", tc.tag, domain), tc.tag, tc.value),
+						Check: resource.ComposeTestCheckFunc(
+							testAccCheckCloudflareRecordExists(resourceName, &record),
+							resource.TestCheckResourceAttr(resourceName, "data.0.flags", "0"),
+							resource.TestCheckResourceAttr(resourceName, "data.0.tag", tc.tag),
+							resource.TestCheckResourceAttr(resourceName, "data.0.value", tc.value),
+						),
+					},
+					{
+						Config:   testAccCheckCloudflareRecordConfigCAATag(rnd, zoneID, fmt.Sprintf("tf-acctest-caa-%s.%s", tc.tag, domain), tc.tag, tc.value),
+						PlanOnly: true,
+					},
+				},
+			})
+		})
+	}
+}
+
 func TestAccCloudflareRecord_Proxied(t *testing.T) {
 	t.Parallel()
 	var record cloudflare.DNSRecord
@@ -268,9 +365,9 @@ func TestAccCloudflareRecord_Proxied(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigProxied(zoneID, domain, "tf-acctest-proxied", rnd),
@@ -295,9 +392,9 @@ func TestAccCloudflareRecord_Updated(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, recordName, rnd),
@@ -327,9 +424,9 @@ func TestAccCloudflareRecord_typeForceNewRecord(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, recordName, rnd),
@@ -357,9 +454,9 @@ func TestAccCloudflareRecord_hostnameForceNewRecord(t *testing.T) {
 	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, recordName, rnd),
@@ -386,9 +483,9 @@ func TestAccCloudflareRecord_CreateAfterManualDestroy(t *testing.T) {
 	name := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigBasic(zoneID, name, rnd),
@@ -417,9 +514,9 @@ func TestAccCloudflareRecord_TtlValidation(t *testing.T) {
 	rnd := generateRandomResourceName()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccCheckCloudflareRecordConfigTtlValidation(zoneID, recordName, zoneName, rnd),
@@ -429,6 +526,79 @@ func TestAccCloudflareRecord_TtlValidation(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRecord_ProxiedCoercesTTL(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigTTL(zoneID, rnd, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "proxied", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "3600"),
+				),
+			},
+			{
+				Config: testAccCheckCloudflareRecordConfigProxiedType(zoneID, rnd, "A", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "proxied", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ttl", "1"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRecordConfigProxiedType(zoneID, rnd, "A", "192.0.2.1"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_ProxiedNonProxiableType(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareRecordConfigProxiedType(zoneID, rnd, "TXT", "v=spf1 -all"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(`type "TXT" cannot be proxied`)),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRecord_ProxiedProxiableType(t *testing.T) {
+	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	resourceName := fmt.Sprintf("cloudflare_record.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigProxiedType(zoneID, rnd, "A", "192.0.2.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "A"),
+					resource.TestCheckResourceAttr(resourceName, "proxied", "true"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRecord_ExplicitProxiedFalse(t *testing.T) {
 	t.Parallel()
 	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
@@ -437,9 +607,9 @@ func TestAccCloudflareRecord_ExplicitProxiedFalse(t *testing.T) {
 	resourceName := "cloudflare_record." + rnd
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigExplicitProxied(zoneID, rnd, zoneName, "false", "300"),
@@ -474,9 +644,9 @@ func TestAccCloudflareRecord_MXWithPriorityZero(t *testing.T) {
 	resourceName := "cloudflare_record." + rnd
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigMXWithPriorityZero(zoneID, rnd, zoneName),
@@ -485,6 +655,10 @@ func TestAccCloudflareRecord_MXWithPriorityZero(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "value", "mail.terraform.cfapi.net"),
 				),
 			},
+			{
+				Config:   testAccCheckCloudflareRecordConfigMXWithPriorityZero(zoneID, rnd, zoneName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -498,9 +672,9 @@ func TestAccCloudflareRecord_TtlValidationUpdate(t *testing.T) {
 	name := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigProxied(zoneID, domain, recordName, rnd),
@@ -523,9 +697,9 @@ func TestAccCloudflareRecord_HTTPS(t *testing.T) {
 	name := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordConfigHTTPS(zoneID, rnd),
@@ -546,9 +720,9 @@ func TestAccCloudflareRecord_MXNull(t *testing.T) {
 	name := fmt.Sprintf("cloudflare_record.%s", rnd)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareRecordDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareRecordNullMX(zoneID, rnd),
@@ -562,29 +736,33 @@ func TestAccCloudflareRecord_MXNull(t *testing.T) {
 	})
 }
 
-func TestSuppressTrailingDots(t *testing.T) {
+func TestAccCloudflareRecord_MXWithSamePriority(t *testing.T) {
 	t.Parallel()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_record.%s", rnd)
 
-	cases := []struct {
-		old      string
-		new      string
-		expected bool
-	}{
-		{"", "", true},
-		{"", "example.com", false},
-		{"", "example.com.", false},
-		{"", ".", false}, // single dot is used for Null MX record
-		{"example.com", "example.com", true},
-		{"example.com", "example.com.", true},
-		{"example.com", "sub.example.com", false},
-		{"sub.example.com", "sub.example.com.", true},
-		{".", ".", true},
-	}
-
-	for _, c := range cases {
-		got := suppressTrailingDots("", c.old, c.new, nil)
-		assert.Equal(t, c.expected, got)
-	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareRecordDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRecordConfigMXWithSamePriority(zoneID, rnd, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "mx1.example.com"),
+					resource.TestCheckResourceAttr(name, "priority", "10"),
+					resource.TestCheckResourceAttr(name+"_2", "value", "mx2.example.com"),
+					resource.TestCheckResourceAttr(name+"_2", "priority", "10"),
+				),
+			},
+			{
+				Config:   testAccCheckCloudflareRecordConfigMXWithSamePriority(zoneID, rnd, domain),
+				PlanOnly: true,
+			},
+		},
+	})
 }
 
 func testAccCheckCloudflareRecordRecreated(before, after *cloudflare.DNSRecord) resource.TestCheckFunc {
@@ -763,6 +941,52 @@ resource "cloudflare_record" "%[2]s" {
 }`, zoneID, rnd, domain)
 }
 
+func testAccCheckCloudflareRecordConfigURI(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id  = "%[1]s"
+  name     = "_http._tcp.%[2]s"
+  type     = "URI"
+  priority = 10
+  data {
+    weight = 20
+    target = "https://example.com/"
+  }
+}`, zoneID, rnd)
+}
+
+func testAccCheckCloudflareRecordConfigMXDataPriority(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id = "%[1]s"
+  name    = "%[2]s"
+  type    = "MX"
+  value   = "mail.terraform.cfapi.net"
+  data {
+    priority = 10
+  }
+}`, zoneID, rnd)
+}
+
+func testAccCheckCloudflareRecordConfigSRVTopLevelPriority(zoneID, rnd string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+  zone_id  = "%[1]s"
+  name     = "_xmpp-client._tcp.%[2]s"
+  type     = "SRV"
+  priority = 5
+  data {
+    priority = 5
+    weight   = 0
+    port     = 5222
+    target   = "talk.l.google.com"
+    service  = "_xmpp-client"
+    proto    = "_tcp"
+    name     = "%[2]s"
+  }
+}`, zoneID, rnd)
+}
+
 func testAccCheckCloudflareRecordConfigCAA(resourceName, zoneID, name string, ttl int) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[1]s" {
@@ -778,6 +1002,21 @@ resource "cloudflare_record" "%[1]s" {
 }`, resourceName, zoneID, name, ttl)
 }
 
+func testAccCheckCloudflareRecordConfigCAATag(resourceName, zoneID, name, tag, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[1]s" {
+  zone_id = "%[2]s"
+  name = "%[3]s"
+  data {
+    flags = "0"
+    tag   = "%[4]s"
+    value = "%[5]s"
+  }
+  type = "CAA"
+  ttl = 300
+}`, resourceName, zoneID, name, tag, value)
+}
+
 func testAccCheckCloudflareRecordConfigProxied(zoneID, domain, name, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[4]s" {
@@ -836,6 +1075,17 @@ resource "cloudflare_record" "%[4]s" {
 }`, zoneID, name, zoneName, rnd)
 }
 
+func testAccCheckCloudflareRecordConfigProxiedType(zoneID, rnd, recordType, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "%[2]s"
+	value = "%[4]s"
+	type = "%[3]s"
+	proxied = true
+}`, zoneID, rnd, recordType, value)
+}
+
 func testAccCheckCloudflareRecordConfigExplicitProxied(zoneID, name, zoneName, proxied, ttl string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[2]s" {
@@ -861,6 +1111,25 @@ resource "cloudflare_record" "%[2]s" {
 }`, zoneID, name, zoneName)
 }
 
+func testAccCheckCloudflareRecordConfigMXWithSamePriority(zoneID, rnd, domain string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "%[2]s.%[3]s"
+	value = "mx1.example.com"
+	type = "MX"
+	priority = 10
+}
+
+resource "cloudflare_record" "%[2]s_2" {
+	zone_id = "%[1]s"
+	name = "%[2]s.%[3]s"
+	value = "mx2.example.com"
+	type = "MX"
+	priority = 10
+}`, zoneID, rnd, domain)
+}
+
 func testAccCheckCloudflareRecordConfigHTTPS(zoneID, rnd string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[2]s" {
@@ -887,3 +1156,14 @@ func testAccCheckCloudflareRecordNullMX(zoneID, rnd string) string {
 	  }
 	`, rnd, zoneID)
 }
+
+func testAccCheckCloudflareRecordConfigTTL(zoneID, rnd string, ttl int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_record" "%[2]s" {
+	zone_id = "%[1]s"
+	name = "%[2]s"
+	value = "192.0.2.1"
+	type = "A"
+	ttl = %[3]d
+}`, zoneID, rnd, ttl)
+}
@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/cloudflare/cloudflare-go"
@@ -104,7 +105,7 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if deleteRulesetErr != nil {
-			return diag.FromErr(fmt.Errorf("failed to delete ruleset: %w", deleteRulesetErr))
+			return cfDiagFromErr(fmt.Errorf("failed to delete ruleset: %w", deleteRulesetErr))
 		}
 	}
 
@@ -116,7 +117,7 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if rulesetCreateErr != nil {
-		return diag.FromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, rulesetCreateErr))
+		return cfDiagFromErr(fmt.Errorf("error creating ruleset %s: %w", rulesetName, rulesetCreateErr))
 	}
 
 	rulesetEntryPoint := cloudflare.Ruleset{
@@ -134,7 +135,7 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 		}
 
 		if err != nil {
-			return diag.FromErr(fmt.Errorf("error updating ruleset phase entrypoint %s: %w", rulesetName, err))
+			return cfDiagFromErr(fmt.Errorf("error updating ruleset phase entrypoint %s: %w", rulesetName, err))
 		}
 	}
 
@@ -144,18 +145,15 @@ func resourceCloudflareRulesetCreate(ctx context.Context, d *schema.ResourceData
 }
 
 func resourceCloudflareRulesetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	attributes := strings.SplitN(d.Id(), "/", 3)
-
-	if len(attributes) != 3 {
-		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "resourceType/resourceTypeID/rulesetID`, d.Id())
+	identifier, rulesetID, err := parseRulesetImportID(d.Id())
+	if err != nil {
+		return nil, err
 	}
 
-	resourceType, resourceTypeID, rulesetID := attributes[0], attributes[1], attributes[2]
-
-	if resourceType == "account" {
-		d.Set("account_id", resourceTypeID)
+	if identifier.Type == AccountType {
+		d.Set(consts.AccountIDSchemaKey, identifier.Value)
 	} else {
-		d.Set("zone_id", resourceTypeID)
+		d.Set(consts.ZoneIDSchemaKey, identifier.Value)
 	}
 	d.SetId(rulesetID)
 
@@ -164,6 +162,43 @@ func resourceCloudflareRulesetImport(ctx context.Context, d *schema.ResourceData
 	return []*schema.ResourceData{d}, nil
 }
 
+// parseRulesetImportID parses the import ID of a ruleset. The following
+// formats are supported:
+//
+//   - "account/<account_id>/<ruleset_id>" and "zone/<zone_id>/<ruleset_id>"
+//   - "account:<account_id>/<ruleset_id>" and "zone:<zone_id>/<ruleset_id>"
+//   - "<account_id>/<ruleset_id>" for account scoped rulesets
+func parseRulesetImportID(id string) (AccessIdentifier, string, error) {
+	invalidIDErr := fmt.Errorf(`invalid id (%q) specified, should be in format "account/accountID/rulesetID", "zone/zoneID/rulesetID" or "accountID/rulesetID"`, id)
+
+	attributes := strings.Split(id, "/")
+	for _, attribute := range attributes {
+		if attribute == "" {
+			return AccessIdentifier{}, "", invalidIDErr
+		}
+	}
+
+	var resourceType, resourceTypeID, rulesetID string
+	switch len(attributes) {
+	case 3:
+		resourceType, resourceTypeID, rulesetID = attributes[0], attributes[1], attributes[2]
+	case 2:
+		resourceType, resourceTypeID, rulesetID = string(AccountType), attributes[0], attributes[1]
+		if discriminator, value, found := strings.Cut(attributes[0], ":"); found {
+			resourceType, resourceTypeID = discriminator, value
+		}
+	default:
+		return AccessIdentifier{}, "", invalidIDErr
+	}
+
+	identifierType := AccessIdentifierType(resourceType)
+	if (identifierType != AccountType && identifierType != ZoneType) || resourceTypeID == "" {
+		return AccessIdentifier{}, "", invalidIDErr
+	}
+
+	return AccessIdentifier{Type: identifierType, Value: resourceTypeID}, rulesetID, nil
+}
+
 func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
@@ -184,7 +219,7 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 			d.SetId("")
 			return nil
 		}
-		return diag.FromErr(fmt.Errorf("error reading ruleset ID %q: %w", d.Id(), err))
+		return cfDiagFromErr(fmt.Errorf("error reading ruleset ID %q: %w", d.Id(), err))
 	}
 
 	d.Set("name", ruleset.Name)
@@ -192,6 +227,10 @@ func resourceCloudflareRulesetRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("kind", ruleset.Kind)
 	d.Set("phase", ruleset.Phase)
 
+	if ruleset.LastUpdated != nil {
+		d.Set("modified_on", ruleset.LastUpdated.Format(time.RFC3339Nano))
+	}
+
 	if err := d.Set("rules", buildStateFromRulesetRules(ruleset.Rules)); err != nil {
 		return diag.FromErr(err)
 	}
@@ -217,7 +256,7 @@ func resourceCloudflareRulesetUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error updating ruleset with ID %q: %w", d.Id(), err))
+		return cfDiagFromErr(fmt.Errorf("error updating ruleset with ID %q: %w", d.Id(), err))
 	}
 
 	return resourceCloudflareRulesetRead(ctx, d, meta)
@@ -236,7 +275,7 @@ func resourceCloudflareRulesetDelete(ctx context.Context, d *schema.ResourceData
 	}
 
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting ruleset with ID %q: %w", d.Id(), err))
+		return cfDiagFromErr(fmt.Errorf("error deleting ruleset with ID %q: %w", d.Id(), err))
 	}
 
 	return nil
@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -95,6 +98,7 @@ func TestAccCloudflareRuleset_WAFBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", rnd+" ruleset description"),
 					resource.TestCheckResourceAttr(resourceName, "kind", "zone"),
 					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_firewall_custom"),
+					resource.TestCheckResourceAttrSet(resourceName, "modified_on"),
 
 					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
 					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "challenge"),
@@ -1269,6 +1273,163 @@ func TestAccCloudflareRuleset_AccountLevelCustomWAFRule(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareRuleset_ImportAccountLevelCustomRuleset(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneName := os.Getenv("CLOUDFLARE_DOMAIN")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetAccountLevelCustomRuleset(rnd, accountID, zoneName),
+			},
+			{
+				ResourceName:        resourceName,
+				ImportState:         true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported ruleset, got %d", len(states))
+					}
+
+					attributes := states[0].Attributes
+					if attributes["account_id"] != accountID {
+						return fmt.Errorf("expected account_id %q, got %q", accountID, attributes["account_id"])
+					}
+					if attributes["zone_id"] != "" {
+						return fmt.Errorf("expected zone_id to be empty, got %q", attributes["zone_id"])
+					}
+					if attributes["kind"] != "custom" || attributes["name"] != rnd {
+						return fmt.Errorf("unexpected ruleset imported: kind %q, name %q", attributes["kind"], attributes["name"])
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestParseRulesetImportID(t *testing.T) {
+	testCases := map[string]struct {
+		id                 string
+		expectedIdentifier AccessIdentifier
+		expectedRulesetID  string
+		err                bool
+	}{
+		"account prefix":        {id: "account/f037e56e89293a057740de681ac9abbe/2f2feab2026849078ba485f918791bdc", expectedIdentifier: AccessIdentifier{Type: AccountType, Value: "f037e56e89293a057740de681ac9abbe"}, expectedRulesetID: "2f2feab2026849078ba485f918791bdc"},
+		"zone prefix":           {id: "zone/0da42c8d2132a9ddaf714f9e7c920711/2f2feab2026849078ba485f918791bdc", expectedIdentifier: AccessIdentifier{Type: ZoneType, Value: "0da42c8d2132a9ddaf714f9e7c920711"}, expectedRulesetID: "2f2feab2026849078ba485f918791bdc"},
+		"account discriminator": {id: "account:f037e56e89293a057740de681ac9abbe/2f2feab2026849078ba485f918791bdc", expectedIdentifier: AccessIdentifier{Type: AccountType, Value: "f037e56e89293a057740de681ac9abbe"}, expectedRulesetID: "2f2feab2026849078ba485f918791bdc"},
+		"zone discriminator":    {id: "zone:0da42c8d2132a9ddaf714f9e7c920711/2f2feab2026849078ba485f918791bdc", expectedIdentifier: AccessIdentifier{Type: ZoneType, Value: "0da42c8d2132a9ddaf714f9e7c920711"}, expectedRulesetID: "2f2feab2026849078ba485f918791bdc"},
+		"account without type":  {id: "f037e56e89293a057740de681ac9abbe/2f2feab2026849078ba485f918791bdc", expectedIdentifier: AccessIdentifier{Type: AccountType, Value: "f037e56e89293a057740de681ac9abbe"}, expectedRulesetID: "2f2feab2026849078ba485f918791bdc"},
+		"unknown prefix":        {id: "user/f037e56e89293a057740de681ac9abbe/2f2feab2026849078ba485f918791bdc", err: true},
+		"unknown discriminator": {id: "user:f037e56e89293a057740de681ac9abbe/2f2feab2026849078ba485f918791bdc", err: true},
+		"missing ruleset":       {id: "f037e56e89293a057740de681ac9abbe", err: true},
+		"empty ruleset":         {id: "account/f037e56e89293a057740de681ac9abbe/", err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			identifier, rulesetID, err := parseRulesetImportID(tc.id)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedIdentifier, identifier)
+			assert.Equal(t, tc.expectedRulesetID, rulesetID)
+		})
+	}
+}
+
+func testAccCheckCloudflareRulesetAccountLevelCustomRuleset(rnd, accountID, zoneName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    account_id  = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "custom"
+    phase       = "http_request_firewall_custom"
+
+    rules {
+      action      = "block"
+      expression  = "(http.host eq \"%[3]s\")"
+      description = "%[1]s block rule"
+      enabled     = true
+    }
+  }`, rnd, accountID, zoneName)
+}
+
+func TestAccCloudflareRuleset_CustomWAFRuleWithIPList(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	t.Parallel()
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	resourceName := "cloudflare_ruleset." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareRulesetCustomWAFRuleWithIPList(rnd, accountID, zoneID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "phase", "http_request_firewall_custom"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action", "skip"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.action_parameters.0.ruleset", "current"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.expression", fmt.Sprintf("ip.src in $allowed_ips_%s", rnd)),
+
+					resource.TestCheckResourceAttr(resourceName, "rules.1.action", "block"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.expression", fmt.Sprintf("ip.src in $blocked_ips_%s", rnd)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareRuleset_FromListInvalidName(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareRulesetRedirectFromListName(rnd, accountID, "$redirect_list"),
+				ExpectError: regexp.MustCompile("list name must only contain lowercase letters, numbers and underscores"),
+			},
+		},
+	})
+}
+
 func TestAccCloudflareRuleset_ExposedCredentialCheck(t *testing.T) {
 	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the WAF
 	// service does not yet support the API tokens and it results in
@@ -3181,6 +3342,90 @@ func testAccCloudflareRulesetRedirectFromList(rnd, accountID string) string {
   }`, rnd, accountID)
 }
 
+func testAccCloudflareRulesetRedirectFromListName(rnd, accountID, listName string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_ruleset" "%[1]s" {
+    account_id  = "%[2]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "root"
+    phase       = "http_request_redirect"
+
+    rules {
+      action = "redirect"
+      action_parameters {
+        from_list {
+          name = "%[3]s"
+          key = "http.request.full_uri"
+        }
+      }
+      expression = "http.request.full_uri in %[3]s"
+      description = "Apply redirects from redirect list"
+      enabled = true
+    }
+  }`, rnd, accountID, listName)
+}
+
+func testAccCheckCloudflareRulesetCustomWAFRuleWithIPList(rnd, accountID, zoneID string) string {
+	return fmt.Sprintf(`
+  resource "cloudflare_list" "%[1]s_allowed" {
+    account_id = "%[2]s"
+    name       = "allowed_ips_%[1]s"
+    kind       = "ip"
+
+    item {
+      value {
+        ip = "192.0.2.0/24"
+      }
+    }
+  }
+
+  resource "cloudflare_list" "%[1]s_blocked" {
+    account_id = "%[2]s"
+    name       = "blocked_ips_%[1]s"
+    kind       = "ip"
+
+    item {
+      value {
+        ip = "198.51.100.0/24"
+      }
+    }
+  }
+
+  resource "cloudflare_ruleset" "%[1]s" {
+    zone_id     = "%[3]s"
+    name        = "%[1]s"
+    description = "%[1]s ruleset description"
+    kind        = "zone"
+    phase       = "http_request_firewall_custom"
+
+    depends_on = [
+      cloudflare_list.%[1]s_allowed,
+      cloudflare_list.%[1]s_blocked,
+    ]
+
+    rules {
+      action = "skip"
+      action_parameters {
+        ruleset = "current"
+      }
+      expression  = "ip.src in $allowed_ips_%[1]s"
+      description = "skip remaining custom rules for allowed IPs"
+      enabled     = true
+      logging {
+        enabled = true
+      }
+    }
+
+    rules {
+      action      = "block"
+      expression  = "ip.src in $blocked_ips_%[1]s"
+      description = "block IPs in the blocked list"
+      enabled     = true
+    }
+  }`, rnd, accountID, zoneID)
+}
+
 func testAccCloudflareRulesetRedirectFromValue(rnd, zoneID string) string {
 	return fmt.Sprintf(`
   resource "cloudflare_ruleset" "%[1]s" {
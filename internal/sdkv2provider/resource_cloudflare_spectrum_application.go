@@ -25,6 +25,7 @@ func resourceCloudflareSpectrumApplication() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareSpectrumApplicationImport,
 		},
+		CustomizeDiff: resourceCloudflareSpectrumApplicationValidateDiff,
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Spectrum Application. You can extend the power
 			of Cloudflare's DDoS, TLS, and IP Firewall to your other TCP-based
@@ -33,6 +34,39 @@ func resourceCloudflareSpectrumApplication() *schema.Resource {
 	}
 }
 
+// resourceCloudflareSpectrumApplicationValidateDiff rejects combinations of
+// edge IP and proxy protocol settings that the API only refuses at apply.
+func resourceCloudflareSpectrumApplicationValidateDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if edgeIPs, ok := d.GetOk("edge_ips"); ok && edgeIPs.(*schema.Set).Len() > 0 && d.NewValueKnown("dns") {
+		if dnsType := d.Get("dns.0.type").(string); dnsType != "ADDRESS" {
+			return fmt.Errorf(`edge_ips requires a dns record of type "ADDRESS", got %q`, dnsType)
+		}
+	}
+
+	if !d.NewValueKnown("proxy_protocol") || !d.NewValueKnown("protocol") || !d.NewValueKnown("traffic_type") {
+		return nil
+	}
+
+	proxyProtocol := d.Get("proxy_protocol").(string)
+	if proxyProtocol == "" || proxyProtocol == "off" {
+		return nil
+	}
+
+	if trafficType := d.Get("traffic_type").(string); trafficType != "direct" {
+		return fmt.Errorf(`proxy_protocol %q requires traffic_type "direct", got %q`, proxyProtocol, trafficType)
+	}
+
+	transport := strings.SplitN(d.Get("protocol").(string), "/", 2)[0]
+	switch {
+	case proxyProtocol == "v1" && transport != "tcp":
+		return fmt.Errorf(`proxy_protocol "v1" is only supported for TCP applications, got protocol %q`, d.Get("protocol").(string))
+	case proxyProtocol == "simple" && transport != "udp":
+		return fmt.Errorf(`proxy_protocol "simple" is only supported for UDP applications, got protocol %q`, d.Get("protocol").(string))
+	}
+
+	return nil
+}
+
 func resourceCloudflareSpectrumApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 
@@ -119,7 +153,7 @@ func resourceCloudflareSpectrumApplicationRead(ctx context.Context, d *schema.Re
 	}
 
 	if application.EdgeIPs != nil {
-		if err := d.Set("edge_ips", flattenEdgeIPs(application.EdgeIPs)); err != nil {
+		if err := d.Set("edge_ips", flattenEdgeIPs(application.EdgeIPs, d.Get("edge_ips").(*schema.Set))); err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("Error setting Edge IPs on spectrum application %q: %s", d.Id(), err))
 		}
 
@@ -229,11 +263,21 @@ func flattenOriginPortRange(port *cloudflare.SpectrumApplicationOriginPort) []ma
 	return []map[string]interface{}{flattened}
 }
 
-func flattenEdgeIPs(edgeIPs *cloudflare.SpectrumApplicationEdgeIPs) []string {
+// flattenEdgeIPs returns the edge IPs of an application, keeping the notation
+// of IPs already in current so that equivalent IPv6 addresses such as
+// "2606:4700:0:0::1" and "2606:4700::1" don't cause a diff.
+func flattenEdgeIPs(edgeIPs *cloudflare.SpectrumApplicationEdgeIPs, current *schema.Set) []string {
 	flattened := make([]string, 0)
 
 	for _, ip := range edgeIPs.IPs {
-		flattened = append(flattened, ip.String())
+		value := ip.String()
+		for _, c := range current.List() {
+			if ip.Equal(net.ParseIP(c.(string))) {
+				value = c.(string)
+				break
+			}
+		}
+		flattened = append(flattened, value)
 	}
 
 	return flattened
@@ -260,17 +304,9 @@ func applicationFromResource(d *schema.ResourceData) cloudflare.SpectrumApplicat
 		application.OriginPort = expandOriginPortRange(originPortRange)
 	}
 
-	if tls, ok := d.GetOk("tls"); ok {
-		application.TLS = tls.(string)
-	}
-
-	if trafficType, ok := d.GetOk("traffic_type"); ok {
-		application.TrafficType = trafficType.(string)
-	}
-
-	if ipFirewall, ok := d.GetOk("ip_firewall"); ok {
-		application.IPFirewall = ipFirewall.(bool)
-	}
+	application.TLS = d.Get("tls").(string)
+	application.TrafficType = d.Get("traffic_type").(string)
+	application.IPFirewall = d.Get("ip_firewall").(bool)
 
 	if proxyProtocol, ok := d.GetOk("proxy_protocol"); ok {
 		application.ProxyProtocol = cloudflare.ProxyProtocol(proxyProtocol.(string))
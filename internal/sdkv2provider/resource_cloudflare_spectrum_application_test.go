@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
+	"regexp"
 	"testing"
 
 	"os"
@@ -12,7 +14,9 @@ import (
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func init() {
@@ -87,7 +91,7 @@ func TestAccCloudflareSpectrumApplication_Basic(t *testing.T) {
 	})
 }
 
-func TestAccCloudflareSpectrumApplication_OriginDNS(t *testing.T) {
+func TestAccCloudflareSpectrumApplication_IPFirewallStrictTLS(t *testing.T) {
 	var spectrumApp cloudflare.SpectrumApplication
 	domain := os.Getenv("CLOUDFLARE_DOMAIN")
 	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
@@ -98,6 +102,49 @@ func TestAccCloudflareSpectrumApplication_OriginDNS(t *testing.T) {
 		PreCheck:          func() { testAccPreCheck(t) },
 		ProviderFactories: providerFactories,
 		CheckDestroy:      testAccCheckCloudflareSpectrumApplicationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareSpectrumApplicationConfigTLSAndIPFirewall(zoneID, domain, rnd, "strict", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
+					resource.TestCheckResourceAttr(name, "tls", "strict"),
+					resource.TestCheckResourceAttr(name, "ip_firewall", "true"),
+					resource.TestCheckResourceAttr(name, "traffic_type", "direct"),
+					func(s *terraform.State) error {
+						if spectrumApp.TLS != "strict" || !spectrumApp.IPFirewall {
+							return fmt.Errorf("expected strict TLS with IP firewall enabled, got tls %q and ip_firewall %t", spectrumApp.TLS, spectrumApp.IPFirewall)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckCloudflareSpectrumApplicationConfigTLSAndIPFirewall(zoneID, domain, rnd, "full", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareSpectrumApplicationExists(name, &spectrumApp),
+					resource.TestCheckResourceAttr(name, "tls", "full"),
+					resource.TestCheckResourceAttr(name, "ip_firewall", "false"),
+				),
+			},
+			{
+				Config:      testAccCheckCloudflareSpectrumApplicationConfigTLSAndIPFirewall(zoneID, domain, rnd, "invalid", true),
+				ExpectError: regexp.MustCompile(`expected tls to be one of \[off flexible full strict\]`),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareSpectrumApplication_OriginDNS(t *testing.T) {
+	var spectrumApp cloudflare.SpectrumApplication
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+	name := "cloudflare_spectrum_application." + rnd
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareSpectrumApplicationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareSpectrumApplicationConfigOriginDNS(zoneID, domain, rnd),
@@ -122,9 +169,9 @@ func TestAccCloudflareSpectrumApplication_OriginPortRange(t *testing.T) {
 	name := "cloudflare_spectrum_application." + rnd
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: providerFactories,
-		CheckDestroy:      testAccCheckCloudflareSpectrumApplicationDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCloudflareSpectrumApplicationDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckCloudflareSpectrumApplicationConfigOriginPortRange(zoneID, domain, rnd),
@@ -285,6 +332,49 @@ func TestAccCloudflareSpectrumApplication_EdgeIPsMultiple(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareSpectrumApplication_InvalidCombinations(t *testing.T) {
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckCloudflareSpectrumApplicationConfigProxyProtocol(zoneID, domain, rnd, "udp/53", "simple", "http"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`requires traffic_type "direct"`),
+			},
+			{
+				Config:      testAccCheckCloudflareSpectrumApplicationConfigProxyProtocol(zoneID, domain, rnd, "tcp/22", "simple", "direct"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only supported for UDP applications`),
+			},
+			{
+				Config:      testAccCheckCloudflareSpectrumApplicationConfigProxyProtocol(zoneID, domain, rnd, "udp/53", "v1", "direct"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`only supported for TCP applications`),
+			},
+			{
+				Config:      testAccCheckCloudflareSpectrumApplicationConfigEdgeIPsCNAME(zoneID, domain, rnd),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`edge_ips requires a dns record of type "ADDRESS"`),
+			},
+		},
+	})
+}
+
+func TestFlattenEdgeIPsKeepsConfiguredNotation(t *testing.T) {
+	edgeIPs := &cloudflare.SpectrumApplicationEdgeIPs{
+		Type: cloudflare.SpectrumEdgeTypeStatic,
+		IPs:  []net.IP{net.ParseIP("2606:4700::1"), net.ParseIP("172.65.64.13")},
+	}
+	current := schema.NewSet(schema.HashString, []interface{}{"2606:4700:0:0:0:0:0:1"})
+
+	assert.ElementsMatch(t, []string{"2606:4700:0:0:0:0:0:1", "172.65.64.13"}, flattenEdgeIPs(edgeIPs, current))
+}
+
 func testAccCheckCloudflareSpectrumApplicationExists(n string, spectrumApp *cloudflare.SpectrumApplication) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -360,6 +450,25 @@ resource "cloudflare_spectrum_application" "%[3]s" {
 `, zoneID, zoneName, ID)
 }
 
+func testAccCheckCloudflareSpectrumApplicationConfigTLSAndIPFirewall(zoneID, zoneName, ID, tls string, ipFirewall bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_spectrum_application" "%[3]s" {
+  zone_id  = "%[1]s"
+  protocol = "tcp/443"
+
+  dns {
+    type = "CNAME"
+    name = "%[3]s.%[2]s"
+  }
+
+  origin_direct = ["tcp://128.66.0.1:443"]
+  origin_port   = 443
+  tls           = "%[4]s"
+  ip_firewall   = %[5]t
+}
+`, zoneID, zoneName, ID, tls, ipFirewall)
+}
+
 func testAccCheckCloudflareSpectrumApplicationConfigOriginDNS(zoneID, zoneName, ID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_record" "%[3]s" {
@@ -484,3 +593,37 @@ resource "cloudflare_spectrum_application" "%[3]s" {
   edge_ips = [%[4]s]
 }`, zoneID, zoneName, ID, IPs)
 }
+
+func testAccCheckCloudflareSpectrumApplicationConfigProxyProtocol(zoneID, zoneName, ID, protocol, proxyProtocol, trafficType string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_spectrum_application" "%[3]s" {
+  zone_id  = "%[1]s"
+  protocol = "%[4]s"
+
+  dns {
+    type = "CNAME"
+    name = "%[3]s.%[2]s"
+  }
+
+  origin_direct  = ["tcp://128.66.0.4:23"]
+  proxy_protocol = "%[5]s"
+  traffic_type   = "%[6]s"
+}`, zoneID, zoneName, ID, protocol, proxyProtocol, trafficType)
+}
+
+func testAccCheckCloudflareSpectrumApplicationConfigEdgeIPsCNAME(zoneID, zoneName, ID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_spectrum_application" "%[3]s" {
+  zone_id  = "%[1]s"
+  protocol = "tcp/22"
+
+  dns {
+    type = "CNAME"
+    name = "%[3]s.%[2]s"
+  }
+
+  origin_direct = ["tcp://128.66.0.4:23"]
+  origin_port   = 22
+  edge_ips      = ["172.65.64.13"]
+}`, zoneID, zoneName, ID)
+}
@@ -0,0 +1,224 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// streamLiveInput is a Stream Live input that can be broadcast to over RTMPS
+// or SRT.
+type streamLiveInput struct {
+	UID       string                     `json:"uid,omitempty"`
+	Meta      map[string]string          `json:"meta,omitempty"`
+	Recording streamLiveInputRecording   `json:"recording"`
+	RTMPS     *streamLiveInputConnection `json:"rtmps,omitempty"`
+	SRT       *streamLiveInputConnection `json:"srt,omitempty"`
+}
+
+type streamLiveInputRecording struct {
+	Mode              string `json:"mode,omitempty"`
+	TimeoutSeconds    int    `json:"timeoutSeconds"`
+	RequireSignedURLs bool   `json:"requireSignedURLs"`
+}
+
+type streamLiveInputConnection struct {
+	URL        string `json:"url"`
+	StreamKey  string `json:"streamKey,omitempty"`
+	StreamID   string `json:"streamId,omitempty"`
+	Passphrase string `json:"passphrase,omitempty"`
+}
+
+func resourceCloudflareStreamLiveInput() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareStreamLiveInputSchema(),
+		CreateContext: resourceCloudflareStreamLiveInputCreate,
+		ReadContext:   resourceCloudflareStreamLiveInputRead,
+		UpdateContext: resourceCloudflareStreamLiveInputUpdate,
+		DeleteContext: resourceCloudflareStreamLiveInputDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareStreamLiveInputImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Stream Live input resource. Live inputs
+			receive RTMPS or SRT broadcasts and can record them.
+		`),
+	}
+}
+
+func resourceCloudflareStreamLiveInputCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Stream Live input for account %q", accountID))
+
+	input, err := streamLiveInputRequest(ctx, client, http.MethodPost, streamLiveInputURI(accountID, ""), buildStreamLiveInput(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Stream Live input for account %q: %w", accountID, err))
+	}
+
+	d.SetId(input.UID)
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamLiveInputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	input, err := streamLiveInputRequest(ctx, client, http.MethodGet, streamLiveInputURI(accountID, d.Id()), nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Stream Live input %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Stream Live input %q: %w", d.Id(), err))
+	}
+
+	d.Set("uid", input.UID)
+	d.Set("meta", input.Meta)
+
+	if err := d.Set("recording", []map[string]interface{}{{
+		"mode":                input.Recording.Mode,
+		"timeout_seconds":     input.Recording.TimeoutSeconds,
+		"require_signed_urls": input.Recording.RequireSignedURLs,
+	}}); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set recording: %w", err))
+	}
+
+	if err := d.Set("rtmps", flattenStreamLiveInputRTMPS(input.RTMPS)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set rtmps: %w", err))
+	}
+
+	if err := d.Set("srt", flattenStreamLiveInputSRT(input.SRT)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set srt: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Stream Live input using ID: %s", d.Id()))
+
+	if _, err := streamLiveInputRequest(ctx, client, http.MethodPut, streamLiveInputURI(accountID, d.Id()), buildStreamLiveInput(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Stream Live input %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+}
+
+func resourceCloudflareStreamLiveInputDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Stream Live input using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, streamLiveInputURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Stream Live input %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareStreamLiveInputImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/inputUID\"", d.Id())
+	}
+
+	accountID, inputUID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Stream Live input: id %s for account %s", inputUID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(inputUID)
+
+	resourceCloudflareStreamLiveInputRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// streamLiveInputRequest calls the live input API with request and response
+// dumps of the API client disabled as every response contains the secrets
+// needed to broadcast to the input.
+func streamLiveInputRequest(ctx context.Context, client *cloudflare.API, method, uri string, input interface{}) (streamLiveInput, error) {
+	var result streamLiveInput
+	res, err := quietClient(client).Raw(ctx, method, uri, input, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal Stream Live input: %w", err)
+	}
+
+	return result, nil
+}
+
+func buildStreamLiveInput(d *schema.ResourceData) streamLiveInput {
+	input := streamLiveInput{
+		Meta: make(map[string]string),
+		Recording: streamLiveInputRecording{
+			Mode:              d.Get("recording.0.mode").(string),
+			TimeoutSeconds:    d.Get("recording.0.timeout_seconds").(int),
+			RequireSignedURLs: d.Get("recording.0.require_signed_urls").(bool),
+		},
+	}
+
+	for k, v := range d.Get("meta").(map[string]interface{}) {
+		input.Meta[k] = v.(string)
+	}
+
+	if input.Recording.Mode == "" {
+		input.Recording.Mode = "off"
+	}
+
+	return input
+}
+
+func flattenStreamLiveInputRTMPS(connection *streamLiveInputConnection) []map[string]interface{} {
+	if connection == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"url":        connection.URL,
+		"stream_key": connection.StreamKey,
+	}}
+}
+
+func flattenStreamLiveInputSRT(connection *streamLiveInputConnection) []map[string]interface{} {
+	if connection == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"url":        connection.URL,
+		"stream_id":  connection.StreamID,
+		"passphrase": connection.Passphrase,
+	}}
+}
+
+func streamLiveInputURI(accountID, inputUID string) string {
+	uri := fmt.Sprintf("/accounts/%s/stream/live_inputs", accountID)
+	if inputUID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, inputUID)
+	}
+	return uri
+}
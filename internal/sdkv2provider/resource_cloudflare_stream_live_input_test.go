@@ -0,0 +1,92 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareStreamLiveInput_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_stream_live_input.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareStreamLiveInputDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID, "automatic", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "meta.name", rnd),
+					resource.TestCheckResourceAttr(name, "recording.0.mode", "automatic"),
+					resource.TestCheckResourceAttr(name, "recording.0.timeout_seconds", "10"),
+					resource.TestCheckResourceAttr(name, "recording.0.require_signed_urls", "false"),
+					resource.TestCheckResourceAttrPair(name, "uid", name, "id"),
+					resource.TestCheckResourceAttrSet(name, "rtmps.0.url"),
+					resource.TestCheckResourceAttrSet(name, "rtmps.0.stream_key"),
+					resource.TestCheckResourceAttrSet(name, "srt.0.url"),
+					resource.TestCheckResourceAttrSet(name, "srt.0.passphrase"),
+				),
+			},
+			{
+				Config: testAccCloudflareStreamLiveInputConfig(rnd, accountID, "off", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "recording.0.mode", "off"),
+					resource.TestCheckResourceAttr(name, "recording.0.timeout_seconds", "0"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareStreamLiveInputConfig(rnd, accountID, mode string, timeoutSeconds int) string {
+	return fmt.Sprintf(`
+resource "cloudflare_stream_live_input" "%[1]s" {
+  account_id = "%[2]s"
+
+  meta = {
+    name = "%[1]s"
+  }
+
+  recording {
+    mode            = "%[3]s"
+    timeout_seconds = %[4]d
+  }
+}
+`, rnd, accountID, mode, timeoutSeconds)
+}
+
+func testAccCheckCloudflareStreamLiveInputDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_stream_live_input" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, streamLiveInputURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Stream Live input still exists")
+		}
+	}
+
+	return nil
+}
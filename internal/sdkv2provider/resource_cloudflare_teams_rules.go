@@ -2,6 +2,7 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
@@ -30,11 +31,37 @@ func resourceCloudflareTeamsRule() *schema.Resource {
 
 const rulePrecedenceFactor int64 = 1000
 
+// teamsRule extends cloudflare.TeamsRule with the rule settings that are not
+// yet available in cloudflare-go.
+type teamsRule struct {
+	cloudflare.TeamsRule
+	RuleSettings teamsRuleSettingsExtended `json:"rule_settings,omitempty"`
+}
+
+type teamsRuleSettingsExtended struct {
+	cloudflare.TeamsRuleSettings
+
+	// settings for how to handle untrusted origin certificates in HTTP policies
+	UntrustedCertSettings *teamsUntrustedCertSettings `json:"untrusted_cert,omitempty"`
+
+	// whether to send matching DNS queries to Cloudflare's default resolver
+	ResolveDNSThroughCloudflare bool `json:"resolve_dns_through_cloudflare"`
+
+	// whether to match on the IP categories of the resolved DNS query
+	IPCategories bool `json:"ip_categories"`
+}
+
+type teamsUntrustedCertSettings struct {
+	Action string `json:"action"`
+}
+
+var teamsUntrustedCertActions = []string{"pass_through", "block", "error"}
+
 func resourceCloudflareTeamsRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
 
-	rule, err := client.TeamsRule(ctx, accountID, d.Id())
+	rule, err := getTeamsRule(ctx, client, accountID, d.Id())
 	if err != nil {
 		if strings.Contains(err.Error(), "invalid rule id") {
 			tflog.Info(ctx, fmt.Sprintf("Teams Rule config %s does not exists", d.Id()))
@@ -92,7 +119,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 
 	ruleName := d.Get("name").(string)
 	apiPrecedence := providerToApiRulePrecedence(int64(d.Get("precedence").(int)), ruleName)
-	newTeamsRule := cloudflare.TeamsRule{
+	newTeamsRule := teamsRule{TeamsRule: cloudflare.TeamsRule{
 		Name:          ruleName,
 		Description:   d.Get("description").(string),
 		Precedence:    uint64(apiPrecedence),
@@ -103,7 +130,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 		Identity:      d.Get("identity").(string),
 		DevicePosture: d.Get("device_posture").(string),
 		Version:       uint64(d.Get("version").(int)),
-	}
+	}}
 
 	if settings != nil {
 		newTeamsRule.RuleSettings = *settings
@@ -111,7 +138,7 @@ func resourceCloudflareTeamsRuleCreate(ctx context.Context, d *schema.ResourceDa
 
 	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Teams Rule from struct: %+v", newTeamsRule))
 
-	rule, err := client.TeamsCreateRule(ctx, accountID, newTeamsRule)
+	rule, err := putTeamsRule(ctx, client, accountID, newTeamsRule)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating Teams rule for account %q: %w", accountID, err))
 	}
@@ -132,7 +159,7 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 
 	ruleName := d.Get("name").(string)
 	apiPrecedence := providerToApiRulePrecedence(int64(d.Get("precedence").(int)), ruleName)
-	teamsRule := cloudflare.TeamsRule{
+	updatedRule := teamsRule{TeamsRule: cloudflare.TeamsRule{
 		ID:            d.Id(),
 		Name:          ruleName,
 		Description:   d.Get("description").(string),
@@ -144,14 +171,14 @@ func resourceCloudflareTeamsRuleUpdate(ctx context.Context, d *schema.ResourceDa
 		Identity:      d.Get("identity").(string),
 		DevicePosture: d.Get("device_posture").(string),
 		Version:       uint64(d.Get("version").(int)),
-	}
+	}}
 
 	if settings != nil {
-		teamsRule.RuleSettings = *settings
+		updatedRule.RuleSettings = *settings
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams rule from struct: %+v", teamsRule))
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Teams rule from struct: %+v", updatedRule))
 
-	updatedTeamsRule, err := client.TeamsUpdateRule(ctx, accountID, teamsRule.ID, teamsRule)
+	updatedTeamsRule, err := putTeamsRule(ctx, client, accountID, updatedRule)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error updating Teams rule for account %q: %w", accountID, err))
 	}
@@ -195,7 +222,44 @@ func resourceCloudflareTeamsRuleImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
-func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interface{} {
+// getTeamsRule fetches a single Teams rule including the rule settings that
+// cloudflare-go does not yet know about.
+func getTeamsRule(ctx context.Context, client *cloudflare.API, accountID, ruleID string) (teamsRule, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/gateway/rules/%s", accountID, ruleID), nil, nil)
+	if err != nil {
+		return teamsRule{}, err
+	}
+
+	var rule teamsRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return teamsRule{}, fmt.Errorf("failed to unmarshal Teams Rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// putTeamsRule creates the Teams rule when it has no ID or otherwise updates
+// the existing rule.
+func putTeamsRule(ctx context.Context, client *cloudflare.API, accountID string, rule teamsRule) (teamsRule, error) {
+	method, uri := http.MethodPost, fmt.Sprintf("/accounts/%s/gateway/rules", accountID)
+	if rule.ID != "" {
+		method, uri = http.MethodPut, fmt.Sprintf("/accounts/%s/gateway/rules/%s", accountID, rule.ID)
+	}
+
+	res, err := client.Raw(ctx, method, uri, rule, nil)
+	if err != nil {
+		return teamsRule{}, err
+	}
+
+	var result teamsRule
+	if err := json.Unmarshal(res, &result); err != nil {
+		return teamsRule{}, fmt.Errorf("failed to unmarshal Teams Rule: %w", err)
+	}
+
+	return result, nil
+}
+
+func flattenTeamsRuleSettings(settings *teamsRuleSettingsExtended) []interface{} {
 	return []interface{}{map[string]interface{}{
 		"block_page_enabled":                 settings.BlockPageEnabled,
 		"block_page_reason":                  settings.BlockReason,
@@ -207,10 +271,13 @@ func flattenTeamsRuleSettings(settings *cloudflare.TeamsRuleSettings) []interfac
 		"add_headers":                        flattenTeamsAddHeaders(settings.AddHeaders),
 		"insecure_disable_dnssec_validation": settings.InsecureDisableDNSSECValidation,
 		"egress":                             flattenTeamsEgressSettings(settings.EgressSettings),
+		"untrusted_cert":                     flattenTeamsUntrustedCertSettings(settings.UntrustedCertSettings),
+		"resolve_dns_through_cloudflare":     settings.ResolveDNSThroughCloudflare,
+		"ip_categories":                      settings.IPCategories,
 	}}
 }
 
-func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSettings {
+func inflateTeamsRuleSettings(settings interface{}) *teamsRuleSettingsExtended {
 	settingsList := settings.([]interface{})
 	if len(settingsList) != 1 {
 		return nil
@@ -233,18 +300,26 @@ func inflateTeamsRuleSettings(settings interface{}) *cloudflare.TeamsRuleSetting
 	addHeaders := inflateTeamsAddHeaders(settingsMap["add_headers"].(map[string]interface{}))
 	insecureDisableDNSSECValidation := settingsMap["insecure_disable_dnssec_validation"].(bool)
 	egressSettings := inflateTeamsEgressSettings(settingsMap["egress"].([]interface{}))
-
-	return &cloudflare.TeamsRuleSettings{
-		BlockPageEnabled:                enabled,
-		BlockReason:                     reason,
-		OverrideIPs:                     overrideIPs,
-		OverrideHost:                    overrideHost,
-		L4Override:                      l4Override,
-		BISOAdminControls:               bisoAdminControls,
-		CheckSession:                    checkSessionSettings,
-		AddHeaders:                      addHeaders,
-		InsecureDisableDNSSECValidation: insecureDisableDNSSECValidation,
-		EgressSettings:                  egressSettings,
+	untrustedCertSettings := inflateTeamsUntrustedCertSettings(settingsMap["untrusted_cert"].([]interface{}))
+	resolveDNSThroughCloudflare := settingsMap["resolve_dns_through_cloudflare"].(bool)
+	ipCategories := settingsMap["ip_categories"].(bool)
+
+	return &teamsRuleSettingsExtended{
+		TeamsRuleSettings: cloudflare.TeamsRuleSettings{
+			BlockPageEnabled:                enabled,
+			BlockReason:                     reason,
+			OverrideIPs:                     overrideIPs,
+			OverrideHost:                    overrideHost,
+			L4Override:                      l4Override,
+			BISOAdminControls:               bisoAdminControls,
+			CheckSession:                    checkSessionSettings,
+			AddHeaders:                      addHeaders,
+			InsecureDisableDNSSECValidation: insecureDisableDNSSECValidation,
+			EgressSettings:                  egressSettings,
+		},
+		UntrustedCertSettings:       untrustedCertSettings,
+		ResolveDNSThroughCloudflare: resolveDNSThroughCloudflare,
+		IPCategories:                ipCategories,
 	}
 }
 
@@ -394,6 +469,27 @@ func inflateTeamsEgressSettings(settings interface{}) *cloudflare.EgressSettings
 	}
 }
 
+func flattenTeamsUntrustedCertSettings(settings *teamsUntrustedCertSettings) []interface{} {
+	if settings == nil {
+		return nil
+	}
+	return []interface{}{map[string]interface{}{
+		"action": settings.Action,
+	}}
+}
+
+func inflateTeamsUntrustedCertSettings(settings interface{}) *teamsUntrustedCertSettings {
+	settingsList := settings.([]interface{})
+	if len(settingsList) != 1 {
+		return nil
+	}
+	settingsMap := settingsList[0].(map[string]interface{})
+	action := settingsMap["action"].(string)
+	return &teamsUntrustedCertSettings{
+		Action: action,
+	}
+}
+
 func providerToApiRulePrecedence(provided int64, ruleName string) int64 {
 	return provided*rulePrecedenceFactor + int64(hashCodeString(ruleName))%rulePrecedenceFactor
 }
@@ -2,13 +2,17 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareTeamsRuleBasic(t *testing.T) {
@@ -74,6 +78,100 @@ resource "cloudflare_teams_rule" "%[1]s" {
 `, rnd, accountID)
 }
 
+func TestAccCloudflareTeamsRule_UntrustedCert(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_teams_rule.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareTeamsRuleConfigUntrustedCert(rnd, accountID, "block"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "action", "allow"),
+					resource.TestCheckResourceAttr(name, "filters.0", "http"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.untrusted_cert.0.action", "block"),
+				),
+			},
+			{
+				Config: testAccCloudflareTeamsRuleConfigUntrustedCert(rnd, accountID, "pass_through"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "rule_settings.0.untrusted_cert.0.action", "pass_through"),
+				),
+			},
+			{
+				Config:      testAccCloudflareTeamsRuleConfigUntrustedCert(rnd, accountID, "ignore"),
+				ExpectError: regexp.MustCompile(regexp.QuoteMeta(`expected rule_settings.0.untrusted_cert.0.action to be one of [pass_through block error]`)),
+			},
+		},
+	})
+}
+
+func TestTeamsRuleSettingsRoundTrip(t *testing.T) {
+	settings := &teamsRuleSettingsExtended{
+		TeamsRuleSettings: cloudflare.TeamsRuleSettings{
+			BlockPageEnabled: true,
+			BlockReason:      "cuz",
+		},
+		UntrustedCertSettings:       &teamsUntrustedCertSettings{Action: "error"},
+		ResolveDNSThroughCloudflare: true,
+		IPCategories:                true,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareTeamsRuleSchema(), map[string]interface{}{})
+	assert.NoError(t, d.Set("rule_settings", flattenTeamsRuleSettings(settings)))
+
+	inflated := inflateTeamsRuleSettings(d.Get("rule_settings"))
+
+	assert.Equal(t, settings.BlockPageEnabled, inflated.BlockPageEnabled)
+	assert.Equal(t, settings.BlockReason, inflated.BlockReason)
+	assert.Equal(t, settings.UntrustedCertSettings, inflated.UntrustedCertSettings)
+	assert.Equal(t, settings.ResolveDNSThroughCloudflare, inflated.ResolveDNSThroughCloudflare)
+	assert.Equal(t, settings.IPCategories, inflated.IPCategories)
+
+	body, err := json.Marshal(teamsRule{RuleSettings: *inflated})
+	assert.NoError(t, err)
+
+	var rule teamsRule
+	assert.NoError(t, json.Unmarshal(body, &rule))
+	assert.Equal(t, "error", rule.RuleSettings.UntrustedCertSettings.Action)
+	assert.True(t, rule.RuleSettings.ResolveDNSThroughCloudflare)
+	assert.True(t, rule.RuleSettings.IPCategories)
+	assert.Equal(t, "cuz", rule.RuleSettings.BlockReason)
+}
+
+func testAccCloudflareTeamsRuleConfigUntrustedCert(rnd, accountID, action string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_teams_rule" "%[1]s" {
+  name        = "%[1]s"
+  account_id  = "%[2]s"
+  description = "desc"
+  precedence  = 12303
+  action      = "allow"
+  filters     = ["http"]
+  traffic     = "http.request.uri == \"https://example.com\""
+  rule_settings {
+    untrusted_cert {
+      action = "%[3]s"
+    }
+  }
+}
+`, rnd, accountID, action)
+}
+
 func testAccCheckCloudflareTeamsRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
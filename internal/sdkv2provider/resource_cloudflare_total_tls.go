@@ -27,10 +27,13 @@ func resourceCloudflareTotalTLS() *schema.Resource {
 func resourceCloudflareTotalSSLUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	enabled := d.Get("enabled").(bool)
 	settings := cloudflare.TotalTLS{
-		Enabled: cloudflare.BoolPtr(d.Get("enabled").(bool)),
+		Enabled: cloudflare.BoolPtr(enabled),
 	}
-	if certificateAuthority, ok := d.GetOk("certificate_authority"); ok {
+	// The certificate authority only applies while Total TLS is enabled so
+	// leave it out when turning the feature off.
+	if certificateAuthority, ok := d.GetOk("certificate_authority"); ok && enabled {
 		settings.CertificateAuthority = certificateAuthority.(string)
 	}
 	_, err := client.SetTotalTLS(ctx, cloudflare.ZoneIdentifier(zoneID), settings)
@@ -47,11 +50,17 @@ func resourceCloudflareTotalSSLRead(ctx context.Context, d *schema.ResourceData,
 
 	result, err := client.GetTotalTLS(ctx, cloudflare.ZoneIdentifier(zoneID))
 	if err != nil {
-		return diag.FromErr(fmt.Errorf("error creating updating total TLS: %w", err))
+		return diag.FromErr(fmt.Errorf("error reading total TLS: %w", err))
 	}
 	d.SetId(zoneID)
-	d.Set("enabled", result.Enabled)
-	d.Set("certificate_authority", result.CertificateAuthority)
+	d.Set("enabled", cloudflare.Bool(result.Enabled))
+
+	// A disabled Total TLS setting may not report a certificate authority. Keep
+	// the one from the state in that case rather than showing a change for it.
+	if result.CertificateAuthority != "" || cloudflare.Bool(result.Enabled) {
+		d.Set("certificate_authority", result.CertificateAuthority)
+	}
+
 	return nil
 }
 
@@ -1,21 +1,47 @@
 package sdkv2provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
+	"regexp"
 	"testing"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
 )
 
-func testTotalTLS(rnd, zoneID string) string {
+func testTotalTLS(rnd, zoneID, certificateAuthority string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_total_tls" "%[1]s" {
 	zone_id = "%[2]s"
 	enabled = true
-	certificate_authority = "google"
+	certificate_authority = "%[3]s"
 }
-`, rnd, zoneID)
+`, rnd, zoneID, certificateAuthority)
+}
+
+func TestAccCloudflareTotalTLS_InvalidCertificateAuthority(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTotalTLS(rnd, zoneID, "digicert"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected certificate_authority to be one of`),
+			},
+		},
+	})
 }
 
 func TestAccCloudflareTotalTLS(t *testing.T) {
@@ -28,13 +54,95 @@ func TestAccCloudflareTotalTLS(t *testing.T) {
 		ProviderFactories: providerFactories,
 		Steps: []resource.TestStep{
 			{
-				Config: testTotalTLS(rnd, zoneID),
+				Config: testTotalTLS(rnd, zoneID, "google"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
 					resource.TestCheckResourceAttr(name, "enabled", "true"),
 					resource.TestCheckResourceAttr(name, "certificate_authority", "google"),
 				),
 			},
+			{
+				Config: testTotalTLS(rnd, zoneID, "ssl_com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "certificate_authority", "ssl_com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareTotalTLS_CertificateAuthorityDrift(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_total_tls." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testTotalTLS(rnd, zoneID, "google"),
+				Check:  resource.TestCheckResourceAttr(name, "certificate_authority", "google"),
+			},
+			{
+				PreConfig: func() {
+					client := testAccProvider.Meta().(*cloudflare.API)
+					_, err := client.SetTotalTLS(context.Background(), cloudflare.ZoneIdentifier(zoneID), cloudflare.TotalTLS{
+						Enabled:              cloudflare.BoolPtr(true),
+						CertificateAuthority: "lets_encrypt",
+					})
+					if err != nil {
+						t.Fatalf("failed to change the Total TLS certificate authority: %s", err)
+					}
+				},
+				Config:             testTotalTLS(rnd, zoneID, "google"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testTotalTLS(rnd, zoneID, "google"),
+				Check:  resource.TestCheckResourceAttr(name, "certificate_authority", "google"),
+			},
 		},
 	})
 }
+
+func TestResourceCloudflareTotalTLSRead(t *testing.T) {
+	testCases := map[string]struct {
+		response                     string
+		expectedEnabled              bool
+		expectedCertificateAuthority string
+	}{
+		"certificate authority changed": {
+			response:                     `{"enabled":true,"certificate_authority":"lets_encrypt"}`,
+			expectedEnabled:              true,
+			expectedCertificateAuthority: "lets_encrypt",
+		},
+		"disabled without certificate authority": {
+			response:                     `{"enabled":false}`,
+			expectedEnabled:              false,
+			expectedCertificateAuthority: "google",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				testclient.WriteResult(w, json.RawMessage(tc.response))
+			}))
+
+			d := schema.TestResourceDataRaw(t, resourceCloudflareTotalTLSSchema(), map[string]interface{}{
+				consts.ZoneIDSchemaKey:  "023e105f4ecef8ad9ca31a8372d0c353",
+				"enabled":               true,
+				"certificate_authority": "google",
+			})
+
+			diags := resourceCloudflareTotalSSLRead(context.Background(), d, client)
+
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expectedEnabled, d.Get("enabled"))
+			assert.Equal(t, tc.expectedCertificateAuthority, d.Get("certificate_authority"))
+		})
+	}
+}
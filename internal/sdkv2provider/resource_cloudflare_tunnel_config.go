@@ -2,7 +2,9 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"reflect"
 	"time"
 
@@ -22,12 +24,62 @@ func resourceCloudflareTunnelConfig() *schema.Resource {
 		CreateContext: resourceCloudflareTunnelConfigUpdate,
 		UpdateContext: resourceCloudflareTunnelConfigUpdate,
 		DeleteContext: resourceCloudflareTunnelConfigDelete,
+		CustomizeDiff: resourceCloudflareTunnelConfigValidateSource,
 		Description: heredoc.Doc(`
 			Provides a Cloudflare Tunnel configuration resource.
 		`),
 	}
 }
 
+const (
+	tunnelConfigSourceCloudflare = "cloudflare"
+	tunnelConfigSourceLocal      = "local"
+)
+
+var tunnelConfigSources = []string{tunnelConfigSourceCloudflare, tunnelConfigSourceLocal}
+
+// tunnelWithConfigSource extends cloudflare.Tunnel with the source of its
+// configuration which the API client doesn't expose yet.
+type tunnelWithConfigSource struct {
+	cloudflare.Tunnel
+	ConfigSrc string `json:"config_src,omitempty"`
+}
+
+func resourceCloudflareTunnelConfigValidateSource(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	_, hasConfig := d.GetOk("config")
+
+	switch d.Get("source").(string) {
+	case tunnelConfigSourceLocal:
+		if hasConfig {
+			return fmt.Errorf("config must not be set when source is %q as the tunnel is configured locally", tunnelConfigSourceLocal)
+		}
+	default:
+		if !hasConfig {
+			return fmt.Errorf("config is required when source is %q", tunnelConfigSourceCloudflare)
+		}
+	}
+
+	return nil
+}
+
+func tunnelURI(accountID, tunnelID string) string {
+	return fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accountID, tunnelID)
+}
+
+func getTunnelConfigSource(ctx context.Context, client *cloudflare.API, accountID, tunnelID string) (string, error) {
+	res, err := client.Raw(ctx, http.MethodGet, tunnelURI(accountID, tunnelID), nil, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var tunnel tunnelWithConfigSource
+	if err := json.Unmarshal(res, &tunnel); err != nil {
+		return "", fmt.Errorf("failed to unmarshal tunnel: %w", err)
+	}
+
+	return tunnel.ConfigSrc, nil
+}
+
 func buildTunnelConfig(d *schema.ResourceData) cloudflare.TunnelConfiguration {
 	warpRouting := cloudflare.WarpRoutingConfig{}
 	if item, ok := d.GetOk("config.0.warp_routing.0"); ok {
@@ -127,6 +179,27 @@ func buildTunnelConfig(d *schema.ResourceData) cloudflare.TunnelConfiguration {
 func resourceCloudflareTunnelConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	// The source is only read back from the API for state written before it
+	// existed; otherwise the configured value decides what is managed here.
+	source := d.Get("source").(string)
+	if source == "" {
+		apiSource, err := getTunnelConfigSource(ctx, client, accountID, d.Id())
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error getting tunnel %q: %w", d.Id(), err))
+		}
+		source = tunnelConfigSourceCloudflare
+		if apiSource == tunnelConfigSourceLocal {
+			source = tunnelConfigSourceLocal
+		}
+		d.Set("source", source)
+	}
+
+	if source == tunnelConfigSourceLocal {
+		d.Set("config", nil)
+		return nil
+	}
+
 	result, err := client.GetTunnelConfiguration(ctx, cloudflare.AccountIdentifier(accountID), d.Id())
 	tflog.Debug(ctx, fmt.Sprintf("GetTunnelConfiguration: %+v", result))
 	if err != nil {
@@ -198,6 +271,24 @@ func resourceCloudflareTunnelConfigUpdate(ctx context.Context, d *schema.Resourc
 	client := meta.(*cloudflare.API)
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
 	tunnelID := d.Get("tunnel_id").(string)
+	source := d.Get("source").(string)
+
+	if source == tunnelConfigSourceLocal {
+		// There is no API to hand a remotely managed tunnel back to a local
+		// configuration file, so only check that the tunnel is not already
+		// managed by Cloudflare.
+		apiSource, err := getTunnelConfigSource(ctx, client, accountID, tunnelID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error getting tunnel %q: %w", tunnelID, err))
+		}
+		if apiSource == tunnelConfigSourceCloudflare {
+			return diag.FromErr(fmt.Errorf("tunnel %q is managed by Cloudflare and cannot be switched to source %q, recreate the tunnel instead", tunnelID, tunnelConfigSourceLocal))
+		}
+
+		d.SetId(tunnelID)
+		return resourceCloudflareTunnelConfigRead(ctx, d, meta)
+	}
+
 	tunnel := cloudflare.TunnelConfigurationParams{
 		TunnelID: tunnelID,
 		Config:   buildTunnelConfig(d),
@@ -3,6 +3,7 @@ package sdkv2provider
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -81,6 +82,44 @@ func testTunnelConfigShort(resourceID, accountID, tunnelSecret string) string {
 		`, resourceID, accountID, tunnelSecret)
 }
 
+func testTunnelConfigLocal(resourceID, accountID, tunnelSecret string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_argo_tunnel" "%[1]s" {
+		  account_id = "%[2]s"
+		  name       = "%[1]s"
+		  secret     = "%[3]s"
+		}
+
+		resource "cloudflare_tunnel_config" "%[1]s" {
+		  account_id = "%[2]s"
+		  tunnel_id  = cloudflare_argo_tunnel.%[1]s.id
+		  source     = "local"
+		}
+		`, resourceID, accountID, tunnelSecret)
+}
+
+func testTunnelConfigLocalWithIngressRules(resourceID, accountID, tunnelSecret string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_argo_tunnel" "%[1]s" {
+		  account_id = "%[2]s"
+		  name       = "%[1]s"
+		  secret     = "%[3]s"
+		}
+
+		resource "cloudflare_tunnel_config" "%[1]s" {
+		  account_id = "%[2]s"
+		  tunnel_id  = cloudflare_argo_tunnel.%[1]s.id
+		  source     = "local"
+
+		  config {
+			ingress_rule {
+				service = "https://10.0.0.1:8081"
+			  }
+		  }
+		}
+		`, resourceID, accountID, tunnelSecret)
+}
+
 func TestAccCloudflareTunnelConfig_Full(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_tunnel_config." + rnd
@@ -149,6 +188,7 @@ func TestAccCloudflareTunnelConfig_Short(t *testing.T) {
 			{
 				Config: testTunnelConfigShort(rnd, zoneID, tunnelSecret),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "source", "cloudflare"),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.#", "1"),
 					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.service", "https://10.0.0.1:8081"),
 				),
@@ -156,3 +196,88 @@ func TestAccCloudflareTunnelConfig_Short(t *testing.T) {
 		},
 	})
 }
+
+func TestAccCloudflareTunnelConfig_ZeroTrustAliases(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zero_trust_tunnel_cloudflared_config." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	tunnelSecret := acctest.RandStringFromCharSet(32, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareArgoTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testZeroTrustTunnelCloudflaredConfigShort(rnd, accountID, tunnelSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("cloudflare_zero_trust_tunnel_cloudflared."+rnd, "name", rnd),
+					resource.TestMatchResourceAttr("cloudflare_zero_trust_tunnel_cloudflared."+rnd, "cname", regexp.MustCompile(".*\\.cfargotunnel\\.com")),
+					resource.TestCheckResourceAttrPair(name, "tunnel_id", "cloudflare_zero_trust_tunnel_cloudflared."+rnd, "id"),
+					resource.TestCheckResourceAttr(name, "source", "cloudflare"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.#", "1"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.0.service", "https://10.0.0.1:8081"),
+				),
+			},
+		},
+	})
+}
+
+func testZeroTrustTunnelCloudflaredConfigShort(resourceID, accountID, tunnelSecret string) string {
+	return fmt.Sprintf(`
+		resource "cloudflare_zero_trust_tunnel_cloudflared" "%[1]s" {
+		  account_id = "%[2]s"
+		  name       = "%[1]s"
+		  secret     = "%[3]s"
+		}
+
+		resource "cloudflare_zero_trust_tunnel_cloudflared_config" "%[1]s" {
+		  account_id = "%[2]s"
+		  tunnel_id  = cloudflare_zero_trust_tunnel_cloudflared.%[1]s.id
+
+		  config {
+			ingress_rule {
+				service = "https://10.0.0.1:8081"
+			  }
+		  }
+		}
+		`, resourceID, accountID, tunnelSecret)
+}
+
+func TestAccCloudflareTunnelConfig_LocalSource(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_tunnel_config." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	tunnelSecret := acctest.RandStringFromCharSet(32, acctest.CharSetAlpha)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTunnelConfigLocalWithIngressRules(rnd, accountID, tunnelSecret),
+				ExpectError: regexp.MustCompile(`config must not be set when source is "local"`),
+			},
+			{
+				Config: testTunnelConfigLocal(rnd, accountID, tunnelSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "source", "local"),
+					resource.TestCheckResourceAttr(name, "config.#", "0"),
+				),
+			},
+			{
+				Config: testTunnelConfigShort(rnd, accountID, tunnelSecret),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "source", "cloudflare"),
+					resource.TestCheckResourceAttr(name, "config.0.ingress_rule.#", "1"),
+				),
+			},
+		},
+	})
+}
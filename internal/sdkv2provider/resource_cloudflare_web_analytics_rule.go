@@ -0,0 +1,169 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// webAnalyticsRule includes or excludes traffic of a Web Analytics site from
+// being measured.
+type webAnalyticsRule struct {
+	ID        string   `json:"id,omitempty"`
+	Host      string   `json:"host"`
+	Paths     []string `json:"paths"`
+	Inclusive bool     `json:"inclusive"`
+	IsPaused  bool     `json:"is_paused"`
+}
+
+type webAnalyticsRulesResponse struct {
+	Rules []webAnalyticsRule `json:"rules"`
+}
+
+func resourceCloudflareWebAnalyticsRule() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWebAnalyticsRuleSchema(),
+		CreateContext: resourceCloudflareWebAnalyticsRuleCreate,
+		ReadContext:   resourceCloudflareWebAnalyticsRuleRead,
+		UpdateContext: resourceCloudflareWebAnalyticsRuleUpdate,
+		DeleteContext: resourceCloudflareWebAnalyticsRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWebAnalyticsRuleImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Web Analytics Rule resource.
+		`),
+	}
+}
+
+func resourceCloudflareWebAnalyticsRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Web Analytics Rule for ruleset %q", rulesetID))
+
+	res, err := client.Raw(ctx, http.MethodPost, webAnalyticsRuleURI(accountID, rulesetID, ""), buildWebAnalyticsRule(d), nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Web Analytics Rule for ruleset %q: %w", rulesetID, err))
+	}
+
+	var rule webAnalyticsRule
+	if err := json.Unmarshal(res, &rule); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Web Analytics Rule: %w", err))
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceCloudflareWebAnalyticsRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	// There is no endpoint to fetch a single rule so look it up in the
+	// rules of its ruleset.
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/rum/v2/%s/rules", accountID, rulesetID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading Web Analytics Rules for ruleset %q: %w", rulesetID, err))
+	}
+
+	var rules webAnalyticsRulesResponse
+	if err := json.Unmarshal(res, &rules); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Web Analytics Rules: %w", err))
+	}
+
+	for _, rule := range rules.Rules {
+		if rule.ID != d.Id() {
+			continue
+		}
+
+		d.Set("host", rule.Host)
+		d.Set("paths", rule.Paths)
+		d.Set("inclusive", rule.Inclusive)
+		d.Set("is_paused", rule.IsPaused)
+
+		return nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Web Analytics Rule %s no longer exists", d.Id()))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Web Analytics Rule %q", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodPut, webAnalyticsRuleURI(accountID, rulesetID, d.Id()), buildWebAnalyticsRule(d), nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Web Analytics Rule %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareWebAnalyticsRuleRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	rulesetID := d.Get("ruleset_id").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Web Analytics Rule %q", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, webAnalyticsRuleURI(accountID, rulesetID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Web Analytics Rule %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsRuleImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/rulesetID/ruleID\"", d.Id())
+	}
+
+	accountID, rulesetID, ruleID := attributes[0], attributes[1], attributes[2]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Web Analytics Rule: id %s for ruleset %s", ruleID, rulesetID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("ruleset_id", rulesetID)
+	d.SetId(ruleID)
+
+	resourceCloudflareWebAnalyticsRuleRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildWebAnalyticsRule(d *schema.ResourceData) webAnalyticsRule {
+	return webAnalyticsRule{
+		Host:      d.Get("host").(string),
+		Paths:     expandInterfaceToStringList(d.Get("paths")),
+		Inclusive: d.Get("inclusive").(bool),
+		IsPaused:  d.Get("is_paused").(bool),
+	}
+}
+
+func webAnalyticsRuleURI(accountID, rulesetID, ruleID string) string {
+	uri := fmt.Sprintf("/accounts/%s/rum/v2/%s/rule", accountID, rulesetID)
+	if ruleID != "" {
+		uri = fmt.Sprintf("%s/%s", uri, ruleID)
+	}
+	return uri
+}
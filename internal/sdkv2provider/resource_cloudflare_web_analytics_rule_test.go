@@ -0,0 +1,74 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareWebAnalyticsRule_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_web_analytics_rule." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+	host := rnd + "." + domain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWebAnalyticsSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWebAnalyticsRuleConfig(rnd, accountID, host, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(name, "ruleset_id", "cloudflare_web_analytics_site."+rnd, "ruleset_id"),
+					resource.TestCheckResourceAttr(name, "host", host),
+					resource.TestCheckResourceAttr(name, "paths.#", "1"),
+					resource.TestCheckResourceAttr(name, "paths.0", "/excluded"),
+					resource.TestCheckResourceAttr(name, "inclusive", "false"),
+					resource.TestCheckResourceAttr(name, "is_paused", "false"),
+				),
+			},
+			{
+				Config: testAccCloudflareWebAnalyticsRuleConfig(rnd, accountID, host, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "is_paused", "true"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs := s.RootModule().Resources[name]
+					return fmt.Sprintf("%s/%s/%s", accountID, rs.Primary.Attributes["ruleset_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccCloudflareWebAnalyticsRuleConfig(rnd, accountID, host string, isPaused bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_web_analytics_site" "%[1]s" {
+  account_id   = "%[2]s"
+  host         = "%[3]s"
+  auto_install = false
+}
+
+resource "cloudflare_web_analytics_rule" "%[1]s" {
+  account_id = "%[2]s"
+  ruleset_id = cloudflare_web_analytics_site.%[1]s.ruleset_id
+  host       = "%[3]s"
+  paths      = ["/excluded"]
+  inclusive  = false
+  is_paused  = %[4]t
+}
+`, rnd, accountID, host, isPaused)
+}
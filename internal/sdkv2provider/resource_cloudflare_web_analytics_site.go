@@ -0,0 +1,211 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// webAnalyticsSite is a site measured by Web Analytics.
+type webAnalyticsSite struct {
+	SiteTag     string                   `json:"site_tag,omitempty"`
+	SiteToken   string                   `json:"site_token,omitempty"`
+	Host        string                   `json:"host,omitempty"`
+	ZoneTag     string                   `json:"zone_tag,omitempty"`
+	AutoInstall bool                     `json:"auto_install"`
+	Snippet     string                   `json:"snippet,omitempty"`
+	Ruleset     *webAnalyticsSiteRuleset `json:"ruleset,omitempty"`
+	Rules       []webAnalyticsRule       `json:"rules,omitempty"`
+}
+
+type webAnalyticsSiteRuleset struct {
+	ID      string `json:"id"`
+	ZoneTag string `json:"zone_tag"`
+}
+
+func resourceCloudflareWebAnalyticsSite() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWebAnalyticsSiteSchema(),
+		CreateContext: resourceCloudflareWebAnalyticsSiteCreate,
+		ReadContext:   resourceCloudflareWebAnalyticsSiteRead,
+		UpdateContext: resourceCloudflareWebAnalyticsSiteUpdate,
+		DeleteContext: resourceCloudflareWebAnalyticsSiteDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWebAnalyticsSiteImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Web Analytics Site resource.
+		`),
+	}
+}
+
+func resourceCloudflareWebAnalyticsSiteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Web Analytics Site for account %q", accountID))
+
+	site, err := writeWebAnalyticsSite(ctx, client, http.MethodPost, webAnalyticsSiteURI(accountID, ""), buildWebAnalyticsSite(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Web Analytics Site for account %q: %w", accountID, err))
+	}
+
+	d.SetId(site.SiteTag)
+
+	return resourceCloudflareWebAnalyticsSiteRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsSiteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, webAnalyticsSiteURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Web Analytics Site %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	var site webAnalyticsSite
+	if err := json.Unmarshal(res, &site); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	d.Set("site_tag", site.SiteTag)
+	d.Set("site_token", site.SiteToken)
+	d.Set("snippet", webAnalyticsSiteSnippet(site))
+	d.Set("auto_install", site.AutoInstall)
+	d.Set("host", site.Host)
+	if site.Ruleset != nil {
+		d.Set("ruleset_id", site.Ruleset.ID)
+		d.Set("zone_tag", site.Ruleset.ZoneTag)
+	}
+	if err := d.Set("rules", flattenWebAnalyticsSiteRules(site.Rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set rules: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsSiteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Web Analytics Site %q", d.Id()))
+
+	if _, err := writeWebAnalyticsSite(ctx, client, http.MethodPut, webAnalyticsSiteURI(accountID, d.Id()), buildWebAnalyticsSite(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareWebAnalyticsSiteRead(ctx, d, meta)
+}
+
+func resourceCloudflareWebAnalyticsSiteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Web Analytics Site %q", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, webAnalyticsSiteURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Web Analytics Site %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWebAnalyticsSiteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/siteTag\"", d.Id())
+	}
+
+	accountID, siteTag := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Web Analytics Site: id %s for account %s", siteTag, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(siteTag)
+
+	resourceCloudflareWebAnalyticsSiteRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func buildWebAnalyticsSite(d *schema.ResourceData) webAnalyticsSite {
+	site := webAnalyticsSite{
+		AutoInstall: d.Get("auto_install").(bool),
+	}
+
+	// The API fills in the host of orange-clouded sites so the zone tag takes
+	// precedence whenever it is known.
+	if zoneTag, ok := d.GetOk("zone_tag"); ok {
+		site.ZoneTag = zoneTag.(string)
+	} else {
+		site.Host = d.Get("host").(string)
+	}
+
+	return site
+}
+
+// webAnalyticsSiteSnippet returns the beacon tag to embed for the site. The
+// snippet is derived from the site token when the API doesn't return one so
+// that it always follows the current token.
+func webAnalyticsSiteSnippet(site webAnalyticsSite) string {
+	if site.Snippet != "" || site.SiteToken == "" {
+		return site.Snippet
+	}
+
+	return fmt.Sprintf(`<!-- Cloudflare Web Analytics --><script defer src='https://static.cloudflareinsights.com/beacon.min.js' data-cf-beacon='{"token": "%s"}'></script><!-- End Cloudflare Web Analytics -->`, site.SiteToken)
+}
+
+func flattenWebAnalyticsSiteRules(rules []webAnalyticsRule) []map[string]interface{} {
+	flattened := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		flattened = append(flattened, map[string]interface{}{
+			"id":        rule.ID,
+			"host":      rule.Host,
+			"paths":     rule.Paths,
+			"inclusive": rule.Inclusive,
+			"is_paused": rule.IsPaused,
+		})
+	}
+
+	return flattened
+}
+
+func writeWebAnalyticsSite(ctx context.Context, client *cloudflare.API, method, uri string, site webAnalyticsSite) (webAnalyticsSite, error) {
+	var result webAnalyticsSite
+	res, err := client.Raw(ctx, method, uri, site, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal Web Analytics Site: %w", err)
+	}
+
+	return result, nil
+}
+
+func webAnalyticsSiteURI(accountID, siteTag string) string {
+	uri := fmt.Sprintf("/accounts/%s/rum/site_info", accountID)
+	if siteTag != "" {
+		uri = fmt.Sprintf("%s/%s", uri, siteTag)
+	}
+	return uri
+}
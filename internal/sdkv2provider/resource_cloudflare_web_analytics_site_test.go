@@ -0,0 +1,123 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareWebAnalyticsSite_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_web_analytics_site." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	domain := os.Getenv("CLOUDFLARE_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWebAnalyticsSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWebAnalyticsSiteConfig(rnd, accountID, rnd+"."+domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "host", rnd+"."+domain),
+					resource.TestCheckResourceAttr(name, "auto_install", "false"),
+					resource.TestCheckResourceAttrSet(name, "site_tag"),
+					resource.TestCheckResourceAttrSet(name, "site_token"),
+					resource.TestCheckResourceAttrSet(name, "snippet"),
+					testAccCheckCloudflareWebAnalyticsSiteSnippetHasToken(name),
+					resource.TestCheckResourceAttrSet(name, "ruleset_id"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestWebAnalyticsSiteSnippet(t *testing.T) {
+	testCases := map[string]struct {
+		site     webAnalyticsSite
+		expected string
+	}{
+		"returned by the API": {
+			site:     webAnalyticsSite{SiteToken: "6e4a2ee7d1b9463d8fb5a8ab12f5c7f4", Snippet: "<script data-cf-beacon='{\"token\": \"6e4a2ee7d1b9463d8fb5a8ab12f5c7f4\"}'></script>"},
+			expected: "<script data-cf-beacon='{\"token\": \"6e4a2ee7d1b9463d8fb5a8ab12f5c7f4\"}'></script>",
+		},
+		"derived from the token": {
+			site:     webAnalyticsSite{SiteToken: "6e4a2ee7d1b9463d8fb5a8ab12f5c7f4"},
+			expected: `<!-- Cloudflare Web Analytics --><script defer src='https://static.cloudflareinsights.com/beacon.min.js' data-cf-beacon='{"token": "6e4a2ee7d1b9463d8fb5a8ab12f5c7f4"}'></script><!-- End Cloudflare Web Analytics -->`,
+		},
+		"no token": {
+			site:     webAnalyticsSite{},
+			expected: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			snippet := webAnalyticsSiteSnippet(tc.site)
+			assert.Equal(t, tc.expected, snippet)
+			assert.Contains(t, snippet, tc.site.SiteToken)
+		})
+	}
+}
+
+func testAccCheckCloudflareWebAnalyticsSiteSnippetHasToken(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		token := rs.Primary.Attributes["site_token"]
+		if !strings.Contains(rs.Primary.Attributes["snippet"], token) {
+			return fmt.Errorf("snippet %q does not contain the site token %q", rs.Primary.Attributes["snippet"], token)
+		}
+
+		return nil
+	}
+}
+
+func testAccCloudflareWebAnalyticsSiteConfig(rnd, accountID, host string, autoInstall bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_web_analytics_site" "%[1]s" {
+  account_id   = "%[2]s"
+  host         = "%[3]s"
+  auto_install = %[4]t
+}
+`, rnd, accountID, host, autoInstall)
+}
+
+func testAccCheckCloudflareWebAnalyticsSiteDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_web_analytics_site" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, webAnalyticsSiteURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Web Analytics Site still exists")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWorkerSecret() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkerSecretSchema(),
+		CreateContext: resourceCloudflareWorkerSecretCreate,
+		ReadContext:   resourceCloudflareWorkerSecretRead,
+		UpdateContext: resourceCloudflareWorkerSecretUpdate,
+		DeleteContext: resourceCloudflareWorkerSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkerSecretImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Worker secret resource. Secrets are encrypted
+			environment bindings of a Worker script that can be managed
+			without uploading the script again.
+		`),
+	}
+}
+
+func resourceCloudflareWorkerSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	scriptName := d.Get("script_name").(string)
+	name := d.Get("name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Creating Cloudflare Worker secret %q for script %q", name, scriptName))
+
+	if err := setWorkerSecret(ctx, client, accountID, scriptName, name, d.Get("secret_text").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating worker secret %q: %w", name, err))
+	}
+
+	d.SetId(workerSecretID(accountID, scriptName, name))
+
+	return resourceCloudflareWorkerSecretRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkerSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	scriptName := d.Get("script_name").(string)
+	name := d.Get("name").(string)
+
+	secrets, err := client.ListWorkersSecrets(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.ListWorkersSecretsParams{
+		ScriptName: scriptName,
+	})
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Worker script %q no longer exists", scriptName))
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("error listing worker secrets for script %q: %w", scriptName, err))
+	}
+
+	for _, secret := range secrets.Result {
+		if secret.Name == name {
+			// The API never returns the secret text so the value in state is
+			// left untouched.
+			return nil
+		}
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Worker secret %q of script %q no longer exists", name, scriptName))
+	d.SetId("")
+
+	return nil
+}
+
+func resourceCloudflareWorkerSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	scriptName := d.Get("script_name").(string)
+	name := d.Get("name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Updating Cloudflare Worker secret %q for script %q", name, scriptName))
+
+	if err := setWorkerSecret(ctx, client, accountID, scriptName, name, d.Get("secret_text").(string)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating worker secret %q: %w", name, err))
+	}
+
+	return resourceCloudflareWorkerSecretRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkerSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	scriptName := d.Get("script_name").(string)
+	name := d.Get("name").(string)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting Cloudflare Worker secret %q for script %q", name, scriptName))
+
+	_, err := client.DeleteWorkersSecret(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.DeleteWorkersSecretParams{
+		ScriptName: scriptName,
+		SecretName: name,
+	})
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			return nil
+		}
+
+		return diag.FromErr(fmt.Errorf("error deleting worker secret %q: %w", name, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkerSecretImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 3)
+
+	if len(attributes) != 3 {
+		return nil, fmt.Errorf(`invalid id (%q) specified, should be in format "accountID/scriptName/secretName"`, d.Id())
+	}
+
+	accountID, scriptName, name := attributes[0], attributes[1], attributes[2]
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("script_name", scriptName)
+	d.Set("name", name)
+	d.SetId(workerSecretID(accountID, scriptName, name))
+
+	resourceCloudflareWorkerSecretRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// setWorkerSecret creates or replaces a secret of a Worker script. Request
+// dumps of the API client are disabled for the call so that the secret text
+// never ends up in the debug logs.
+func setWorkerSecret(ctx context.Context, client *cloudflare.API, accountID, scriptName, name, text string) error {
+	_, err := quietClient(client).SetWorkersSecret(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.SetWorkersSecretParams{
+		ScriptName: scriptName,
+		Secret: &cloudflare.WorkersPutSecretRequest{
+			Name: name,
+			Text: text,
+			Type: cloudflare.WorkerSecretTextBindingType,
+		},
+	})
+
+	return err
+}
+
+func workerSecretID(accountID, scriptName, name string) string {
+	return stringChecksum(fmt.Sprintf("%s/%s/%s", accountID, scriptName, name))
+}
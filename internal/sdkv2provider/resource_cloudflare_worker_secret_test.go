@@ -0,0 +1,120 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareWorkerSecret_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_worker_secret.%s", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkerSecretConfig(rnd, accountID, "first-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerSecretExists(name),
+					resource.TestCheckResourceAttr(name, "script_name", rnd),
+					resource.TestCheckResourceAttr(name, "name", "MY_SECRET"),
+					resource.TestCheckResourceAttr(name, "secret_text", "first-secret"),
+				),
+			},
+			{
+				Config: testAccCloudflareWorkerSecretConfig(rnd, accountID, "second-secret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerSecretExists(name),
+					resource.TestCheckResourceAttr(name, "secret_text", "second-secret"),
+				),
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateId:           fmt.Sprintf("%s/%s/MY_SECRET", accountID, rnd),
+				ImportStateVerifyIgnore: []string{"secret_text"},
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkerSecretConfig(rnd, accountID, secret string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  content    = "%[4]s"
+}
+
+resource "cloudflare_worker_secret" "%[1]s" {
+  account_id  = "%[2]s"
+  script_name = cloudflare_worker_script.%[1]s.name
+  name        = "MY_SECRET"
+  secret_text = "%[3]s"
+}
+`, rnd, accountID, secret, scriptContent1)
+}
+
+func testAccCheckCloudflareWorkerSecretExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*cloudflare.API)
+		secrets, err := client.ListWorkersSecrets(context.Background(), cloudflare.AccountIdentifier(rs.Primary.Attributes["account_id"]), cloudflare.ListWorkersSecretsParams{
+			ScriptName: rs.Primary.Attributes["script_name"],
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, secret := range secrets.Result {
+			if secret.Name == rs.Primary.Attributes["name"] {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("worker secret %s not found", rs.Primary.Attributes["name"])
+	}
+}
+
+func testAccCheckCloudflareWorkerSecretDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_worker_secret" {
+			continue
+		}
+
+		secrets, err := client.ListWorkersSecrets(context.Background(), cloudflare.AccountIdentifier(rs.Primary.Attributes["account_id"]), cloudflare.ListWorkersSecretsParams{
+			ScriptName: rs.Primary.Attributes["script_name"],
+		})
+		if err != nil {
+			// The script itself has been removed along with its secrets.
+			continue
+		}
+
+		for _, secret := range secrets.Result {
+			if secret.Name == rs.Primary.Attributes["name"] {
+				return fmt.Errorf("worker secret %s still exists", secret.Name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// workersForPlatformsDispatchNamespace is a namespace that customer Worker
+// scripts are uploaded to and dispatched from in Workers for Platforms.
+type workersForPlatformsDispatchNamespace struct {
+	NamespaceID   string `json:"namespace_id,omitempty"`
+	NamespaceName string `json:"namespace_name,omitempty"`
+	CreatedOn     string `json:"created_on,omitempty"`
+	ScriptCount   int    `json:"script_count,omitempty"`
+}
+
+func resourceCloudflareWorkersForPlatformsDispatchNamespace() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareWorkersForPlatformsDispatchNamespaceSchema(),
+		CreateContext: resourceCloudflareWorkersForPlatformsDispatchNamespaceCreate,
+		ReadContext:   resourceCloudflareWorkersForPlatformsDispatchNamespaceRead,
+		DeleteContext: resourceCloudflareWorkersForPlatformsDispatchNamespaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareWorkersForPlatformsDispatchNamespaceImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Workers for Platforms dispatch namespace
+			resource. Dispatch namespaces hold the Worker scripts of your
+			customers which are invoked from a dispatch Worker.
+		`),
+	}
+}
+
+func resourceCloudflareWorkersForPlatformsDispatchNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+	name := d.Get("name").(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Workers for Platforms dispatch namespace %q", name))
+
+	body := map[string]string{"name": name}
+	if _, err := client.Raw(ctx, http.MethodPost, workersForPlatformsDispatchNamespaceURI(accountID, ""), body, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating dispatch namespace %q for account %q: %w", name, accountID, err))
+	}
+
+	d.SetId(name)
+
+	return resourceCloudflareWorkersForPlatformsDispatchNamespaceRead(ctx, d, meta)
+}
+
+func resourceCloudflareWorkersForPlatformsDispatchNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, workersForPlatformsDispatchNamespaceURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Dispatch namespace %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding dispatch namespace %q: %w", d.Id(), err))
+	}
+
+	var namespace workersForPlatformsDispatchNamespace
+	if err := json.Unmarshal(res, &namespace); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal dispatch namespace: %w", err))
+	}
+
+	d.Set("name", namespace.NamespaceName)
+	d.Set("namespace_id", namespace.NamespaceID)
+	d.Set("created_on", namespace.CreatedOn)
+	d.Set("scripts_count", namespace.ScriptCount)
+
+	return nil
+}
+
+func resourceCloudflareWorkersForPlatformsDispatchNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Workers for Platforms dispatch namespace: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, workersForPlatformsDispatchNamespaceURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting dispatch namespace %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareWorkersForPlatformsDispatchNamespaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/namespaceName\"", d.Id())
+	}
+
+	accountID, name := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Workers for Platforms dispatch namespace %s for account %s", name, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(name)
+
+	resourceCloudflareWorkersForPlatformsDispatchNamespaceRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func workersForPlatformsDispatchNamespaceURI(accountID, name string) string {
+	uri := fmt.Sprintf("/accounts/%s/workers/dispatch/namespaces", accountID)
+	if name != "" {
+		uri = fmt.Sprintf("%s/%s", uri, name)
+	}
+	return uri
+}
@@ -0,0 +1,73 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareWorkersForPlatformsDispatchNamespace_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_workers_for_platforms_dispatch_namespace.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkersForPlatformsDispatchNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareWorkersForPlatformsDispatchNamespaceConfig(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "id", rnd),
+					resource.TestCheckResourceAttr(name, "scripts_count", "0"),
+					resource.TestCheckResourceAttrSet(name, "namespace_id"),
+					resource.TestCheckResourceAttrSet(name, "created_on"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func testAccCloudflareWorkersForPlatformsDispatchNamespaceConfig(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_workers_for_platforms_dispatch_namespace" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+}
+`, rnd, accountID)
+}
+
+func testAccCheckCloudflareWorkersForPlatformsDispatchNamespaceDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_workers_for_platforms_dispatch_namespace" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, workersForPlatformsDispatchNamespaceURI(rs.Primary.Attributes["account_id"], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("dispatch namespace still exists")
+		}
+	}
+
+	return nil
+}
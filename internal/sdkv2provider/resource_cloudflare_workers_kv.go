@@ -2,7 +2,10 @@ package sdkv2provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"strings"
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
@@ -23,7 +26,8 @@ func resourceCloudflareWorkerKV() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceCloudflareWorkersKVImport,
 		},
-		Description: "Provides a resource to manage a Cloudflare Workers KV Pair.",
+		CustomizeDiff: resourceCloudflareWorkersKVValueFileDiff,
+		Description:   "Provides a resource to manage a Cloudflare Workers KV Pair.",
 	}
 }
 
@@ -53,7 +57,15 @@ func resourceCloudflareWorkersKVRead(ctx context.Context, d *schema.ResourceData
 	}
 
 	d.Set("account_id", accountID)
-	d.Set("value", string(value))
+
+	// Values sourced from a file are tracked by their hash so that large or
+	// binary values don't end up in the state.
+	if d.Get("value_file").(string) != "" {
+		d.Set("value_file_sha256", workersKVValueHash(value))
+	} else {
+		d.Set("value", string(value))
+	}
+
 	return nil
 }
 
@@ -61,7 +73,15 @@ func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceDa
 	client := meta.(*cloudflare.API)
 	namespaceID := d.Get("namespace_id").(string)
 	key := d.Get("key").(string)
-	value := d.Get("value").(string)
+	value := []byte(d.Get("value").(string))
+
+	if path := d.Get("value_file").(string); path != "" {
+		var err error
+		value, err = os.ReadFile(path)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading workers kv value file %q: %w", path, err))
+		}
+	}
 
 	accountID := d.Get(consts.AccountIDSchemaKey).(string)
 	if accountID == "" {
@@ -71,7 +91,7 @@ func resourceCloudflareWorkersKVUpdate(ctx context.Context, d *schema.ResourceDa
 	_, err := client.WriteWorkersKVEntry(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.WriteWorkersKVEntryParams{
 		NamespaceID: namespaceID,
 		Key:         key,
-		Value:       []byte(value),
+		Value:       value,
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating workers kv"))
@@ -123,6 +143,35 @@ func resourceCloudflareWorkersKVImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceCloudflareWorkersKVValueFileDiff plans an update whenever the
+// content of value_file no longer matches the hash in the state.
+func resourceCloudflareWorkersKVValueFileDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("value_file") {
+		return d.SetNewComputed("value_file_sha256")
+	}
+
+	path := d.Get("value_file").(string)
+	if path == "" {
+		return nil
+	}
+
+	value, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("error reading workers kv value file %q: %w", path, err)
+	}
+
+	if hash := workersKVValueHash(value); hash != d.Get("value_file_sha256").(string) {
+		return d.SetNew("value_file_sha256", hash)
+	}
+
+	return nil
+}
+
+func workersKVValueHash(value []byte) string {
+	sum := sha256.Sum256(value)
+	return hex.EncodeToString(sum[:])
+}
+
 func parseId(id string) (string, string, error) {
 	parts := strings.SplitN(id, "/", 2)
 	if len(parts) != 2 {
@@ -85,6 +85,7 @@ func resourceCloudflareWorkersKVNamespaceRead(ctx context.Context, d *schema.Res
 	}
 
 	d.Set("account_id", accountID)
+	d.Set("title", namespace.Title)
 
 	return nil
 }
@@ -97,6 +98,10 @@ func resourceCloudflareWorkersKVNamespaceUpdate(ctx context.Context, d *schema.R
 		accountID = client.AccountID
 	}
 
+	// Renaming a namespace keeps its ID so the keys it holds and the Workers
+	// bound to it are unaffected.
+	tflog.Debug(ctx, fmt.Sprintf("Renaming Cloudflare Workers KV Namespace %s to %q", d.Id(), d.Get("title").(string)))
+
 	_, err := client.UpdateWorkersKVNamespace(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.UpdateWorkersKVNamespaceParams{
 		NamespaceID: d.Id(),
 		Title:       d.Get("title").(string),
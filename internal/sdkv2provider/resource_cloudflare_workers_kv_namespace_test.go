@@ -31,6 +31,44 @@ func TestAccCloudflareWorkersKVNamespace_Basic(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkersKVNamespace_Rename(t *testing.T) {
+	rnd := generateRandomResourceName()
+	resourceName := "cloudflare_workers_kv_namespace." + rnd
+	key := generateRandomResourceName()
+	var namespaceID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCloudflareWorkersKVNamespaceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkersKVNamespaceWithKey(rnd, rnd, key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", rnd),
+					func(s *terraform.State) error {
+						namespaceID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCheckCloudflareWorkersKVNamespaceWithKey(rnd, rnd+"-renamed", key),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", rnd+"-renamed"),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[resourceName].Primary.ID; id != namespaceID {
+							return fmt.Errorf("namespace was recreated: ID changed from %s to %s", namespaceID, id)
+						}
+						return nil
+					},
+					testAccCheckCloudflareWorkersKVExists(key, &cloudflare.WorkersKVPair{}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareWorkersKVNamespaceDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -93,3 +131,16 @@ func testAccCheckCloudflareWorkersKVNamespaceExists(title string, namespace *clo
 		return fmt.Errorf("namespace not found")
 	}
 }
+
+func testAccCheckCloudflareWorkersKVNamespaceWithKey(rName, title, key string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_workers_kv_namespace" "%[1]s" {
+	title = "%[2]s"
+}
+
+resource "cloudflare_workers_kv" "%[1]s" {
+	namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+	key = "%[3]s"
+	value = "%[1]s"
+}`, rName, title, key)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -108,6 +109,52 @@ func TestAccCloudflareWorkersKV_WithAccountID(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkersKV_ValueFile(t *testing.T) {
+	var kvPair cloudflare.WorkersKVPair
+	name := generateRandomResourceName()
+	key := generateRandomResourceName()
+	resourceName := "cloudflare_workers_kv." + name
+	path := filepath.Join(t.TempDir(), "value.bin")
+	value := []byte{0x00, 0xff, 0x10, 0x80, 'k', 'v'}
+	updatedValue := append(value, 0xfe)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCloudflareWorkersKVDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(path, value, 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckCloudflareWorkersKVValueFile(name, key, path),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkersKVExists(key, &kvPair),
+					resource.TestCheckNoResourceAttr(resourceName, "value"),
+					resource.TestCheckResourceAttr(resourceName, "value_file", path),
+					resource.TestCheckResourceAttr(resourceName, "value_file_sha256", workersKVValueHash(value)),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := os.WriteFile(path, updatedValue, 0o600); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckCloudflareWorkersKVValueFile(name, key, path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value_file_sha256", workersKVValueHash(updatedValue)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCloudflareWorkersKVDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*cloudflare.API)
 
@@ -181,3 +228,12 @@ func testAccCheckCloudflareWorkersKVExists(key string, kv *cloudflare.WorkersKVP
 		return nil
 	}
 }
+
+func testAccCheckCloudflareWorkersKVValueFile(rName, key, path string) string {
+	return testAccCheckCloudflareWorkersKVNamespace(rName) + fmt.Sprintf(`
+resource "cloudflare_workers_kv" "%[1]s" {
+	namespace_id = cloudflare_workers_kv_namespace.%[1]s.id
+	key = "%[2]s"
+	value_file = "%[3]s"
+}`, rName, key, path)
+}
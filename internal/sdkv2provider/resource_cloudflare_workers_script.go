@@ -1,10 +1,16 @@
 package sdkv2provider
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc/v2"
@@ -16,6 +22,8 @@ import (
 	"github.com/pkg/errors"
 )
 
+const workerD1DatabaseBindingType = "d1"
+
 func resourceCloudflareWorkerScript() *schema.Resource {
 	return &schema.Resource{
 		Schema:        resourceCloudflareWorkerScriptSchema(),
@@ -54,6 +62,11 @@ func getScriptData(d *schema.ResourceData, client *cloudflare.API) (ScriptData,
 
 type ScriptBindings map[string]cloudflare.WorkerBinding
 
+// D1DatabaseBindings maps binding names to D1 database IDs. The API client
+// has no binding type for D1 databases so they are tracked separately from
+// ScriptBindings and serialized by uploadWorkerScript.
+type D1DatabaseBindings map[string]string
+
 func getWorkerScriptBindings(ctx context.Context, accountId, scriptName string, client *cloudflare.API) (ScriptBindings, error) {
 	resp, err := client.ListWorkerBindings(ctx, cloudflare.AccountIdentifier(accountId), cloudflare.ListWorkerBindingsParams{ScriptName: scriptName})
 	if err != nil {
@@ -120,6 +133,227 @@ func parseWorkerBindings(d *schema.ResourceData, bindings ScriptBindings) {
 			Dataset: data["dataset"].(string),
 		}
 	}
+
+	for _, rawData := range d.Get("queue_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		bindings[data["binding"].(string)] = cloudflare.WorkerQueueBinding{
+			Binding: data["binding"].(string),
+			Queue:   data["queue"].(string),
+		}
+	}
+}
+
+// inheritUnmanagedWorkerSecrets keeps secrets which were set outside of this
+// resource, for example with cloudflare_worker_secret, when the script is
+// uploaded again. Uploading a script replaces all of its bindings so these
+// would otherwise be removed. Secrets previously defined on this resource are
+// not inherited so that removing them from the configuration deletes them.
+func inheritUnmanagedWorkerSecrets(ctx context.Context, d *schema.ResourceData, client *cloudflare.API, accountID string, bindings ScriptBindings) error {
+	existing, err := getWorkerScriptBindings(ctx, accountID, d.Get("name").(string), client)
+	if err != nil {
+		return err
+	}
+
+	previous := make(map[string]bool)
+	old, _ := d.GetChange("secret_text_binding")
+	for _, rawData := range old.(*schema.Set).List() {
+		previous[rawData.(map[string]interface{})["name"].(string)] = true
+	}
+
+	for name, binding := range existing {
+		if _, ok := binding.(cloudflare.WorkerSecretTextBinding); !ok {
+			continue
+		}
+		if _, ok := bindings[name]; ok || previous[name] {
+			continue
+		}
+		bindings[name] = cloudflare.WorkerInheritBinding{}
+	}
+
+	return nil
+}
+
+func parseWorkerD1DatabaseBindings(d *schema.ResourceData) D1DatabaseBindings {
+	bindings := make(D1DatabaseBindings)
+
+	for _, rawData := range d.Get("d1_database_binding").(*schema.Set).List() {
+		data := rawData.(map[string]interface{})
+		bindings[data["name"].(string)] = data["database_id"].(string)
+	}
+
+	return bindings
+}
+
+func getWorkerScriptD1DatabaseBindings(ctx context.Context, accountId, scriptName string, client *cloudflare.API) (D1DatabaseBindings, error) {
+	res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("/accounts/%s/workers/scripts/%s/bindings", accountId, scriptName), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list script bindings: %w", err)
+	}
+
+	var list []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+		ID   string `json:"id"`
+	}
+	if err := json.Unmarshal(res, &list); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal script bindings: %w", err)
+	}
+
+	bindings := make(D1DatabaseBindings)
+	for _, b := range list {
+		if b.Type == workerD1DatabaseBindingType {
+			bindings[b.Name] = b.ID
+		}
+	}
+
+	return bindings, nil
+}
+
+type workerScriptUploadParams struct {
+	ScriptName         string
+	Script             string
+	Module             bool
+	Bindings           ScriptBindings
+	D1DatabaseBindings D1DatabaseBindings
+}
+
+// uploadWorkerScript uploads the script and its bindings as the multipart
+// form expected by the script upload API. This mirrors what the API client
+// does in UploadWorker but also supports binding types the client doesn't
+// know about yet.
+func uploadWorkerScript(ctx context.Context, client *cloudflare.API, accountID string, params workerScriptUploadParams) error {
+	contentType, body, err := buildWorkerScriptMultipartBody(params)
+	if err != nil {
+		return err
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	_, err = client.Raw(ctx, http.MethodPut, fmt.Sprintf("/accounts/%s/workers/scripts/%s", accountID, params.ScriptName), body, headers)
+	return err
+}
+
+func buildWorkerScriptMultipartBody(params workerScriptUploadParams) (string, []byte, error) {
+	var buf bytes.Buffer
+	mpw := multipart.NewWriter(&buf)
+
+	meta := struct {
+		BodyPart   string                   `json:"body_part,omitempty"`
+		MainModule string                   `json:"main_module,omitempty"`
+		Bindings   []map[string]interface{} `json:"bindings"`
+	}{
+		Bindings: make([]map[string]interface{}, 0, len(params.Bindings)+len(params.D1DatabaseBindings)),
+	}
+
+	scriptPartName := "script"
+	if params.Module {
+		scriptPartName = "worker.mjs"
+		meta.MainModule = scriptPartName
+	} else {
+		meta.BodyPart = scriptPartName
+	}
+
+	// WebAssembly modules are sent in their own part which is referenced
+	// from the binding metadata.
+	wasmParts := make(map[string]io.Reader)
+
+	for name, binding := range params.Bindings {
+		bindingMeta := map[string]interface{}{
+			"name": name,
+			"type": binding.Type(),
+		}
+
+		switch v := binding.(type) {
+		case cloudflare.WorkerKvNamespaceBinding:
+			bindingMeta["namespace_id"] = v.NamespaceID
+		case cloudflare.WorkerPlainTextBinding:
+			bindingMeta["text"] = v.Text
+		case cloudflare.WorkerSecretTextBinding:
+			bindingMeta["text"] = v.Text
+		case cloudflare.WorkerWebAssemblyBinding:
+			partName := fmt.Sprintf("wasm_%s", name)
+			bindingMeta["part"] = partName
+			wasmParts[partName] = v.Module
+		case cloudflare.WorkerServiceBinding:
+			bindingMeta["service"] = v.Service
+			if v.Environment != nil {
+				bindingMeta["environment"] = *v.Environment
+			}
+		case cloudflare.WorkerR2BucketBinding:
+			bindingMeta["bucket_name"] = v.BucketName
+		case cloudflare.WorkerAnalyticsEngineBinding:
+			bindingMeta["dataset"] = v.Dataset
+		case cloudflare.WorkerQueueBinding:
+			bindingMeta["name"] = v.Binding
+			bindingMeta["queue_name"] = v.Queue
+		case cloudflare.WorkerInheritBinding:
+			if v.OldName != "" {
+				bindingMeta["old_name"] = v.OldName
+			}
+		default:
+			return "", nil, fmt.Errorf("unsupported binding type %q for binding %q", binding.Type(), name)
+		}
+
+		meta.Bindings = append(meta.Bindings, bindingMeta)
+	}
+
+	for name, databaseID := range params.D1DatabaseBindings {
+		meta.Bindings = append(meta.Bindings, map[string]interface{}{
+			"name": name,
+			"type": workerD1DatabaseBindingType,
+			"id":   databaseID,
+		})
+	}
+
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return "", nil, err
+	}
+
+	hdr := textproto.MIMEHeader{}
+	hdr.Set("content-disposition", `form-data; name="metadata"`)
+	hdr.Set("content-type", "application/json")
+	if err := writeMultipartPart(mpw, hdr, bytes.NewReader(metaJSON)); err != nil {
+		return "", nil, err
+	}
+
+	hdr = textproto.MIMEHeader{}
+	if params.Module {
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"; filename="%[1]s"`, scriptPartName))
+		hdr.Set("content-type", "application/javascript+module")
+	} else {
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"`, scriptPartName))
+		hdr.Set("content-type", "application/javascript")
+	}
+	if err := writeMultipartPart(mpw, hdr, strings.NewReader(params.Script)); err != nil {
+		return "", nil, err
+	}
+
+	for partName, module := range wasmParts {
+		hdr = textproto.MIMEHeader{}
+		hdr.Set("content-disposition", fmt.Sprintf(`form-data; name="%s"`, partName))
+		hdr.Set("content-type", "application/wasm")
+		if err := writeMultipartPart(mpw, hdr, module); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if err := mpw.Close(); err != nil {
+		return "", nil, err
+	}
+
+	return mpw.FormDataContentType(), buf.Bytes(), nil
+}
+
+func writeMultipartPart(mpw *multipart.Writer, hdr textproto.MIMEHeader, content io.Reader) error {
+	pw, err := mpw.CreatePart(hdr)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(pw, content)
+	return err
 }
 
 func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -152,11 +386,12 @@ func resourceCloudflareWorkerScriptCreate(ctx context.Context, d *schema.Resourc
 
 	parseWorkerBindings(d, bindings)
 
-	_, err = client.UploadWorker(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateWorkerParams{
-		ScriptName: scriptData.Params.ScriptName,
-		Script:     scriptBody,
-		Module:     d.Get("module").(bool),
-		Bindings:   bindings,
+	err = uploadWorkerScript(ctx, client, accountID, workerScriptUploadParams{
+		ScriptName:         scriptData.Params.ScriptName,
+		Script:             scriptBody,
+		Module:             d.Get("module").(bool),
+		Bindings:           bindings,
+		D1DatabaseBindings: parseWorkerD1DatabaseBindings(d),
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error creating worker script"))
@@ -210,6 +445,8 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 	serviceBindings := &schema.Set{F: schema.HashResource(serviceBindingResource)}
 	r2BucketBindings := &schema.Set{F: schema.HashResource(r2BucketBindingResource)}
 	analyticsEngineBindings := &schema.Set{F: schema.HashResource(analyticsEngineBindingResource)}
+	queueBindings := &schema.Set{F: schema.HashResource(queueBindingResource)}
+	d1DatabaseBindings := &schema.Set{F: schema.HashResource(d1DatabaseBindingResource)}
 
 	for name, binding := range bindings {
 		switch v := binding.(type) {
@@ -224,14 +461,16 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 				"text": v.Text,
 			})
 		case cloudflare.WorkerSecretTextBinding:
-			value := v.Text
-			switch v := existingBindings[name].(type) {
-			case cloudflare.WorkerSecretTextBinding:
-				value = v.Text
+			// The API never returns the secret text, so secrets which aren't
+			// defined on this resource can't be tracked here. They are
+			// usually managed with cloudflare_worker_secret instead.
+			existing, ok := existingBindings[name].(cloudflare.WorkerSecretTextBinding)
+			if !ok {
+				continue
 			}
 			secretTextBindings.Add(map[string]interface{}{
 				"name": name,
-				"text": value,
+				"text": existing.Text,
 			})
 		case cloudflare.WorkerWebAssemblyBinding:
 			module, err := ioutil.ReadAll(v.Module)
@@ -258,9 +497,26 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 				"name":    name,
 				"dataset": v.Dataset,
 			})
+		case cloudflare.WorkerQueueBinding:
+			queueBindings.Add(map[string]interface{}{
+				"binding": name,
+				"queue":   v.Queue,
+			})
 		}
 	}
 
+	d1Bindings, err := getWorkerScriptD1DatabaseBindings(ctx, accountID, d.Get("name").(string), client)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for name, databaseID := range d1Bindings {
+		d1DatabaseBindings.Add(map[string]interface{}{
+			"name":        name,
+			"database_id": databaseID,
+		})
+	}
+
 	if err := d.Set("content", r.Script); err != nil {
 		return diag.FromErr(fmt.Errorf("cannot set content: %w", err))
 	}
@@ -293,6 +549,14 @@ func resourceCloudflareWorkerScriptRead(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(fmt.Errorf("cannot set analytics engine bindings (%s): %w", d.Id(), err))
 	}
 
+	if err := d.Set("queue_binding", queueBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set queue bindings (%s): %w", d.Id(), err))
+	}
+
+	if err := d.Set("d1_database_binding", d1DatabaseBindings); err != nil {
+		return diag.FromErr(fmt.Errorf("cannot set d1 database bindings (%s): %w", d.Id(), err))
+	}
+
 	d.SetId(scriptData.ID)
 
 	return nil
@@ -322,11 +586,16 @@ func resourceCloudflareWorkerScriptUpdate(ctx context.Context, d *schema.Resourc
 
 	parseWorkerBindings(d, bindings)
 
-	_, err = client.UploadWorker(ctx, cloudflare.AccountIdentifier(accountID), cloudflare.CreateWorkerParams{
-		ScriptName: scriptData.Params.ScriptName,
-		Script:     scriptBody,
-		Module:     d.Get("module").(bool),
-		Bindings:   bindings,
+	if err := inheritUnmanagedWorkerSecrets(ctx, d, client, accountID, bindings); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = uploadWorkerScript(ctx, client, accountID, workerScriptUploadParams{
+		ScriptName:         scriptData.Params.ScriptName,
+		Script:             scriptBody,
+		Module:             d.Get("module").(bool),
+		Bindings:           bindings,
+		D1DatabaseBindings: parseWorkerD1DatabaseBindings(d),
 	})
 	if err != nil {
 		return diag.FromErr(errors.Wrap(err, "error updating worker script"))
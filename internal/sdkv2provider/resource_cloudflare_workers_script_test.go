@@ -1,8 +1,12 @@
 package sdkv2provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"mime"
+	"mime/multipart"
 	"os"
 	"strings"
 	"testing"
@@ -10,6 +14,7 @@ import (
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 const (
@@ -91,6 +96,78 @@ func TestAccCloudflareWorkerScript_ModuleUpload(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareWorkerScript_D1DatabaseBinding(t *testing.T) {
+	t.Parallel()
+
+	var script cloudflare.WorkerScript
+	rnd := generateRandomResourceName()
+	name := "cloudflare_worker_script." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareWorkerScriptDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCloudflareWorkerScriptConfigD1DatabaseBinding(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudflareWorkerScriptExists(name, &script, nil),
+					resource.TestCheckResourceAttr(name, "d1_database_binding.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(name, "d1_database_binding.*", map[string]string{
+						"name": "MY_DATABASE",
+					}),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+				ImportState:         true,
+				ImportStateVerify:   true,
+			},
+		},
+	})
+}
+
+func TestBuildWorkerScriptMultipartBody(t *testing.T) {
+	contentType, body, err := buildWorkerScriptMultipartBody(workerScriptUploadParams{
+		ScriptName: "example",
+		Script:     scriptContent1,
+		Bindings: ScriptBindings{
+			"MY_QUEUE":  cloudflare.WorkerQueueBinding{Binding: "MY_QUEUE", Queue: "my-queue"},
+			"MY_TEXT":   cloudflare.WorkerPlainTextBinding{Text: "example"},
+			"MY_SECRET": cloudflare.WorkerInheritBinding{},
+		},
+		D1DatabaseBindings: D1DatabaseBindings{
+			"MY_DATABASE": "ce8b95dc-b376-4ff8-9b9e-1801ed6d745d",
+		},
+	})
+	assert.NoError(t, err)
+
+	_, params, err := mime.ParseMediaType(contentType)
+	assert.NoError(t, err)
+
+	form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{scriptContent1}, form.Value["script"])
+
+	var meta struct {
+		BodyPart string                   `json:"body_part"`
+		Bindings []map[string]interface{} `json:"bindings"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(form.Value["metadata"][0]), &meta))
+	assert.Equal(t, "script", meta.BodyPart)
+	assert.ElementsMatch(t, []map[string]interface{}{
+		{"name": "MY_QUEUE", "type": "queue", "queue_name": "my-queue"},
+		{"name": "MY_TEXT", "type": "plain_text", "text": "example"},
+		{"name": "MY_SECRET", "type": "inherit"},
+		{"name": "MY_DATABASE", "type": "d1", "id": "ce8b95dc-b376-4ff8-9b9e-1801ed6d745d"},
+	}, meta.Bindings)
+}
+
 // Create a bucket before creating a worker script binding.
 // When a cloudflare_r2_bucket resource is added, we can switch to that instead
 func testAccCheckCloudflareWorkerScriptCreateBucket(t *testing.T, rnd string) {
@@ -176,6 +253,25 @@ resource "cloudflare_worker_script" "%[1]s" {
 }`, rnd, scriptContent2, encodedWasm, accountID)
 }
 
+func testAccCheckCloudflareWorkerScriptConfigD1DatabaseBinding(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_d1_database" "%[1]s" {
+  account_id = "%[3]s"
+  name       = "%[1]s"
+}
+
+resource "cloudflare_worker_script" "%[1]s" {
+  account_id = "%[3]s"
+  name       = "%[1]s"
+  content    = "%[2]s"
+
+  d1_database_binding {
+    name        = "MY_DATABASE"
+    database_id = cloudflare_d1_database.%[1]s.id
+  }
+}`, rnd, scriptContent1, accountID)
+}
+
 func testAccCheckCloudflareWorkerScriptUploadModule(rnd, accountID string) string {
 	return fmt.Sprintf(`
 resource "cloudflare_worker_script" "%[1]s" {
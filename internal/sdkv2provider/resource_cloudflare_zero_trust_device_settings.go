@@ -0,0 +1,143 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zeroTrustDeviceSettings are the account wide device settings which are not
+// tied to a device settings policy. cloudflare-go only models the gateway
+// proxy settings, which cloudflare_teams_account manages through its proxy
+// block, so the remaining settings are added here.
+type zeroTrustDeviceSettings struct {
+	cloudflare.TeamsDeviceSettings
+	DisableForTime                     int  `json:"disable_for_time"`
+	RootCertificateInstallationEnabled bool `json:"root_certificate_installation_enabled"`
+	UseZTVirtualIP                     bool `json:"use_zt_virtual_ip"`
+}
+
+// zeroTrustDeviceSettingsKeys are the settings managed by the resource, which
+// share their names with the attributes of the resource.
+var zeroTrustDeviceSettingsKeys = []string{
+	"disable_for_time",
+	"gateway_proxy_enabled",
+	"gateway_udp_proxy_enabled",
+	"root_certificate_installation_enabled",
+	"use_zt_virtual_ip",
+}
+
+func resourceCloudflareZeroTrustDeviceSettings() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustDeviceSettingsSchema(),
+		ReadContext:   resourceCloudflareZeroTrustDeviceSettingsRead,
+		CreateContext: resourceCloudflareZeroTrustDeviceSettingsUpdate, // Intentionally identical to Update as the resource is always present
+		UpdateContext: resourceCloudflareZeroTrustDeviceSettingsUpdate,
+		DeleteContext: resourceCloudflareZeroTrustDeviceSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustDeviceSettingsImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare resource for managing the account wide Zero
+			Trust device settings. These settings are not tied to a device
+			settings policy.
+
+			The gateway proxy settings are also managed by the ` + "`proxy`" + ` block
+			of ` + "`cloudflare_teams_account`" + `, only configure them in one of the
+			two resources. Settings which are not configured are left unchanged.
+		`),
+	}
+}
+
+func resourceCloudflareZeroTrustDeviceSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, zeroTrustDeviceSettingsURI(accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Zero Trust device settings for account %q: %w", accountID, err))
+	}
+
+	var settings zeroTrustDeviceSettings
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Zero Trust device settings: %w", err))
+	}
+
+	d.Set("disable_for_time", settings.DisableForTime)
+	d.Set("gateway_proxy_enabled", settings.GatewayProxyEnabled)
+	d.Set("gateway_udp_proxy_enabled", settings.GatewayProxyUDPEnabled)
+	d.Set("root_certificate_installation_enabled", settings.RootCertificateInstallationEnabled)
+	d.Set("use_zt_virtual_ip", settings.UseZTVirtualIP)
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	// The settings are replaced as a whole, so only the configured settings
+	// are changed and every other setting is sent back as it is. This keeps
+	// the proxy settings of cloudflare_teams_account and settings unknown to
+	// the provider.
+	res, err := client.Raw(ctx, http.MethodGet, zeroTrustDeviceSettingsURI(accountID), nil, nil)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error finding Zero Trust device settings for account %q: %w", accountID, err))
+	}
+
+	settings := make(map[string]interface{})
+	if err := json.Unmarshal(res, &settings); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Zero Trust device settings: %w", err))
+	}
+
+	config := d.GetRawConfig()
+	for _, key := range zeroTrustDeviceSettingsKeys {
+		if !config.IsNull() && !config.GetAttr(key).IsNull() {
+			settings[key] = d.Get(key)
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Zero Trust device settings: %+v", settings))
+
+	if _, err := client.Raw(ctx, http.MethodPut, zeroTrustDeviceSettingsURI(accountID), settings, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Zero Trust device settings for account %q: %w", accountID, err))
+	}
+
+	d.SetId(accountID)
+
+	return resourceCloudflareZeroTrustDeviceSettingsRead(ctx, d, meta)
+}
+
+// resourceCloudflareZeroTrustDeviceSettingsDelete only removes the settings
+// from the state. The account always has device settings and which of them
+// were set by the resource, rather than by cloudflare_teams_account or the
+// dashboard, is no longer known.
+func resourceCloudflareZeroTrustDeviceSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Removing Zero Trust device settings of account %q from state, the settings are left unchanged", d.Get(consts.AccountIDSchemaKey).(string)))
+
+	d.SetId("")
+	return nil
+}
+
+func resourceCloudflareZeroTrustDeviceSettingsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	accountID := d.Id()
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(accountID)
+
+	resourceCloudflareZeroTrustDeviceSettingsRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func zeroTrustDeviceSettingsURI(accountID string) string {
+	return fmt.Sprintf("/accounts/%s/devices/settings", accountID)
+}
@@ -0,0 +1,126 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZeroTrustDeviceSettings_Basic(t *testing.T) {
+	// Temporarily unset CLOUDFLARE_API_TOKEN if it is set as the Access
+	// service does not yet support the API tokens and it results in
+	// misleading state error messages.
+	if os.Getenv("CLOUDFLARE_API_TOKEN") != "" {
+		t.Setenv("CLOUDFLARE_API_TOKEN", "")
+	}
+
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_device_settings.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZeroTrustDeviceSettingsConfig(rnd, accountID, 180, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "disable_for_time", "180"),
+					resource.TestCheckResourceAttr(name, "gateway_proxy_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "gateway_udp_proxy_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "root_certificate_installation_enabled", "true"),
+					resource.TestCheckResourceAttr(name, "use_zt_virtual_ip", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareZeroTrustDeviceSettingsConfig(rnd, accountID, 0, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "disable_for_time", "0"),
+					resource.TestCheckResourceAttr(name, "gateway_proxy_enabled", "false"),
+					resource.TestCheckResourceAttr(name, "gateway_udp_proxy_enabled", "false"),
+					resource.TestCheckResourceAttr(name, "root_certificate_installation_enabled", "false"),
+					resource.TestCheckResourceAttr(name, "use_zt_virtual_ip", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateId:     accountID,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareZeroTrustDeviceSettingsUpdateKeepsUnconfiguredSettings(t *testing.T) {
+	var putBody map[string]interface{}
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/accounts/f037e56e89293a057740de681ac9abbe/devices/settings", r.URL.Path)
+		switch r.Method {
+		case http.MethodGet:
+			testclient.WriteResult(w, json.RawMessage(`{"disable_for_time":0,"gateway_proxy_enabled":true,"gateway_udp_proxy_enabled":true,"root_certificate_installation_enabled":false,"use_zt_virtual_ip":false,"new_setting":"kept"}`))
+		case http.MethodPut:
+			body, _ := io.ReadAll(r.Body)
+			assert.NoError(t, json.Unmarshal(body, &putBody))
+			testclient.WriteResult(w, json.RawMessage(body))
+		}
+	}))
+
+	d := resourceCloudflareZeroTrustDeviceSettings().Data(&terraform.InstanceState{
+		Attributes: map[string]string{
+			consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+			"disable_for_time":        "3600",
+			"use_zt_virtual_ip":       "true",
+		},
+		RawConfig: cty.ObjectVal(map[string]cty.Value{
+			consts.AccountIDSchemaKey:               cty.StringVal("f037e56e89293a057740de681ac9abbe"),
+			"disable_for_time":                      cty.NumberIntVal(3600),
+			"gateway_proxy_enabled":                 cty.NullVal(cty.Bool),
+			"gateway_udp_proxy_enabled":             cty.NullVal(cty.Bool),
+			"root_certificate_installation_enabled": cty.NullVal(cty.Bool),
+			"use_zt_virtual_ip":                     cty.BoolVal(true),
+		}),
+	})
+
+	diags := resourceCloudflareZeroTrustDeviceSettingsUpdate(context.Background(), d, client)
+	assert.False(t, diags.HasError(), diags)
+
+	// The gateway proxy settings belong to cloudflare_teams_account here.
+	assert.Equal(t, map[string]interface{}{
+		"disable_for_time":                      float64(3600),
+		"gateway_proxy_enabled":                 true,
+		"gateway_udp_proxy_enabled":             true,
+		"root_certificate_installation_enabled": false,
+		"use_zt_virtual_ip":                     true,
+		"new_setting":                           "kept",
+	}, putBody)
+	assert.Equal(t, true, d.Get("gateway_proxy_enabled"))
+}
+
+func testAccCloudflareZeroTrustDeviceSettingsConfig(rnd, accountID string, disableForTime int, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_device_settings" "%[1]s" {
+  account_id                            = "%[2]s"
+  disable_for_time                      = %[3]d
+  gateway_proxy_enabled                 = %[4]t
+  gateway_udp_proxy_enabled             = %[4]t
+  root_certificate_installation_enabled = %[4]t
+  use_zt_virtual_ip                     = %[4]t
+}
+`, rnd, accountID, disableForTime, enabled)
+}
@@ -0,0 +1,242 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dexTest is a Digital Experience Monitoring test run by the WARP client.
+// The pinned cloudflare-go version has no DEX test types yet.
+type dexTest struct {
+	TestID         string                `json:"test_id,omitempty"`
+	Name           string                `json:"name"`
+	Description    string                `json:"description,omitempty"`
+	Interval       string                `json:"interval"`
+	Enabled        bool                  `json:"enabled"`
+	Data           dexTestData           `json:"data"`
+	Targeted       bool                  `json:"targeted"`
+	TargetPolicies []dexTestTargetPolicy `json:"target_policies"`
+	Created        string                `json:"created,omitempty"`
+	Updated        string                `json:"updated,omitempty"`
+}
+
+type dexTestData struct {
+	Kind   string `json:"kind"`
+	Host   string `json:"host"`
+	Method string `json:"method,omitempty"`
+}
+
+// dexTestTargetPolicy is a device settings policy a targeted test runs for.
+type dexTestTargetPolicy struct {
+	ID      string `json:"id"`
+	Name    string `json:"name,omitempty"`
+	Default bool   `json:"default,omitempty"`
+}
+
+func resourceCloudflareZeroTrustDexTest() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustDexTestSchema(),
+		CreateContext: resourceCloudflareZeroTrustDexTestCreate,
+		ReadContext:   resourceCloudflareZeroTrustDexTestRead,
+		UpdateContext: resourceCloudflareZeroTrustDexTestUpdate,
+		DeleteContext: resourceCloudflareZeroTrustDexTestDelete,
+		CustomizeDiff: resourceCloudflareZeroTrustDexTestCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustDexTestImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Device Digital Experience Monitoring (DEX)
+			test resource. DEX tests are run by the WARP client to monitor the
+			availability of and the route to an application.
+		`),
+	}
+}
+
+func dexTestURI(accountID, testID string) string {
+	uri := fmt.Sprintf("/accounts/%s/dex/devices/dex_tests", accountID)
+	if testID != "" {
+		uri += "/" + testID
+	}
+	return uri
+}
+
+func resourceCloudflareZeroTrustDexTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare Device DEX test %q", d.Get("name").(string)))
+
+	test, err := writeDexTest(ctx, client, http.MethodPost, dexTestURI(accountID, ""), buildDexTest(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Device DEX test for account %q: %w", accountID, err))
+	}
+
+	d.SetId(test.TestID)
+
+	return resourceCloudflareZeroTrustDexTestRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustDexTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, dexTestURI(accountID, d.Id()), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Device DEX test %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding Device DEX test %q: %w", d.Id(), err))
+	}
+
+	var test dexTest
+	if err := json.Unmarshal(res, &test); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Device DEX test: %w", err))
+	}
+
+	d.Set("name", test.Name)
+	d.Set("description", test.Description)
+	d.Set("interval", test.Interval)
+	d.Set("enabled", test.Enabled)
+	d.Set("targeted", test.Targeted)
+	d.Set("created", test.Created)
+	d.Set("updated", test.Updated)
+
+	if err := d.Set("data", flattenDexTestData(test.Data)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set data: %w", err))
+	}
+
+	policyIDs := make([]string, 0, len(test.TargetPolicies))
+	for _, policy := range test.TargetPolicies {
+		policyIDs = append(policyIDs, policy.ID)
+	}
+	if err := d.Set("target_policies", policyIDs); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set target_policies: %w", err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDexTestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Cloudflare Device DEX test using ID: %s", d.Id()))
+
+	if _, err := writeDexTest(ctx, client, http.MethodPut, dexTestURI(accountID, d.Id()), buildDexTest(d)); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Device DEX test %q: %w", d.Id(), err))
+	}
+
+	return resourceCloudflareZeroTrustDexTestRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustDexTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare Device DEX test using ID: %s", d.Id()))
+
+	if _, err := client.Raw(ctx, http.MethodDelete, dexTestURI(accountID, d.Id()), nil, nil); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting Device DEX test %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustDexTestImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/testID\"", d.Id())
+	}
+
+	accountID, testID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Device DEX test: id %s for account %s", testID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.SetId(testID)
+
+	resourceCloudflareZeroTrustDexTestRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceCloudflareZeroTrustDexTestCustomizeDiff validates at plan time that
+// a targeted test has device settings policies to target.
+func resourceCloudflareZeroTrustDexTestCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("targeted") || !d.Get("targeted").(bool) {
+		return nil
+	}
+
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	policies := config.GetAttr("target_policies")
+	if policies.IsNull() || (policies.IsKnown() && policies.LengthInt() == 0) {
+		return errors.New("target_policies must be set when targeted is true")
+	}
+
+	return nil
+}
+
+// writeDexTest creates or updates a Device DEX test and returns the test the
+// API responded with.
+func writeDexTest(ctx context.Context, client *cloudflare.API, method, uri string, test dexTest) (dexTest, error) {
+	var result dexTest
+	res, err := client.Raw(ctx, method, uri, test, nil)
+	if err != nil {
+		return result, err
+	}
+
+	if err := json.Unmarshal(res, &result); err != nil {
+		return result, fmt.Errorf("failed to unmarshal Device DEX test: %w", err)
+	}
+
+	return result, nil
+}
+
+func buildDexTest(d *schema.ResourceData) dexTest {
+	test := dexTest{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Interval:       d.Get("interval").(string),
+		Enabled:        d.Get("enabled").(bool),
+		Targeted:       d.Get("targeted").(bool),
+		TargetPolicies: []dexTestTargetPolicy{},
+		Data: dexTestData{
+			Kind:   d.Get("data.0.kind").(string),
+			Host:   d.Get("data.0.host").(string),
+			Method: d.Get("data.0.method").(string),
+		},
+	}
+
+	for _, id := range expandInterfaceToStringList(d.Get("target_policies").(*schema.Set).List()) {
+		test.TargetPolicies = append(test.TargetPolicies, dexTestTargetPolicy{ID: id})
+	}
+
+	return test
+}
+
+func flattenDexTestData(data dexTestData) []interface{} {
+	return []interface{}{map[string]interface{}{
+		"kind":   data.Kind,
+		"host":   data.Host,
+		"method": data.Method,
+	}}
+}
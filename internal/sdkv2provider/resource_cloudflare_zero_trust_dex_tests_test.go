@@ -0,0 +1,161 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZeroTrustDexTest_TargetPolicies(t *testing.T) {
+	rnd := generateRandomResourceName()
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	name := fmt.Sprintf("cloudflare_zero_trust_dex_test.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareZeroTrustDexTestDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareZeroTrustDexTestTargetedWithoutPolicies(rnd, accountID),
+				ExpectError: regexp.MustCompile("target_policies must be set when targeted is true"),
+			},
+			{
+				Config: testAccCloudflareZeroTrustDexTestTargeted(rnd, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, consts.AccountIDSchemaKey, accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+					resource.TestCheckResourceAttr(name, "data.0.kind", "http"),
+					resource.TestCheckResourceAttr(name, "data.0.host", "https://dash.cloudflare.com"),
+					resource.TestCheckResourceAttr(name, "targeted", "true"),
+					resource.TestCheckResourceAttr(name, "target_policies.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(name, "target_policies.*", "cloudflare_device_settings_policy."+rnd, "id"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestBuildDexTestTargetPolicies(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZeroTrustDexTestSchema(), map[string]interface{}{
+		consts.AccountIDSchemaKey: "f037e56e89293a057740de681ac9abbe",
+		"name":                    "dashboard",
+		"interval":                "30m",
+		"enabled":                 true,
+		"data": []interface{}{map[string]interface{}{
+			"kind":   "http",
+			"host":   "https://dash.cloudflare.com",
+			"method": "GET",
+		}},
+		"targeted":        true,
+		"target_policies": []interface{}{"0f6a3d2b6e5c4e1a9b8c7d6e5f4a3b2c"},
+	})
+
+	body, err := json.Marshal(buildDexTest(d))
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"name": "dashboard",
+		"interval": "30m",
+		"enabled": true,
+		"data": {"kind": "http", "host": "https://dash.cloudflare.com", "method": "GET"},
+		"targeted": true,
+		"target_policies": [{"id": "0f6a3d2b6e5c4e1a9b8c7d6e5f4a3b2c"}]
+	}`, string(body))
+}
+
+func TestResourceCloudflareZeroTrustDexTestTargetedWithoutPolicies(t *testing.T) {
+	raw := cty.ObjectVal(map[string]cty.Value{
+		consts.AccountIDSchemaKey: cty.StringVal("f037e56e89293a057740de681ac9abbe"),
+		"name":                    cty.StringVal("dashboard"),
+		"interval":                cty.StringVal("30m"),
+		"enabled":                 cty.True,
+		"data": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+			"kind": cty.StringVal("http"),
+			"host": cty.StringVal("https://dash.cloudflare.com"),
+		})}),
+		"targeted":        cty.True,
+		"target_policies": cty.NullVal(cty.Set(cty.String)),
+	})
+	config := terraform.NewResourceConfigShimmed(raw, resourceCloudflareZeroTrustDexTest().CoreConfigSchema())
+
+	_, err := resourceCloudflareZeroTrustDexTest().Diff(context.Background(), &terraform.InstanceState{RawConfig: raw}, config, nil)
+	assert.ErrorContains(t, err, "target_policies must be set when targeted is true")
+}
+
+func testAccCloudflareZeroTrustDexTestTargeted(rnd, accountID string) string {
+	return testAccCloudflareDeviceSettingsPolicy(rnd, accountID, 10) + fmt.Sprintf(`
+resource "cloudflare_zero_trust_dex_test" "%[1]s" {
+  account_id  = "%[2]s"
+  name        = "%[1]s"
+  description = "Dashboard availability"
+  interval    = "0h30m0s"
+  enabled     = true
+
+  data {
+    kind   = "http"
+    host   = "https://dash.cloudflare.com"
+    method = "GET"
+  }
+
+  targeted        = true
+  target_policies = [cloudflare_device_settings_policy.%[1]s.id]
+}
+`, rnd, accountID)
+}
+
+func testAccCloudflareZeroTrustDexTestTargetedWithoutPolicies(rnd, accountID string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_dex_test" "%[1]s" {
+  account_id = "%[2]s"
+  name       = "%[1]s"
+  interval   = "0h30m0s"
+  enabled    = true
+
+  data {
+    kind = "traceroute"
+    host = "dash.cloudflare.com"
+  }
+
+  targeted = true
+}
+`, rnd, accountID)
+}
+
+func testAccCheckCloudflareZeroTrustDexTestDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zero_trust_dex_test" {
+			continue
+		}
+
+		_, err := client.Raw(context.Background(), http.MethodGet, dexTestURI(rs.Primary.Attributes[consts.AccountIDSchemaKey], rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("Device DEX test still exists")
+		}
+	}
+
+	return nil
+}
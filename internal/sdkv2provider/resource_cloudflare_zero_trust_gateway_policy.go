@@ -0,0 +1,109 @@
+package sdkv2provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	gatewayPolicyResolveAction     = "resolve"
+	gatewayPolicyDNSResolverFilter = "dns_resolver"
+)
+
+// gatewayPolicyActionFilters lists the filters each Gateway policy action can
+// be used with. Actions missing from the map are valid for every filter.
+var gatewayPolicyActionFilters = map[string][]string{
+	"safesearch":               {"dns"},
+	"ytrestricted":             {"dns"},
+	"override":                 {"dns"},
+	gatewayPolicyResolveAction: {gatewayPolicyDNSResolverFilter},
+	"on":                       {"http"},
+	"off":                      {"http"},
+	"scan":                     {"http"},
+	"noscan":                   {"http"},
+	"isolate":                  {"http"},
+	"noisolate":                {"http"},
+	"l4_override":              {"l4"},
+	"egress":                   {"egress"},
+}
+
+// gatewayPolicySettingActions lists the action a rule setting only applies to.
+var gatewayPolicySettingActions = map[string]string{
+	"biso_admin_controls":            "isolate",
+	"override_ips":                   "override",
+	"override_host":                  "override",
+	"l4override":                     "l4_override",
+	"egress":                         "egress",
+	"resolve_dns_through_cloudflare": gatewayPolicyResolveAction,
+}
+
+// resourceCloudflareZeroTrustGatewayPolicy manages the same Gateway rules as
+// cloudflare_teams_rule but validates that the action, filters and rule
+// settings of a policy fit together before they reach the API.
+func resourceCloudflareZeroTrustGatewayPolicy() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustGatewayPolicySchema(),
+		ReadContext:   resourceCloudflareTeamsRuleRead,
+		UpdateContext: resourceCloudflareTeamsRuleUpdate,
+		CreateContext: resourceCloudflareTeamsRuleCreate,
+		DeleteContext: resourceCloudflareTeamsRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareTeamsRuleImport,
+		},
+		CustomizeDiff: resourceCloudflareZeroTrustGatewayPolicyCustomizeDiff,
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Zero Trust Gateway policy resource. Gateway
+			policies filter the DNS, network and HTTP traffic of an account.
+		`),
+	}
+}
+
+func resourceCloudflareZeroTrustGatewayPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("action") || !d.NewValueKnown("filters") || !d.NewValueKnown("rule_settings") {
+		return nil
+	}
+
+	var settings map[string]interface{}
+	if s, ok := d.Get("rule_settings").([]interface{}); ok && len(s) == 1 && s[0] != nil {
+		settings = s[0].(map[string]interface{})
+	}
+
+	return validateGatewayPolicy(d.Get("action").(string), expandInterfaceToStringList(d.Get("filters")), settings)
+}
+
+// validateGatewayPolicy checks that the filters and rule settings of a
+// Gateway policy are supported by its action.
+func validateGatewayPolicy(action string, filters []string, settings map[string]interface{}) error {
+	if allowed, ok := gatewayPolicyActionFilters[action]; ok {
+		for _, filter := range filters {
+			if !contains(allowed, filter) {
+				return fmt.Errorf("action %q is only valid for policies with the %s filter, got %q", action, strings.Join(allowed, ", "), filter)
+			}
+		}
+	}
+
+	for setting, settingAction := range gatewayPolicySettingActions {
+		if settingAction != action && gatewayPolicySettingSet(settings[setting]) {
+			return fmt.Errorf("rule_settings.%s is only valid for policies with the %q action", setting, settingAction)
+		}
+	}
+
+	return nil
+}
+
+func gatewayPolicySettingSet(value interface{}) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	}
+
+	return false
+}
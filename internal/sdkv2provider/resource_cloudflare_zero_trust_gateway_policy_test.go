@@ -0,0 +1,150 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZeroTrustGatewayPolicy_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_gateway_policy.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareTeamsRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZeroTrustGatewayPolicyConfig(rnd, accountID, "resolve", "dns_resolver", `
+    resolve_dns_through_cloudflare = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "action", "resolve"),
+					resource.TestCheckResourceAttr(name, "filters.0", "dns_resolver"),
+					resource.TestCheckResourceAttr(name, "rule_settings.0.resolve_dns_through_cloudflare", "true"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", accountID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZeroTrustGatewayPolicy_InvalidCombinations(t *testing.T) {
+	rnd := generateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCloudflareZeroTrustGatewayPolicyConfig(rnd, accountID, "resolve", "dns", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`action "resolve" is only valid for policies with the dns_resolver filter`),
+			},
+			{
+				Config:      testAccCloudflareZeroTrustGatewayPolicyConfig(rnd, accountID, "isolate", "dns", ""),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`action "isolate" is only valid for policies with the http filter`),
+			},
+			{
+				Config: testAccCloudflareZeroTrustGatewayPolicyConfig(rnd, accountID, "block", "http", `
+    biso_admin_controls {
+      disable_printing = true
+    }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`rule_settings.biso_admin_controls is only valid for policies with the "isolate" action`),
+			},
+		},
+	})
+}
+
+func TestValidateGatewayPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		action   string
+		filters  []string
+		settings map[string]interface{}
+		err      string
+	}{
+		"block any filter": {action: "block", filters: []string{"dns", "http"}},
+		"resolve dns resolver": {
+			action:   "resolve",
+			filters:  []string{"dns_resolver"},
+			settings: map[string]interface{}{"resolve_dns_through_cloudflare": true},
+		},
+		"resolve dns": {
+			action:  "resolve",
+			filters: []string{"dns"},
+			err:     `action "resolve" is only valid for policies with the dns_resolver filter, got "dns"`,
+		},
+		"isolate http": {
+			action:   "isolate",
+			filters:  []string{"http"},
+			settings: map[string]interface{}{"biso_admin_controls": []interface{}{map[string]interface{}{"disable_printing": true}}},
+		},
+		"isolate l4": {
+			action:  "isolate",
+			filters: []string{"l4"},
+			err:     `action "isolate" is only valid for policies with the http filter, got "l4"`,
+		},
+		"override settings on block": {
+			action:   "block",
+			filters:  []string{"dns"},
+			settings: map[string]interface{}{"override_host": "example.com"},
+			err:      `rule_settings.override_host is only valid for policies with the "override" action`,
+		},
+		"unset settings on block": {
+			action:  "block",
+			filters: []string{"dns"},
+			settings: map[string]interface{}{
+				"block_page_enabled":             true,
+				"override_ips":                   []interface{}{},
+				"override_host":                  "",
+				"resolve_dns_through_cloudflare": false,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := validateGatewayPolicy(tc.action, tc.filters, tc.settings)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func testAccCloudflareZeroTrustGatewayPolicyConfig(rnd, accountID, action, filter, settings string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zero_trust_gateway_policy" "%[1]s" {
+  name        = "%[1]s"
+  account_id  = "%[2]s"
+  description = "desc"
+  precedence  = 12303
+  action      = "%[3]s"
+  filters     = ["%[4]s"]
+  traffic     = "any(dns.domains[*] == \"example.com\")"
+
+  rule_settings {%[5]s
+  }
+}
+`, rnd, accountID, action, filter, settings)
+}
@@ -0,0 +1,150 @@
+package sdkv2provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZeroTrustTunnelCloudflared() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZeroTrustTunnelCloudflaredSchema(),
+		CreateContext: resourceCloudflareZeroTrustTunnelCloudflaredCreate,
+		ReadContext:   resourceCloudflareZeroTrustTunnelCloudflaredRead,
+		UpdateContext: resourceCloudflareZeroTrustTunnelCloudflaredUpdate,
+		DeleteContext: resourceCloudflareZeroTrustTunnelCloudflaredDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZeroTrustTunnelCloudflaredImport,
+		},
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ForceNewIf("secret", func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return !d.Get("rotate_secret").(bool)
+			}),
+		),
+		Description: heredoc.Doc(`
+			Provides a Cloudflare resource for managing cloudflared tunnels.
+			Tunnels expose applications running on your local web server on
+			any network with an internet connection without manually adding
+			DNS records or configuring a firewall or router.
+		`),
+	}
+}
+
+func resourceCloudflareZeroTrustTunnelCloudflaredCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating Cloudflare tunnel %q", d.Get("name").(string)))
+
+	tunnel, err := quietClient(client).CreateArgoTunnel(ctx, accountID, d.Get("name").(string), d.Get("secret").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating tunnel for account %q: %w", accountID, err))
+	}
+
+	d.SetId(tunnel.ID)
+
+	return resourceCloudflareZeroTrustTunnelCloudflaredRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustTunnelCloudflaredRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tunnel, err := client.ArgoTunnel(ctx, accountID, d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Tunnel %s no longer exists", d.Id()))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error finding tunnel %q: %w", d.Id(), err))
+	}
+
+	if tunnel.DeletedAt != nil {
+		tflog.Info(ctx, fmt.Sprintf("Tunnel %s has been deleted", d.Id()))
+		d.SetId("")
+		return nil
+	}
+
+	// The API never returns the secret so the one in state is left untouched.
+	d.Set("name", tunnel.Name)
+	d.Set("cname", fmt.Sprintf("%s.%s", tunnel.ID, argoTunnelCNAME))
+
+	token, err := client.TunnelToken(ctx, cloudflare.AccountIdentifier(accountID), tunnel.ID)
+	if err != nil {
+		// Keep whatever token is already in state rather than flapping
+		// between a value and an empty string on transient failures.
+		tflog.Warn(ctx, fmt.Sprintf("unable to refresh the tunnel_token of tunnel %s: %s", tunnel.ID, err))
+		return nil
+	}
+
+	d.Set("tunnel_token", token)
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustTunnelCloudflaredUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	if d.HasChange("secret") {
+		tflog.Info(ctx, fmt.Sprintf("Rotating secret of tunnel %s", d.Id()))
+
+		_, err := quietClient(client).Raw(ctx, http.MethodPatch, fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accountID, d.Id()), cloudflare.TunnelUpdateParams{
+			Secret: d.Get("secret").(string),
+		}, nil)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error rotating secret of tunnel %q: %w", d.Id(), err))
+		}
+	}
+
+	return resourceCloudflareZeroTrustTunnelCloudflaredRead(ctx, d, meta)
+}
+
+func resourceCloudflareZeroTrustTunnelCloudflaredDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	accountID := d.Get(consts.AccountIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cloudflare tunnel using ID: %s", d.Id()))
+
+	if err := client.CleanupArgoTunnelConnections(ctx, accountID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error cleaning up connections of tunnel %q: %w", d.Id(), err))
+	}
+
+	if err := client.DeleteArgoTunnel(ctx, accountID, d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("error deleting tunnel %q: %w", d.Id(), err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZeroTrustTunnelCloudflaredImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"accountID/tunnelID\"", d.Id())
+	}
+
+	accountID, tunnelID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare tunnel: id %s for account %s", tunnelID, accountID))
+
+	d.Set(consts.AccountIDSchemaKey, accountID)
+	d.Set("rotate_secret", false)
+	d.SetId(tunnelID)
+
+	resourceCloudflareZeroTrustTunnelCloudflaredRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
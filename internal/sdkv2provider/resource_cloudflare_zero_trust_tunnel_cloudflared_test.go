@@ -0,0 +1,123 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZeroTrustTunnelCloudflared_Basic(t *testing.T) {
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	rnd := generateRandomResourceName()
+	name := fmt.Sprintf("cloudflare_zero_trust_tunnel_cloudflared.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAccount(t)
+		},
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareArgoTunnelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZeroTrustTunnelCloudflared(accountID, rnd),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "account_id", accountID),
+					resource.TestCheckResourceAttr(name, "name", rnd),
+					resource.TestCheckResourceAttr(name, "secret", "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="),
+					resource.TestMatchResourceAttr(name, "cname", regexp.MustCompile(".*\\.cfargotunnel\\.com")),
+					testAccCheckCloudflareZeroTrustTunnelCloudflaredToken(name),
+				),
+			},
+			{
+				Config:   testAccCloudflareZeroTrustTunnelCloudflared(accountID, rnd),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdPrefix:     fmt.Sprintf("%s/", accountID),
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+		},
+	})
+}
+
+func TestResourceCloudflareZeroTrustTunnelCloudflaredRead(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/cfd_tunnel/f174e90a-fafe-4643-bbbc-4a0ed4fc8415", func(w http.ResponseWriter, r *http.Request) {
+		testclient.WriteResult(w, json.RawMessage(`{"id":"f174e90a-fafe-4643-bbbc-4a0ed4fc8415","name":"example"}`))
+	})
+	mux.HandleFunc("/accounts/f037e56e89293a057740de681ac9abbe/cfd_tunnel/f174e90a-fafe-4643-bbbc-4a0ed4fc8415/token", func(w http.ResponseWriter, r *http.Request) {
+		testclient.WriteResult(w, "eyJhIjoiZjAzN2U1NmU4OTI5M2EwNTc3NDBkZTY4MWFjOWFiYmUifQ==")
+	})
+
+	client := testclient.New(t, mux)
+
+	testCases := map[string]struct {
+		id     string
+		secret string
+	}{
+		"refresh keeps secret": {id: "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", secret: "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="},
+		"import":               {id: "f037e56e89293a057740de681ac9abbe/f174e90a-fafe-4643-bbbc-4a0ed4fc8415"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceCloudflareZeroTrustTunnelCloudflaredSchema(), map[string]interface{}{})
+			d.SetId(tc.id)
+
+			if tc.secret == "" {
+				_, err := resourceCloudflareZeroTrustTunnelCloudflaredImport(context.Background(), d, client)
+				assert.NoError(t, err)
+			} else {
+				d.Set("account_id", "f037e56e89293a057740de681ac9abbe")
+				d.Set("secret", tc.secret)
+				assert.False(t, resourceCloudflareZeroTrustTunnelCloudflaredRead(context.Background(), d, client).HasError())
+			}
+
+			assert.Equal(t, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415", d.Id())
+			assert.Equal(t, "f037e56e89293a057740de681ac9abbe", d.Get("account_id"))
+			assert.Equal(t, "example", d.Get("name"))
+			assert.Equal(t, tc.secret, d.Get("secret"))
+			assert.Equal(t, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415.cfargotunnel.com", d.Get("cname"))
+			assert.Equal(t, "eyJhIjoiZjAzN2U1NmU4OTI5M2EwNTc3NDBkZTY4MWFjOWFiYmUifQ==", d.Get("tunnel_token"))
+		})
+	}
+}
+
+func testAccCheckCloudflareZeroTrustTunnelCloudflaredToken(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if _, err := base64.StdEncoding.DecodeString(rs.Primary.Attributes["tunnel_token"]); err != nil {
+			return fmt.Errorf("tunnel_token is not base64 encoded: %w", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCloudflareZeroTrustTunnelCloudflared(accountID, name string) string {
+	return fmt.Sprintf(`
+	resource "cloudflare_zero_trust_tunnel_cloudflared" "%[2]s" {
+		account_id = "%[1]s"
+		name       = "%[2]s"
+		secret     = "AQIDBAUGBwgBAgMEBQYHCAECAwQFBgcIAQIDBAUGBwg="
+	}`, accountID, name)
+}
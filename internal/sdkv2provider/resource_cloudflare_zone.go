@@ -5,12 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"time"
 
 	"golang.org/x/net/idna"
 
 	"github.com/MakeNowJust/heredoc/v2"
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -96,6 +98,11 @@ func resourceCloudflareZone() *schema.Resource {
 		ReadContext:   resourceCloudflareZoneRead,
 		UpdateContext: resourceCloudflareZoneUpdate,
 		DeleteContext: resourceCloudflareZoneDelete,
+		CustomizeDiff: resourceCloudflareZoneCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -134,10 +141,8 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 
 	if paused, ok := d.GetOk("paused"); ok {
 		if paused.(bool) == true {
-			_, err := client.ZoneSetPaused(ctx, zone.ID, paused.(bool))
-
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error updating zone_id %q: %w", zone.ID, err))
+			if diags := setZonePaused(ctx, client, zone.ID, paused.(bool)); diags.HasError() {
+				return diags
 			}
 		}
 	}
@@ -155,7 +160,20 @@ func resourceCloudflareZoneCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	return resourceCloudflareZoneRead(ctx, d, meta)
+	diags := resourceCloudflareZoneRead(ctx, d, meta)
+
+	// A replaced zone gets a new verification key so the TXT record can't be
+	// in place yet. Point at the next step instead of failing the creation.
+	if d.Get("activate").(bool) && d.Get("status").(string) != "active" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "Zone is pending activation",
+			Detail:        fmt.Sprintf("Create a TXT record named \"cloudflare-verify.%s\" with the value %q at your DNS provider, then set activate to false and back to true to request an activation check.", zoneName, d.Get("verification_key").(string)),
+			AttributePath: cty.GetAttrPath("activate"),
+		})
+	}
+
+	return diags
 }
 
 func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -204,17 +222,14 @@ func resourceCloudflareZoneRead(ctx context.Context, d *schema.ResourceData, met
 func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*cloudflare.API)
 	zoneID := d.Id()
-	zone, _ := client.ZoneDetails(ctx, zoneID)
 
 	log.Printf("[INFO] Updating Cloudflare Zone: id %s", zoneID)
 
 	if paused, ok := d.GetOkExists("paused"); ok && d.HasChange("paused") {
 		log.Printf("[DEBUG] _ paused")
 
-		_, err := client.ZoneSetPaused(ctx, zoneID, paused.(bool))
-
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error setting paused for zone ID %q: %w", zoneID, err))
+		if diags := setZonePaused(ctx, client, zoneID, paused.(bool)); diags.HasError() {
+			return diags
 		}
 	}
 
@@ -226,23 +241,38 @@ func resourceCloudflareZoneUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
-	// In the cases where the zone isn't completely setup yet, we need to
-	// check the `status` field and should it be pending, use the `LegacyID`
-	// from `zone.PlanPending` instead to account for paid plans.
-	if zone.Status == "pending" && zone.PlanPending.Name != "" {
-		d.Set("plan", zone.PlanPending.LegacyID)
-	}
+	if plan, ok := d.GetOk("plan"); ok && d.HasChange("plan") {
+		// Compare against the plan the zone is actually on rather than the one
+		// in the state which may be stale, otherwise a change could be skipped
+		// or sent to the wrong endpoint.
+		zone, err := client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error finding Zone %q: %w", zoneID, err))
+		}
 
-	if change := d.HasChange("plan"); change {
-		// If we're upgrading from a free plan, we need to use POST (not PUT) as the
-		// the subscription needs to be created, not modified despite the resource
-		// already existing.
-		existingPlan, newPlan := d.GetChange("plan")
-		wasFreePlan := existingPlan.(string) == "free"
-		planID := newPlan.(string)
+		// In the cases where the zone isn't completely setup yet, we need to
+		// check the `status` field and should it be pending, use the `LegacyID`
+		// from `zone.PlanPending` instead to account for paid plans.
+		currentPlan := zone.Plan.LegacyID
+		if zone.Status == "pending" && zone.PlanPending.LegacyID != "" {
+			currentPlan = zone.PlanPending.LegacyID
+		}
 
-		if err := setRatePlan(ctx, client, zoneID, planID, wasFreePlan, d); err != nil {
-			return diag.FromErr(err)
+		if planID := plan.(string); planID != currentPlan {
+			// If we're upgrading from a free plan, we need to use POST (not PUT)
+			// as the subscription needs to be created, not modified despite the
+			// resource already existing.
+			if err := setRatePlan(ctx, client, zoneID, planID, currentPlan == planIDFree, d); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	// Activation checks are rate limited so only request one when activate
+	// is switched on rather than on every apply while the zone is pending.
+	if d.HasChange("activate") && d.Get("activate").(bool) && d.Get("status").(string) != "active" {
+		if diags := activateZone(ctx, client, zoneID, d.Timeout(schema.TimeoutUpdate)); diags.HasError() {
+			return diags
 		}
 	}
 
@@ -264,6 +294,24 @@ func resourceCloudflareZoneDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+func resourceCloudflareZoneCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("activate").(bool) {
+		return nil
+	}
+
+	if d.Get("type").(string) != "partial" {
+		return fmt.Errorf("activate can only be used with zones of type partial")
+	}
+
+	// The verification key is only known once the zone exists so the TXT
+	// record can't be in place for an activation check during creation.
+	if d.Id() == "" {
+		return fmt.Errorf("activate cannot be set when creating a zone, set it once the TXT record containing the verification_key exists")
+	}
+
+	return nil
+}
+
 func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]interface{} {
 	cfg := map[string]interface{}{}
 
@@ -275,6 +323,66 @@ func flattenMeta(d *schema.ResourceData, meta cloudflare.ZoneMeta) map[string]in
 	return cfg
 }
 
+// setZonePaused pauses or unpauses a zone. Zones that haven't been activated
+// yet can't be paused so explain that instead of surfacing the raw API error.
+func setZonePaused(ctx context.Context, client *cloudflare.API, zoneID string, paused bool) diag.Diagnostics {
+	_, err := client.ZoneSetPaused(ctx, zoneID, paused)
+	if err == nil {
+		return nil
+	}
+
+	if zone, detailsErr := client.ZoneDetails(ctx, zoneID); detailsErr == nil && zone.Status == "pending" {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "Pending zone cannot be paused",
+			Detail:        fmt.Sprintf("Zone %q is still pending activation and its paused state cannot be changed until it is active: %s. Complete the zone setup, such as updating the name servers at the registrar, and apply again.", zone.Name, err),
+			AttributePath: cty.GetAttrPath("paused"),
+		}}
+	}
+
+	return diag.FromErr(fmt.Errorf("error setting paused for zone ID %q: %w", zoneID, err))
+}
+
+// activateZone requests an activation check for a pending zone and waits until
+// the zone is active. Should the zone remain pending, the verification details
+// are returned so that the TXT record can be checked.
+func activateZone(ctx context.Context, client *cloudflare.API, zoneID string, timeout time.Duration) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Requesting activation check for zone %s", zoneID))
+
+	if _, err := client.ZoneActivationCheck(ctx, zoneID); err != nil {
+		return diag.FromErr(fmt.Errorf("error requesting activation check for zone ID %q: %w", zoneID, err))
+	}
+
+	var zone cloudflare.Zone
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		var err error
+		zone, err = client.ZoneDetails(ctx, zoneID)
+		if err != nil {
+			return resource.NonRetryableError(fmt.Errorf("error finding Zone %q: %w", zoneID, err))
+		}
+
+		if zone.Status != "active" {
+			return resource.RetryableError(fmt.Errorf("zone %q is %s", zone.Name, zone.Status))
+		}
+
+		return nil
+	})
+	if err == nil {
+		return nil
+	}
+
+	if zone.ID == "" {
+		return diag.FromErr(err)
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Zone activation failed",
+		Detail:        fmt.Sprintf("Zone %q is still %s after requesting an activation check: %s. Make sure a TXT record named \"cloudflare-verify.%s\" with the value %q exists at your DNS provider and apply again.", zone.Name, zone.Status, err, zone.Name, zone.VerificationKey),
+		AttributePath: cty.GetAttrPath("activate"),
+	}}
+}
+
 // setRatePlan handles the internals of creating or updating a zone
 // subscription rate plan.
 func setRatePlan(ctx context.Context, client *cloudflare.API, zoneID, planID string, isNewPlan bool, d *schema.ResourceData) error {
@@ -0,0 +1,119 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// zoneCacheReserve is the Cache Reserve setting of a zone.
+type zoneCacheReserve struct {
+	Value string `json:"value"`
+}
+
+func resourceCloudflareZoneCacheReserve() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneCacheReserveSchema(),
+		CreateContext: resourceCloudflareZoneCacheReserveUpdate,
+		ReadContext:   resourceCloudflareZoneCacheReserveRead,
+		UpdateContext: resourceCloudflareZoneCacheReserveUpdate,
+		DeleteContext: resourceCloudflareZoneCacheReserveDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneCacheReserveImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a Cloudflare Cache Reserve resource. Cache Reserve stores
+			cacheable content in persistent storage so that it can be served
+			from cache for longer.
+		`),
+	}
+}
+
+func resourceCloudflareZoneCacheReserveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	res, err := client.Raw(ctx, http.MethodGet, zoneCacheReserveURI(zoneID), nil, nil)
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Cache Reserve for zone %q not found", zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	var setting zoneCacheReserve
+	if err := json.Unmarshal(res, &setting); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to unmarshal Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	d.Set("enabled", setting.Value == "on")
+
+	return nil
+}
+
+func resourceCloudflareZoneCacheReserveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	enabled := d.Get("enabled").(bool)
+
+	tflog.Debug(ctx, fmt.Sprintf("Setting Cache Reserve for zone %q to %t", zoneID, enabled))
+
+	if err := setZoneCacheReserve(ctx, client, zoneID, enabled); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	d.SetId(zoneID)
+
+	return resourceCloudflareZoneCacheReserveRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneCacheReserveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	tflog.Debug(ctx, fmt.Sprintf("Disabling Cache Reserve for zone %q", zoneID))
+
+	if err := setZoneCacheReserve(ctx, client, zoneID, false); err != nil {
+		return diag.FromErr(fmt.Errorf("error disabling Cache Reserve for zone %q: %w", zoneID, err))
+	}
+
+	return nil
+}
+
+func resourceCloudflareZoneCacheReserveImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	zoneID := d.Id()
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare Cache Reserve for zone %q", zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+
+	resourceCloudflareZoneCacheReserveRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func setZoneCacheReserve(ctx context.Context, client *cloudflare.API, zoneID string, enabled bool) error {
+	setting := zoneCacheReserve{Value: "off"}
+	if enabled {
+		setting.Value = "on"
+	}
+
+	_, err := client.Raw(ctx, http.MethodPatch, zoneCacheReserveURI(zoneID), setting, nil)
+	return err
+}
+
+func zoneCacheReserveURI(zoneID string) string {
+	return fmt.Sprintf("/zones/%s/cache/cache_reserve", zoneID)
+}
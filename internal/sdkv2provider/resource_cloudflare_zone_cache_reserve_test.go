@@ -0,0 +1,80 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCloudflareZoneCacheReserve_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone_cache_reserve." + rnd
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		CheckDestroy:      testAccCheckCloudflareZoneCacheReserveDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneCacheReserveConfig(rnd, zoneID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneCacheReserveConfig(rnd, zoneID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:      name,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudflareZoneCacheReserveConfig(rnd, zoneID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_cache_reserve" "%[1]s" {
+  zone_id = "%[2]s"
+  enabled = %[3]t
+}
+`, rnd, zoneID, enabled)
+}
+
+func testAccCheckCloudflareZoneCacheReserveDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*cloudflare.API)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cloudflare_zone_cache_reserve" {
+			continue
+		}
+
+		res, err := client.Raw(context.Background(), http.MethodGet, zoneCacheReserveURI(rs.Primary.ID), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		var setting zoneCacheReserve
+		if err := json.Unmarshal(res, &setting); err != nil {
+			return err
+		}
+		if setting.Value != "off" {
+			return fmt.Errorf("Cache Reserve is still enabled for zone %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
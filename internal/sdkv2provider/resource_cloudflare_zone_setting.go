@@ -0,0 +1,151 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZoneSetting() *schema.Resource {
+	return &schema.Resource{
+		Schema:        resourceCloudflareZoneSettingSchema(),
+		CreateContext: resourceCloudflareZoneSettingUpdate,
+		ReadContext:   resourceCloudflareZoneSettingRead,
+		UpdateContext: resourceCloudflareZoneSettingUpdate,
+		DeleteContext: resourceCloudflareZoneSettingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCloudflareZoneSettingImport,
+		},
+		Description: heredoc.Doc(`
+			Provides a resource which manages a single Cloudflare zone setting.
+			Unlike ` + "`cloudflare_zone_settings_override`" + `, each setting is
+			managed independently so different configurations can own
+			different settings of the same zone.
+
+			Zone settings can't be deleted, destroying this resource only
+			removes it from the Terraform state and leaves the setting as is.
+		`),
+	}
+}
+
+func resourceCloudflareZoneSettingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+
+	setting, err := client.ZoneSingleSetting(ctx, zoneID, d.Id())
+	if err != nil {
+		var notFoundError *cloudflare.NotFoundError
+		if errors.As(err, &notFoundError) {
+			tflog.Info(ctx, fmt.Sprintf("Zone setting %s not found for zone %s", d.Id(), zoneID))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q for zone %q: %w", d.Id(), zoneID, err))
+	}
+
+	value, err := flattenZoneSettingValue(setting.Value)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error flattening zone setting %q: %w", d.Id(), err))
+	}
+
+	d.Set("setting_id", setting.ID)
+	d.Set("value", value)
+	d.Set("editable", setting.Editable)
+	d.Set("modified_on", setting.ModifiedOn)
+
+	return nil
+}
+
+func resourceCloudflareZoneSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*cloudflare.API)
+	zoneID := d.Get(consts.ZoneIDSchemaKey).(string)
+	settingID := d.Get("setting_id").(string)
+
+	// The type of the value is only known to the API, use the current value
+	// to decide whether the configured value needs decoding.
+	current, err := client.ZoneSingleSetting(ctx, zoneID, settingID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading zone setting %q for zone %q: %w", settingID, zoneID, err))
+	}
+
+	value, err := expandZoneSettingValue(current.Value, d.Get("value").(string))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error expanding zone setting %q: %w", settingID, err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating zone setting %s for zone %s", settingID, zoneID))
+
+	if _, err := client.UpdateZoneSingleSetting(ctx, zoneID, settingID, cloudflare.ZoneSetting{Value: value}); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating zone setting %q for zone %q: %w", settingID, zoneID, err))
+	}
+
+	d.SetId(settingID)
+
+	return resourceCloudflareZoneSettingRead(ctx, d, meta)
+}
+
+func resourceCloudflareZoneSettingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Info(ctx, fmt.Sprintf("Zone setting %s can't be deleted, removing it from state only", d.Id()))
+
+	return nil
+}
+
+func resourceCloudflareZoneSettingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	attributes := strings.SplitN(d.Id(), "/", 2)
+
+	if len(attributes) != 2 {
+		return nil, fmt.Errorf("invalid id (\"%s\") specified, should be in format \"zoneID/settingID\"", d.Id())
+	}
+
+	zoneID, settingID := attributes[0], attributes[1]
+
+	tflog.Debug(ctx, fmt.Sprintf("Importing Cloudflare zone setting: id %s for zone %s", settingID, zoneID))
+
+	d.Set(consts.ZoneIDSchemaKey, zoneID)
+	d.SetId(settingID)
+
+	resourceCloudflareZoneSettingRead(ctx, d, meta)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandZoneSettingValue converts the configured value of a zone setting to
+// the type expected by the API. Settings currently holding a string take the
+// value as is, anything else is JSON decoded.
+func expandZoneSettingValue(current interface{}, value string) (interface{}, error) {
+	if _, ok := current.(string); ok {
+		return value, nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return nil, fmt.Errorf("value must be JSON encoded for settings which don't hold a string: %w", err)
+	}
+
+	return decoded, nil
+}
+
+// flattenZoneSettingValue converts the value of a zone setting to its string
+// representation, string values are kept as is and anything else is JSON
+// encoded.
+func flattenZoneSettingValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return s, nil
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
@@ -0,0 +1,136 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCloudflareZoneSetting_Basic(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("cloudflare_zone_setting.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "always_online", `"on"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "zone_id", zoneID),
+					resource.TestCheckResourceAttr(name, "setting_id", "always_online"),
+					resource.TestCheckResourceAttr(name, "value", "on"),
+					resource.TestCheckResourceAttr(name, "editable", "true"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "always_online", `"off"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "off"),
+				),
+			},
+			{
+				ResourceName:        name,
+				ImportState:         true,
+				ImportStateVerify:   true,
+				ImportStateIdPrefix: fmt.Sprintf("%s/", zoneID),
+			},
+		},
+	})
+}
+
+func TestAccCloudflareZoneSetting_JSONValue(t *testing.T) {
+	rnd := generateRandomResourceName()
+	zoneID := os.Getenv("CLOUDFLARE_ZONE_ID")
+	name := fmt.Sprintf("cloudflare_zone_setting.%s", rnd)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "browser_cache_ttl", "jsonencode(14400)"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "value", "14400"),
+				),
+			},
+			{
+				Config: testAccCloudflareZoneSettingConfig(rnd, zoneID, "minify", `jsonencode({ css = "on", html = "off", js = "on" })`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "setting_id", "minify"),
+				),
+			},
+			{
+				Config:   testAccCloudflareZoneSettingConfig(rnd, zoneID, "minify", `jsonencode({ js = "on", html = "off", css = "on" })`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestExpandZoneSettingValue(t *testing.T) {
+	testCases := map[string]struct {
+		current  interface{}
+		value    string
+		expected interface{}
+		err      bool
+	}{
+		"string":          {current: "off", value: "on", expected: "on"},
+		"numeric string":  {current: "1.0", value: "1.2", expected: "1.2"},
+		"number":          {current: float64(14400), value: "3600", expected: float64(3600)},
+		"object":          {current: map[string]interface{}{}, value: `{"css":"on"}`, expected: map[string]interface{}{"css": "on"}},
+		"list":            {current: []interface{}{}, value: `["ECDHE-RSA-AES128-GCM-SHA256"]`, expected: []interface{}{"ECDHE-RSA-AES128-GCM-SHA256"}},
+		"invalid encoded": {current: float64(14400), value: "on", err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := expandZoneSettingValue(tc.current, tc.value)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestFlattenZoneSettingValue(t *testing.T) {
+	testCases := map[string]struct {
+		value    interface{}
+		expected string
+	}{
+		"string": {value: "on", expected: "on"},
+		"number": {value: float64(14400), expected: "14400"},
+		"object": {value: map[string]interface{}{"css": "on", "js": "off"}, expected: `{"css":"on","js":"off"}`},
+		"list":   {value: []interface{}{"a", "b"}, expected: `["a","b"]`},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := flattenZoneSettingValue(tc.value)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func testAccCloudflareZoneSettingConfig(rnd, zoneID, settingID, value string) string {
+	return fmt.Sprintf(`
+resource "cloudflare_zone_setting" "%[1]s" {
+  zone_id    = "%[2]s"
+  setting_id = "%[3]s"
+  value      = %[4]s
+}
+`, rnd, zoneID, settingID, value)
+}
@@ -2,6 +2,7 @@ package sdkv2provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"testing"
@@ -10,7 +11,9 @@ import (
 
 	cloudflare "github.com/cloudflare/cloudflare-go"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareZoneSettingsOverride_Full(t *testing.T) {
@@ -216,3 +219,46 @@ resource "cloudflare_zone_settings_override" "%[1]s" {
 	}
 }`, rnd, zoneID)
 }
+
+func TestZoneSettingsOverrideSecurityHeaderRoundTrip(t *testing.T) {
+	hsts := map[string]interface{}{
+		"enabled":            true,
+		"preload":            true,
+		"max_age":            31536000,
+		"include_subdomains": true,
+		"nosniff":            false,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSettingsOverrideSchema(), map[string]interface{}{
+		"zone_id": "023e105f4ecef8ad9ca31a8372d0c353",
+		"settings": []interface{}{map[string]interface{}{
+			"security_level":  "high",
+			"security_header": []interface{}{hsts},
+		}},
+	})
+
+	value, err := expandZoneSetting(d, "settings.0.%s", "security_header", d.Get("settings.0.security_header"), nil)
+	assert.NoError(t, err)
+
+	// Send the value through JSON like the API does so that numbers come back
+	// as float64.
+	body, err := json.Marshal(value)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"strict_transport_security":{"enabled":true,"preload":true,"max_age":31536000,"include_subdomains":true,"nosniff":false}}`, string(body))
+
+	var returned interface{}
+	assert.NoError(t, json.Unmarshal(body, &returned))
+
+	settings := flattenZoneSettings(context.Background(), d, []cloudflare.ZoneSetting{
+		{ID: "security_level", Value: "high"},
+		{ID: "security_header", Value: returned},
+	}, false)
+	assert.NoError(t, d.Set("settings", settings))
+
+	assert.Equal(t, "high", d.Get("settings.0.security_level"))
+	assert.Equal(t, true, d.Get("settings.0.security_header.0.enabled"))
+	assert.Equal(t, true, d.Get("settings.0.security_header.0.preload"))
+	assert.Equal(t, 31536000, d.Get("settings.0.security_header.0.max_age"))
+	assert.Equal(t, true, d.Get("settings.0.security_header.0.include_subdomains"))
+	assert.Equal(t, false, d.Get("settings.0.security_header.0.nosniff"))
+}
@@ -1,11 +1,19 @@
 package sdkv2provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccCloudflareZone_Basic(t *testing.T) {
@@ -78,6 +86,253 @@ func TestAccCloudflareZone_WithPlan(t *testing.T) {
 	})
 }
 
+func TestAccCloudflareZone_UpdatePlan(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone." + rnd
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	var zoneID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testZoneConfigWithPlan(rnd, fmt.Sprintf("%s.cfapi.net", rnd), "false", "false", planIDFree, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "plan", planIDFree),
+					testAccCheckCloudflareZoneID(name, &zoneID, false),
+				),
+			},
+			{
+				Config: testZoneConfigWithPlan(rnd, fmt.Sprintf("%s.cfapi.net", rnd), "false", "false", planIDPro, accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "plan", planIDPro),
+					testAccCheckCloudflareZoneID(name, &zoneID, true),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckCloudflareZoneID records the ID of the zone or, when compare is
+// set, checks that it is the same as the recorded one.
+func testAccCheckCloudflareZoneID(name string, zoneID *string, compare bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if compare && rs.Primary.ID != *zoneID {
+			return fmt.Errorf("zone was recreated: ID changed from %s to %s", *zoneID, rs.Primary.ID)
+		}
+		*zoneID = rs.Primary.ID
+
+		return nil
+	}
+}
+
+func TestResourceCloudflareZoneUpdatePlanOfPendingZone(t *testing.T) {
+	var subscriptionRequests []string
+	zone := cloudflare.Zone{
+		ID:          "023e105f4ecef8ad9ca31a8372d0c353",
+		Name:        "example.com",
+		Status:      "pending",
+		Type:        "full",
+		Plan:        cloudflare.ZonePlan{ZonePlanCommon: cloudflare.ZonePlanCommon{Name: "Free Website"}, LegacyID: planIDFree},
+		PlanPending: cloudflare.ZonePlan{ZonePlanCommon: cloudflare.ZonePlanCommon{Name: "Free Website"}, LegacyID: planIDFree},
+	}
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/zones/"+zone.ID+"/subscription" {
+			subscriptionRequests = append(subscriptionRequests, r.Method)
+			zone.Plan = cloudflare.ZonePlan{ZonePlanCommon: cloudflare.ZonePlanCommon{Name: "Pro Website"}, LegacyID: planIDPro}
+			zone.PlanPending = zone.Plan
+		}
+
+		testclient.WriteResult(w, zone)
+	}))
+
+	d := schema.TestResourceDataRaw(t, resourceCloudflareZoneSchema(), map[string]interface{}{
+		"zone": zone.Name,
+		"plan": planIDPro,
+	})
+	d.SetId(zone.ID)
+
+	diags := resourceCloudflareZoneUpdate(context.Background(), d, client)
+
+	assert.False(t, diags.HasError())
+	assert.Equal(t, []string{http.MethodPost}, subscriptionRequests)
+	assert.Equal(t, planIDPro, d.Get("plan"))
+}
+
+func TestAccCloudflareZone_TogglePaused(t *testing.T) {
+	rnd := generateRandomResourceName()
+	name := "cloudflare_zone." + rnd
+	zoneName := fmt.Sprintf("%s.cfapi.net", rnd)
+	accountID := os.Getenv("CLOUDFLARE_ACCOUNT_ID")
+	var zoneID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testZoneConfig(rnd, zoneName, "false", "false", accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "paused", "false"),
+					resource.TestCheckResourceAttrSet(name, "status"),
+					testAccCheckCloudflareZoneID(name, &zoneID, false),
+				),
+			},
+			{
+				Config: testZoneConfig(rnd, zoneName, "true", "false", accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "paused", "true"),
+					testAccCheckCloudflareZoneID(name, &zoneID, true),
+				),
+			},
+			{
+				Config: testZoneConfig(rnd, zoneName, "false", "false", accountID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(name, "paused", "false"),
+					testAccCheckCloudflareZoneID(name, &zoneID, true),
+				),
+			},
+		},
+	})
+}
+
+func TestSetZonePausedOnPendingZone(t *testing.T) {
+	zone := cloudflare.Zone{
+		ID:     "023e105f4ecef8ad9ca31a8372d0c353",
+		Name:   "example.com",
+		Status: "pending",
+	}
+
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			testclient.WriteErrors(w, http.StatusBadRequest, cloudflare.ResponseInfo{Code: 1052, Message: "Zone is not active"})
+			return
+		}
+
+		testclient.WriteResult(w, zone)
+	}))
+
+	diags := setZonePaused(context.Background(), client, zone.ID, true)
+
+	assert.Len(t, diags, 1)
+	assert.Equal(t, "Pending zone cannot be paused", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Zone is not active (1052)")
+}
+
+func TestResourceCloudflareZoneActivateDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"zone":       "example.com",
+		"type":       "partial",
+		"activate":   true,
+	})
+
+	_, err := resourceCloudflareZone().Diff(context.Background(), nil, config, nil)
+	assert.ErrorContains(t, err, "activate cannot be set when creating a zone")
+
+	state := &terraform.InstanceState{
+		ID: "023e105f4ecef8ad9ca31a8372d0c353",
+		Attributes: map[string]string{
+			"id":         "023e105f4ecef8ad9ca31a8372d0c353",
+			"account_id": "f037e56e89293a057740de681ac9abbe",
+			"zone":       "example.com",
+			"type":       "partial",
+			"activate":   "true",
+			"paused":     "false",
+			"plan":       planIDFree,
+			"status":     "pending",
+
+			"meta.%":                "0",
+			"name_servers.#":        "0",
+			"vanity_name_servers.#": "0",
+		},
+	}
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"account_id": "f037e56e89293a057740de681ac9abbe",
+		"zone":       "example.com",
+		"type":       "partial",
+		"activate":   true,
+		"plan":       planIDFree,
+	})
+
+	diff, err := resourceCloudflareZone().Diff(context.Background(), state, config, nil)
+	assert.NoError(t, err)
+	assert.True(t, diff == nil || diff.Empty(), "a pending zone must not keep planning changes")
+}
+
+func TestActivateZone(t *testing.T) {
+	zone := cloudflare.Zone{
+		ID:              "023e105f4ecef8ad9ca31a8372d0c353",
+		Name:            "example.com",
+		Status:          "pending",
+		Type:            "partial",
+		VerificationKey: "484809-1f1ba6f4-4bd4-4a6e-8d3b-9e4a4d2f9c1e",
+	}
+
+	testCases := map[string]struct {
+		activeAfter     int
+		activationErr   bool
+		expectedSummary string
+		expectedDetail  string
+	}{
+		"activated": {
+			activeAfter: 2,
+		},
+		"activation check rejected": {
+			activationErr:   true,
+			expectedSummary: "You may only perform this action once per hour. (1224)",
+		},
+		"still pending": {
+			expectedSummary: "Zone activation failed",
+			expectedDetail:  `"cloudflare-verify.example.com" with the value "484809-1f1ba6f4-4bd4-4a6e-8d3b-9e4a4d2f9c1e"`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			activationChecks, lookups := 0, 0
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodPut && r.URL.Path == "/zones/"+zone.ID+"/activation_check" {
+					activationChecks++
+					if tc.activationErr {
+						testclient.WriteErrors(w, http.StatusBadRequest, cloudflare.ResponseInfo{Code: 1224, Message: "You may only perform this action once per hour."})
+						return
+					}
+					testclient.WriteResult(w, cloudflare.Zone{ID: zone.ID})
+					return
+				}
+
+				lookups++
+				current := zone
+				if tc.activeAfter > 0 && lookups >= tc.activeAfter {
+					current.Status = "active"
+				}
+
+				testclient.WriteResult(w, current)
+			}))
+
+			diags := activateZone(context.Background(), client, zone.ID, 2*time.Second)
+
+			assert.Equal(t, 1, activationChecks)
+			if tc.expectedSummary == "" {
+				assert.False(t, diags.HasError())
+				return
+			}
+
+			assert.Len(t, diags, 1)
+			assert.Contains(t, diags[0].Summary, tc.expectedSummary)
+			assert.Contains(t, diags[0].Detail, tc.expectedDetail)
+		})
+	}
+}
+
 func TestAccCloudflareZone_PartialSetup(t *testing.T) {
 	rnd := generateRandomResourceName()
 	name := "cloudflare_zone." + rnd
@@ -2,6 +2,7 @@ package sdkv2provider
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/cloudflare/cloudflare-go"
@@ -224,6 +225,33 @@ func resourceCloudflareAccessApplicationSchema() map[string]*schema.Schema {
 			Default:     false,
 			Description: "Option to return a 401 status code in service authentication rules on failed requests.",
 		},
+		"fail_on_domain_conflict": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to fail the creation of the application when another Access Application already uses the same `domain`.",
+		},
+		"policies": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "The reusable Access policies to attach to the application. Policies are evaluated in the order they are defined unless an explicit `precedence` is set.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ID of the reusable Access policy.",
+					},
+					"precedence": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+						Description:  "The order of execution for the policy. Defaults to the position of the policy in the list.",
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -328,3 +356,44 @@ func convertSaasStructToSchema(d *schema.ResourceData, app *cloudflare.SaasAppli
 
 	return []interface{}{m}
 }
+
+func convertAccessApplicationPoliciesSchemaToStruct(d *schema.ResourceData) []accessApplicationPolicy {
+	policies := []accessApplicationPolicy{}
+
+	for i, p := range d.Get("policies").([]interface{}) {
+		policy := p.(map[string]interface{})
+		precedence := policy["precedence"].(int)
+		if precedence == 0 {
+			precedence = i + 1
+		}
+
+		policies = append(policies, accessApplicationPolicy{
+			ID:         policy["id"].(string),
+			Precedence: precedence,
+		})
+	}
+
+	return policies
+}
+
+func convertAccessApplicationPoliciesStructToSchema(policies []accessApplicationPolicy) []interface{} {
+	sort.SliceStable(policies, func(i, j int) bool {
+		return policies[i].Precedence < policies[j].Precedence
+	})
+
+	var data []interface{}
+	for _, policy := range policies {
+		// Policies that are scoped to this application only are managed by
+		// `cloudflare_access_policy` and not reflected here.
+		if policy.Reusable == nil || !*policy.Reusable {
+			continue
+		}
+
+		data = append(data, map[string]interface{}{
+			"id":         policy.ID,
+			"precedence": policy.Precedence,
+		})
+	}
+
+	return data
+}
@@ -11,9 +11,18 @@ import (
 func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"application_id": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The ID of the application the policy is associated with.",
+			Type:          schema.TypeString,
+			Optional:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"reusable"},
+			Description:   "The ID of the application the policy is associated with. Required unless `reusable` is set.",
+		},
+		"reusable": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"application_id", consts.ZoneIDSchemaKey},
+			Description:   "Whether the policy is an account level policy which can be attached to multiple applications using the `policies` attribute of `cloudflare_access_application`.",
 		},
 		consts.AccountIDSchemaKey: {
 			Description:   "The account identifier to target for the resource.",
@@ -36,8 +45,8 @@ func resourceCloudflareAccessPolicySchema() map[string]*schema.Schema {
 		},
 		"precedence": {
 			Type:        schema.TypeInt,
-			Required:    true,
-			Description: "The unique precedence for policies on a single application.",
+			Optional:    true,
+			Description: "The unique precedence for policies on a single application. Required unless `reusable` is set.",
 		},
 		"decision": {
 			Type:         schema.TypeString,
@@ -20,7 +20,26 @@ func resourceCloudflareAccountSchema() map[string]*schema.Schema {
 			Description:  fmt.Sprintf("Account type. %s", renderAvailableDocumentationValuesStringSlice([]string{accountTypeEnterprise, accountTypeStandard})),
 			Default:      accountTypeStandard,
 			ValidateFunc: validation.StringInSlice([]string{accountTypeEnterprise, accountTypeStandard}, false),
-			ForceNew:     true, // "Updating account type is not supported from client api"
+		},
+		"unit": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "The tenant unit the account is created in. Only applicable to enterprise sub-accounts and cannot be changed once the account has been created.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The identifier of the tenant unit.",
+					},
+				},
+			},
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the account was created.",
 		},
 		"enforce_twofactor": {
 			Description: "Whether 2FA is enforced on the account.",
@@ -2,11 +2,15 @@ package sdkv2provider
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var accountMemberIdentifierRegexp = regexp.MustCompile(`^[a-f0-9]{32}$`)
+
 func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.AccountIDSchemaKey: {
@@ -20,10 +24,58 @@ func resourceCloudflareAccountMemberSchema() map[string]*schema.Schema {
 			Description: "The email address of the user who you wish to manage. Following creation, this field becomes read only via the API and cannot be updated.",
 		},
 		"role_ids": {
-			Type:        schema.TypeSet,
-			Required:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "List of account role IDs that you want to assign to a member.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(accountMemberIdentifierRegexp, "must be a 32 character hexadecimal account role ID"),
+			},
+			ExactlyOneOf: []string{"role_ids", "roles", "policies"},
+			Description:  "List of account role IDs that you want to assign to a member.",
+		},
+		"roles": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			ExactlyOneOf: []string{"role_ids", "roles", "policies"},
+			Description:  "List of account role names that you want to assign to a member. The names are resolved to account role IDs when applied.",
+		},
+		"policies": {
+			Type:         schema.TypeSet,
+			Optional:     true,
+			ExactlyOneOf: []string{"role_ids", "roles", "policies"},
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"access": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"allow", "deny"}, false),
+						Description:  fmt.Sprintf("Whether the policy grants or denies the permissions. %s", renderAvailableDocumentationValuesStringSlice([]string{"allow", "deny"})),
+					},
+					"permission_group_ids": {
+						Type:     schema.TypeSet,
+						Required: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(accountMemberIdentifierRegexp, "must be a 32 character hexadecimal permission group ID"),
+						},
+						Description: "List of permission group IDs granted by the policy.",
+					},
+					"resource_group_ids": {
+						Type:     schema.TypeSet,
+						Required: true,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.StringMatch(accountMemberIdentifierRegexp, "must be a 32 character hexadecimal resource group ID"),
+						},
+						Description: "List of resource group IDs defining the scope the policy applies to.",
+					},
+				},
+			},
+			Description: "Policies granting the member access to resources.",
 		},
 		"status": {
 			Type:        schema.TypeString,
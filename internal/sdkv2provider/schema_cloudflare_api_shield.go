@@ -8,7 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
-func resourceCloudflareAPIShieldSchema() map[string]*schema.Schema {
+func resourceCloudflareAPIShieldConfigurationSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.ZoneIDSchemaKey: {
 			Description: "The zone identifier to target for the resource.",
@@ -0,0 +1,51 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareAPIShieldSchemaSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Name of the schema.",
+		},
+		"kind": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      apiShieldSchemaKindOpenAPIV3,
+			ValidateFunc: validation.StringInSlice([]string{apiShieldSchemaKindOpenAPIV3}, false),
+			Description:  fmt.Sprintf("Kind of schema. %s", renderAvailableDocumentationValuesStringSlice([]string{apiShieldSchemaKindOpenAPIV3})),
+		},
+		"source": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Schema file bytes.",
+		},
+		"validation_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Flag whether schema is enabled for validation.",
+		},
+		"schema_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Identifier of the uploaded schema.",
+		},
+	}
+}
@@ -18,9 +18,15 @@ func resourceCloudflareApiTokenSchema() map[string]*schema.Schema {
 			},
 			"permission_groups": {
 				Type:        schema.TypeSet,
-				Required:    true,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of permissions groups IDs. See [documentation](https://developers.cloudflare.com/api/tokens/create/permissions) for more information. At least one of `permission_groups` or `permission_group_names` must be set.",
+			},
+			"permission_group_names": {
+				Type:        schema.TypeSet,
+				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
-				Description: "List of permissions groups IDs. See [documentation](https://developers.cloudflare.com/api/tokens/create/permissions) for more information.",
+				Description: "List of permissions group names such as `DNS Read`, resolved to their IDs when the token is created or updated. When a name is shared by permission groups of different scopes, the group matching the scope of `resources` is used. At least one of `permission_groups` or `permission_group_names` must be set.",
 			},
 			"effect": {
 				Type:         schema.TypeString,
@@ -19,18 +19,26 @@ func resourceCloudflareArgoTunnelSchema() map[string]*schema.Schema {
 			ForceNew: true,
 		},
 		"secret": {
-			Type:      schema.TypeString,
-			Required:  true,
-			Sensitive: true,
-			ForceNew:  true,
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "32 or more bytes, encoded as a base64 string. Changing the secret recreates the tunnel unless `rotate_secret` is enabled.",
+		},
+		"rotate_secret": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether changes to `secret` rotate the secret of the existing tunnel instead of recreating it.",
 		},
 		"cname": {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
 		"tunnel_token": {
-			Type:     schema.TypeString,
-			Computed: true,
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Token used by a connector to authenticate and run the tunnel.",
 		},
 	}
 }
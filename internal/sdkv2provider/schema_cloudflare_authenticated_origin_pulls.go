@@ -14,14 +14,16 @@ func resourceCloudflareAuthenticatedOriginPullsSchema() map[string]*schema.Schem
 			ForceNew:    true,
 		},
 		"hostname": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "Specify a hostname to enable Per-Hostname Authenticated Origin Pulls on, using the provided certificate.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			RequiredWith: []string{"authenticated_origin_pulls_certificate"},
+			Description:  "Specify a hostname to enable Per-Hostname Authenticated Origin Pulls on, using the provided certificate.",
 		},
 		"authenticated_origin_pulls_certificate": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "The ID of an uploaded Authenticated Origin Pulls certificate. If no hostname is provided, this certificate will be used zone wide as Per-Zone Authenticated Origin Pulls.",
+			Description: "The ID of an uploaded Authenticated Origin Pulls certificate. If no hostname is provided, this certificate will be used zone wide as Per-Zone Authenticated Origin Pulls. Without a certificate, Authenticated Origin Pulls is managed for the whole zone using the Cloudflare certificate. Adding or removing the certificate forces creation of a new resource.",
 		},
 		"enabled": {
 			Type:        schema.TypeBool,
@@ -0,0 +1,78 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	botManagementAutomatedActions   = []string{"allow", "block", "managed_challenge"}
+	botManagementVerifiedBotActions = []string{"allow", "block"}
+	botManagementAIBotsProtections  = []string{"block", "disabled"}
+)
+
+func resourceCloudflareBotManagementSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enable_js": {
+			Description: "Use lightweight, invisible JavaScript detections to improve Bot Management.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"fight_mode": {
+			Description: "Whether to enable Bot Fight Mode.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"sbfm_definitely_automated": {
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on definitely automated requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementAutomatedActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementAutomatedActions, false),
+		},
+		"sbfm_likely_automated": {
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on likely automated requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementAutomatedActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementAutomatedActions, false),
+		},
+		"sbfm_verified_bots": {
+			Description:  fmt.Sprintf("Super Bot Fight Mode (SBFM) action to take on verified bots requests. %s", renderAvailableDocumentationValuesStringSlice(botManagementVerifiedBotActions)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementVerifiedBotActions, false),
+		},
+		"optimize_wordpress": {
+			Description: "Whether to optimize Super Bot Fight Mode protections for Wordpress.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"auto_update_model": {
+			Description: "Automatically update to the newest bot detection models created by Cloudflare as they are released.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+		},
+		"ai_bots_protection": {
+			Description:  fmt.Sprintf("Whether to block AI bots and crawlers. %s", renderAvailableDocumentationValuesStringSlice(botManagementAIBotsProtections)),
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(botManagementAIBotsProtections, false),
+		},
+	}
+}
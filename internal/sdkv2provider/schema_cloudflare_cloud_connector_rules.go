@@ -0,0 +1,71 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareCloudConnectorRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Required:    true,
+			Description: "The Cloud Connector rules of the zone, evaluated in the order they are listed.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The identifier of the rule.",
+					},
+					"expression": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The criteria for the rule to match a request.",
+					},
+					"provider": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(cloudConnectorRuleProviders, false),
+						Description:  fmt.Sprintf("The cloud provider matching requests are routed to. %s", renderAvailableDocumentationValuesStringSlice(cloudConnectorRuleProviders)),
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "A description of the rule.",
+					},
+					"enabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+						Description: "Whether the rule is enabled.",
+					},
+					"parameters": {
+						Type:        schema.TypeList,
+						Required:    true,
+						MaxItems:    1,
+						Description: "The parameters of the rule.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"host": {
+									Type:        schema.TypeString,
+									Required:    true,
+									Description: "The host of the cloud provider bucket or storage account requests are routed to.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
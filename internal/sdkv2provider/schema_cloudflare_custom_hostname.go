@@ -165,10 +165,18 @@ func resourceCloudflareCustomHostnameSchema() map[string]*schema.Schema {
 			},
 		},
 		"wait_for_ssl_pending_validation": {
-			Type:        schema.TypeBool,
-			Optional:    true,
-			Default:     false,
-			Description: "Whether to wait for a custom hostname SSL sub-object to reach status `pending_validation` during creation.",
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"wait_for_ssl_active"},
+			Description:   "Whether to wait for a custom hostname SSL sub-object to reach status `pending_validation` during creation.",
+		},
+		"wait_for_ssl_active": {
+			Type:          schema.TypeBool,
+			Optional:      true,
+			Default:       false,
+			ConflictsWith: []string{"wait_for_ssl_pending_validation"},
+			Description:   "Whether to wait for a custom hostname SSL sub-object to reach status `active` during creation and updates. Fails early if validation or issuance of the certificate times out.",
 		},
 	}
 }
@@ -45,10 +45,11 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 						Description: "Certificate certificate and the intermediate(s).",
 					},
 					"private_key": {
-						Type:        schema.TypeString,
-						Optional:    true,
-						Sensitive:   true,
-						Description: "Certificate's private key.",
+						Type:             schema.TypeString,
+						Optional:         true,
+						Sensitive:        true,
+						DiffSuppressFunc: suppressCustomSSLPrivateKeyDiff,
+						Description:      "Certificate's private key.",
 					},
 					"bundle_method": {
 						Type:         schema.TypeString,
@@ -90,6 +91,12 @@ func resourceCloudflareCustomSslSchema() map[string]*schema.Schema {
 			Type:     schema.TypeString,
 			Computed: true,
 		},
+		"wait_for_active": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Whether to wait for the certificate to become active before completing the create. Certificates ending up in a non-recoverable state (such as failing validation) will return an error.",
+		},
 		"uploaded_on": {
 			Type:     schema.TypeString,
 			Computed: true,
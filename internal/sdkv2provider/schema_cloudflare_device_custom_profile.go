@@ -0,0 +1,29 @@
+package sdkv2provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceCloudflareDeviceCustomProfileSchema reuses the device settings
+// policy schema but, as custom profiles are never the default account
+// policy, `match` and `precedence` are always required and `default` is only
+// exposed for reference.
+func resourceCloudflareDeviceCustomProfileSchema() map[string]*schema.Schema {
+	s := resourceCloudflareDeviceSettingsPolicySchema()
+
+	s["default"] = &schema.Schema{
+		Description: "Whether the policy refers to the default account policy. Always `false` for custom profiles.",
+		Type:        schema.TypeBool,
+		Computed:    true,
+	}
+
+	s["match"].Optional = false
+	s["match"].Required = true
+
+	s["precedence"].Optional = false
+	s["precedence"].Required = true
+
+	s["enabled"].Description = "Whether the policy is enabled."
+
+	return s
+}
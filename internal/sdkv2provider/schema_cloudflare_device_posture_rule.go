@@ -18,8 +18,8 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 		"type": {
 			Type:         schema.TypeString,
 			Required:     true,
-			ValidateFunc: validation.StringInSlice([]string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s"}, false),
-			Description:  fmt.Sprintf("The device posture rule type. %s", renderAvailableDocumentationValuesStringSlice([]string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s"})),
+			ValidateFunc: validation.StringInSlice([]string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s", "intune", "kolide", "tanium", "sentinelone_s2s"}, false),
+			Description:  fmt.Sprintf("The device posture rule type. %s", renderAvailableDocumentationValuesStringSlice([]string{"serial_number", "file", "application", "gateway", "warp", "domain_joined", "os_version", "disk_encryption", "firewall", "workspace_one", "unique_client_id", "crowdstrike_s2s", "intune", "kolide", "tanium", "sentinelone_s2s"})),
 		},
 		"name": {
 			Type:        schema.TypeString,
@@ -99,6 +99,12 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 						Computed:    true,
 						Description: "True if all drives must be encrypted.",
 					},
+					"check_disks": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of volume names to be checked for encryption.",
+					},
 					"enabled": {
 						Type:        schema.TypeBool,
 						Optional:    true,
@@ -124,13 +130,13 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 					"connection_id": {
 						Type:        schema.TypeString,
 						Optional:    true,
-						Description: "The workspace one connection id.",
+						Description: "The device posture integration connection id.",
 					},
 					"compliance_status": {
 						Type:         schema.TypeString,
 						Optional:     true,
-						ValidateFunc: validation.StringInSlice([]string{"compliant", "noncompliant"}, true),
-						Description:  fmt.Sprintf("The workspace one device compliance status. %s", renderAvailableDocumentationValuesStringSlice([]string{"compliant", "noncompliant"})),
+						ValidateFunc: validation.StringInSlice([]string{"compliant", "noncompliant", "unknown", "notapplicable", "ingraceperiod", "error"}, true),
+						Description:  fmt.Sprintf("The workspace one or intune device compliance status. `compliant` and `noncompliant` are supported by workspace one. %s", renderAvailableDocumentationValuesStringSlice([]string{"compliant", "noncompliant", "unknown", "notapplicable", "ingraceperiod", "error"})),
 					},
 					"os_distro_name": {
 						Type:        schema.TypeString,
@@ -163,6 +169,80 @@ func resourceCloudflareDevicePostureRuleSchema() map[string]*schema.Schema {
 						ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<=", "=="}, true),
 						Description:  fmt.Sprintf("The version comparison operator for crowdstrike. %s", renderAvailableDocumentationValuesStringSlice([]string{">", ">=", "<", "<=", "=="})),
 					},
+					"last_seen": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The duration of time that the host was last seen from Crowdstrike. Must be in the format `1h` or `30m`. Valid units are `d`, `h` and `m`.",
+					},
+					"state": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"online", "offline", "unknown"}, true),
+						Description:  fmt.Sprintf("The host's current online status from Crowdstrike. %s", renderAvailableDocumentationValuesStringSlice([]string{"online", "offline", "unknown"})),
+					},
+					"issue_count": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The number of issues for kolide.",
+					},
+					"count_operator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<=", "=="}, true),
+						Description:  fmt.Sprintf("The count comparison operator for kolide. %s", renderAvailableDocumentationValuesStringSlice([]string{">", ">=", "<", "<=", "=="})),
+					},
+					"total_score": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(1, 100),
+						Description:  "The total score from Tanium. Value must be between 1 and 100.",
+					},
+					"score_operator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{">", ">=", "<", "<=", "=="}, true),
+						Description:  fmt.Sprintf("The score comparison operator for tanium. %s", renderAvailableDocumentationValuesStringSlice([]string{">", ">=", "<", "<=", "=="})),
+					},
+					"risk_level": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"low", "medium", "high", "critical"}, true),
+						Description:  fmt.Sprintf("The risk level from Tanium. %s", renderAvailableDocumentationValuesStringSlice([]string{"low", "medium", "high", "critical"})),
+					},
+					"eid_last_seen": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The datetime a device was last seen by Tanium in RFC 3339 format.",
+					},
+					"active_threats": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "The number of active threats from SentinelOne.",
+					},
+					"infected": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "True if a SentinelOne device is infected.",
+					},
+					"is_active": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "True if a SentinelOne device is active.",
+					},
+					"network_status": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"connected", "disconnected", "disconnecting", "connecting"}, true),
+						Description:  fmt.Sprintf("The network status from SentinelOne. %s", renderAvailableDocumentationValuesStringSlice([]string{"connected", "disconnected", "disconnecting", "connecting"})),
+					},
+					"operational_state": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"na", "partially_disabled", "auto_fully_disabled", "fully_disabled", "auto_partially_disabled", "disabled_error", "db_corruption"}, true),
+						Description:  fmt.Sprintf("The current operational state of a SentinelOne agent. %s", renderAvailableDocumentationValuesStringSlice([]string{"na", "partially_disabled", "auto_fully_disabled", "fully_disabled", "auto_partially_disabled", "disabled_error", "db_corruption"})),
+					},
 				},
 			},
 		},
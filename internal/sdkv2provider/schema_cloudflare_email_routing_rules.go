@@ -1,6 +1,8 @@
 package sdkv2provider
 
 import (
+	"fmt"
+
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -42,18 +44,18 @@ func resourceCloudflareEmailRoutingRuleSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"type": {
-						Description:  "Type of matcher.",
+						Description:  fmt.Sprintf("Type of matcher. %s", renderAvailableDocumentationValuesStringSlice(emailRoutingRuleMatcherTypes)),
 						Type:         schema.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringInSlice([]string{"literal", "all"}, true),
+						ValidateFunc: validation.StringInSlice(emailRoutingRuleMatcherTypes, true),
 					},
 					"field": {
-						Description: "Field for type matcher.",
+						Description: "Field for type matcher. Required for `literal` matchers.",
 						Type:        schema.TypeString,
 						Optional:    true,
 					},
 					"value": {
-						Description:  "Value for matcher.",
+						Description:  "Value for matcher. Required for `literal` matchers.",
 						Type:         schema.TypeString,
 						Optional:     true,
 						ValidateFunc: validation.StringLenBetween(0, 90),
@@ -68,15 +70,15 @@ func resourceCloudflareEmailRoutingRuleSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"type": {
-						Description:  "Type of supported action.",
+						Description:  fmt.Sprintf("Type of supported action. %s", renderAvailableDocumentationValuesStringSlice(emailRoutingRuleActionTypes)),
 						Type:         schema.TypeString,
 						Required:     true,
-						ValidateFunc: validation.StringInSlice([]string{"forward", "worker"}, true),
+						ValidateFunc: validation.StringInSlice(emailRoutingRuleActionTypes, true),
 					},
 					"value": {
-						Description: "An array with items in the following form.",
+						Description: "An array with items in the following form. Required for `forward` and `worker` actions, which take the destination addresses and the name of the Worker respectively.",
 						Type:        schema.TypeList,
-						Required:    true,
+						Optional:    true,
 						Elem: &schema.Schema{
 							Type:         schema.TypeString,
 							ValidateFunc: validation.StringLenBetween(0, 90),
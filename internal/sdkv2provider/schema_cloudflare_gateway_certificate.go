@@ -0,0 +1,70 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareGatewayCertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"gateway_managed": {
+			Type:         schema.TypeBool,
+			Optional:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"gateway_managed", "custom"},
+			Description:  "Whether the certificate is generated and managed by Gateway.",
+		},
+		"custom": {
+			Type:         schema.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"gateway_managed", "custom"},
+			Description:  "A custom root CA to upload for use by Gateway inspection.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"certificate": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+						Description: "The PEM encoded root CA certificate.",
+					},
+					"private_key": {
+						Type:        schema.TypeString,
+						Required:    true,
+						ForceNew:    true,
+						Sensitive:   true,
+						Description: "The PEM encoded private key of the root CA certificate.",
+					},
+				},
+			},
+		},
+		"activate": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the certificate should be activated and deployed to the Cloudflare edge for use in Gateway inspection.",
+		},
+		"certificate": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The PEM encoded root CA certificate used by Gateway inspection.",
+		},
+		"expires_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the certificate expires.",
+		},
+		"binding_status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The deployment status of the certificate on the Cloudflare edge.",
+		},
+	}
+}
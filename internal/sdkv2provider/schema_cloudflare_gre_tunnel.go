@@ -65,7 +65,7 @@ func resourceCloudflareGRETunnelSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Computed:    true,
-			Description: "The IP address of the customer endpoint that will receive tunnel health checks.",
+			Description: "The IP address of the customer endpoint that will receive tunnel health checks. Defaults to `customer_gre_endpoint` when not set.",
 		},
 		"health_check_type": {
 			Type:         schema.TypeString,
@@ -74,5 +74,11 @@ func resourceCloudflareGRETunnelSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice([]string{"request", "reply"}, false),
 			Description:  fmt.Sprintf("Specifies the ICMP echo type for the health check. %s", renderAvailableDocumentationValuesStringSlice([]string{"request", "reply"})),
 		},
+		"replace": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether changes to `customer_gre_endpoint`, `cloudflare_gre_endpoint` or `interface_address` replace the tunnel instead of updating it in place.",
+		},
 	}
 }
@@ -2,6 +2,7 @@ package sdkv2provider
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,6 +13,9 @@ var healthcheckRegions = []string{"WNAM", "ENAM", "WEU", "EEU", "NSAM", "SSAM",
 var healthcheckType = []string{"TCP", "HTTP", "HTTPS"}
 var healthcheckMethod = []string{"connection_established", "GET", "HEAD"}
 
+// healthcheckHTTPAttributes only apply to HTTP and HTTPS health checks.
+var healthcheckHTTPAttributes = []string{"path", "expected_codes", "expected_body", "follow_redirects", "allow_insecure", "header"}
+
 func resourceCloudflareHealthcheckSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.ZoneIDSchemaKey: {
@@ -42,16 +46,18 @@ func resourceCloudflareHealthcheckSchema() map[string]*schema.Schema {
 			Required:    true,
 		},
 		"consecutive_fails": {
-			Description: "The number of consecutive fails required from a health check before changing the health to unhealthy.",
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     1,
+			Description:  "The number of consecutive fails required from a health check before changing the health to unhealthy.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"consecutive_successes": {
-			Description: "The number of consecutive successes required from a health check before changing the health to healthy.",
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Default:     1,
+			Description:  "The number of consecutive successes required from a health check before changing the health to healthy.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"retries": {
 			Description: "The number of retries to attempt in case of a timeout before marking the origin as unhealthy. Retries are attempted immediately.",
@@ -89,7 +95,7 @@ func resourceCloudflareHealthcheckSchema() map[string]*schema.Schema {
 			ValidateFunc: validation.StringInSlice(healthcheckType, false),
 		},
 		"method": {
-			Description:  fmt.Sprintf("The HTTP method to use for the health check. %s", renderAvailableDocumentationValuesStringSlice(healthcheckMethod)),
+			Description:  fmt.Sprintf("The HTTP method to use for the health check. TCP health checks only support `connection_established` while HTTP and HTTPS health checks support `GET` and `HEAD`. %s", renderAvailableDocumentationValuesStringSlice(healthcheckMethod)),
 			Type:         schema.TypeString,
 			Optional:     true,
 			Computed:     true,
@@ -113,7 +119,8 @@ func resourceCloudflareHealthcheckSchema() map[string]*schema.Schema {
 			Type:        schema.TypeList,
 			Optional:    true,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`), "must be a HTTP status code such as 200 or a range such as 2xx"),
 			},
 		},
 		"expected_body": {
@@ -0,0 +1,100 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareHyperdriveConfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Hyperdrive configuration.",
+		},
+		"origin": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "The origin database to connect to.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"host": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The host (hostname or IP) of your origin database.",
+					},
+					"port": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IsPortNumber,
+						Description:  "The port of your origin database.",
+					},
+					"database": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The name of your origin database.",
+					},
+					"scheme": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "postgres",
+						ValidateFunc: validation.StringInSlice(hyperdriveConfigOriginSchemes, false),
+						Description:  fmt.Sprintf("The URL scheme used to connect to your origin database. %s", renderAvailableDocumentationValuesStringSlice(hyperdriveConfigOriginSchemes)),
+					},
+					"user": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The user of your origin database.",
+					},
+					"password": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Sensitive:   true,
+						Description: "The password of your origin database.",
+					},
+				},
+			},
+		},
+		"caching": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The query caching settings of the Hyperdrive configuration.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disabled": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether query caching is disabled.",
+					},
+					"max_age": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The maximum duration, in seconds, items should persist in the cache.",
+					},
+					"stale_while_revalidate": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The number of seconds the cache may serve a stale response while revalidating.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -51,7 +51,7 @@ func resourceCloudflareIPsecTunnelSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 			Computed:    true,
-			Description: "The IP address of the customer endpoint that will receive tunnel health checks. Default: `<customer_gre_endpoint>`.",
+			Description: "The IP address of the customer endpoint that will receive tunnel health checks. Defaults to `customer_endpoint` when not set.",
 		},
 		"health_check_type": {
 			Type:         schema.TypeString,
@@ -0,0 +1,27 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareLeakedCredentialCheckRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"username": {
+			Description: "The ruleset expression to use in matching the username in a request, such as `lookup_json_string(http.request.body.raw, \"user\")`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"password": {
+			Description: "The ruleset expression to use in matching the password in a request, such as `lookup_json_string(http.request.body.raw, \"secret\")`.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+	}
+}
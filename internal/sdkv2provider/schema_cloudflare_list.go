@@ -42,6 +42,10 @@ func resourceCloudflareListSchema() map[string]*schema.Schema {
 	}
 }
 
+// listRedirectStatusCodes are the HTTP redirect status codes accepted for
+// redirect list items.
+var listRedirectStatusCodes = []int{301, 302, 307, 308}
+
 var listItemElem = &schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"value": {
@@ -58,6 +62,7 @@ var listItemElem = &schema.Resource{
 					"redirect": {
 						Type:     schema.TypeList,
 						Optional: true,
+						MaxItems: 1,
 						Elem: &schema.Resource{
 							Schema: map[string]*schema.Schema{
 								"source_url": {
@@ -83,9 +88,10 @@ var listItemElem = &schema.Resource{
 									ValidateFunc: validation.StringInSlice([]string{"disabled", "enabled"}, false),
 								},
 								"status_code": {
-									Description: "The status code to be used when redirecting a request.",
-									Type:        schema.TypeInt,
-									Optional:    true,
+									Description:  fmt.Sprintf("The status code to be used when redirecting a request. %s", renderAvailableDocumentationValuesIntSlice(listRedirectStatusCodes)),
+									Type:         schema.TypeInt,
+									Optional:     true,
+									ValidateFunc: validation.IntInSlice(listRedirectStatusCodes),
 								},
 								"preserve_query_string": {
 									Description:  fmt.Sprintf("Whether the redirect target url should keep the query string of the request's url. %s", renderAvailableDocumentationValuesStringSlice([]string{"disabled", "enabled"})),
@@ -470,11 +470,10 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		},
 
 		"proxied": {
-			Type:          schema.TypeBool,
-			Optional:      true,
-			Default:       false,
-			ConflictsWith: []string{"ttl"},
-			Description:   "Whether the hostname gets Cloudflare's origin protection.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the hostname gets Cloudflare's origin protection.",
 		},
 
 		"enabled": {
@@ -485,11 +484,10 @@ func resourceCloudflareLoadBalancerSchema() map[string]*schema.Schema {
 		},
 
 		"ttl": {
-			Type:          schema.TypeInt,
-			Optional:      true,
-			Computed:      true,
-			ConflictsWith: []string{"proxied"}, // this is set to zero regardless of config when proxied=true
-			Description:   "Time to live (TTL) of the DNS entry for the IP address returned by this load balancer. This cannot be set for proxied load balancers. Defaults to `30`.",
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "Time to live (TTL) of the DNS entry for the IP address returned by this load balancer. This cannot be set for proxied load balancers, which always use `0`. Defaults to `30`.",
 		},
 
 		"description": {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -91,9 +92,67 @@ func resourceCloudflareLogpushJobSchema() map[string]*schema.Schema {
 			Description: `Ownership challenge token to prove destination ownership, required when destination is Amazon S3, Google Cloud Storage, Microsoft Azure or Sumo Logic. See [Developer documentation](https://developers.cloudflare.com/logs/logpush/logpush-configuration-api/understanding-logpush-api/#usage).`,
 		},
 		"filter": {
-			Type:        schema.TypeString,
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+			Description:      "Use filters to select the events to include and/or remove from your logs. For more information, refer to [Filters](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/filters/).",
+		},
+		"output_options": {
+			Type:        schema.TypeList,
 			Optional:    true,
-			Description: "Use filters to select the events to include and/or remove from your logs. For more information, refer to [Filters](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/filters/).",
+			Computed:    true,
+			MaxItems:    1,
+			Description: "Structured replacement for `logpull_options`. When set, the fields and formatting of the pushed logs are configured with this block. See [Log Output Options](https://developers.cloudflare.com/logs/reference/logpush-api-configuration/#output-options).",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"field_names": {
+						Type:        schema.TypeList,
+						Optional:    true,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "List of field names to be included in the Logpush output.",
+					},
+					"timestamp_format": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.StringInSlice(logpushJobTimestampFormats, false),
+						Description:  fmt.Sprintf("Specifies the format for timestamps. %s", renderAvailableDocumentationValuesStringSlice(logpushJobTimestampFormats)),
+					},
+					"sample_rate": {
+						Type:         schema.TypeFloat,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.FloatBetween(0, 1),
+						Description:  "Specifies the sampling rate, between `0` and `1`. A value of `1` includes every log line.",
+					},
+					"cve_2021_44228": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Description: "Whether to escape the `${` sequence in log values to protect against [CVE-2021-44228](https://www.cve.org/CVERecord?id=CVE-2021-44228).",
+					},
+					"batch_prefix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be prepended before each batch.",
+					},
+					"batch_suffix": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be appended after each batch.",
+					},
+					"record_delimiter": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "String to be inserted in-between the records as separator.",
+					},
+				},
+			},
 		},
 		"frequency": {
 			Type:         schema.TypeString,
@@ -0,0 +1,53 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareMagicNetworkMonitoringRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the rule. Must be unique within the account.",
+		},
+		"automatic_advertisement": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the prefixes of the rule are automatically advertised when the rule is triggered.",
+		},
+		"bandwidth_threshold": {
+			Type:         schema.TypeFloat,
+			Required:     true,
+			ValidateFunc: validation.FloatAtLeast(1),
+			Description:  "The number of bits per second that triggers the rule.",
+		},
+		"duration": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "1m",
+			ValidateFunc: validation.StringInSlice(magicNetworkMonitoringRuleDurations, false),
+			Description:  fmt.Sprintf("The amount of time the traffic must exceed the threshold to trigger the rule. %s", renderAvailableDocumentationValuesStringSlice(magicNetworkMonitoringRuleDurations)),
+		},
+		"prefixes": {
+			Type:     schema.TypeSet,
+			Required: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+			Description: "The IP prefixes, in CIDR notation, monitored by the rule.",
+		},
+	}
+}
@@ -5,9 +5,57 @@ import (
 
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var notificationPolicyAlertTypes = []string{
+	"billing_usage_alert",
+	"health_check_status_notification",
+	"g6_pool_toggle_alert",
+	"real_origin_monitoring",
+	"universal_ssl_event_type",
+	"dedicated_ssl_certificate_event_type",
+	"custom_ssl_certificate_event_type",
+	"access_custom_certificate_expiration_type",
+	"zone_aop_custom_certificate_expiration_type",
+	"bgp_hijack_notification",
+	"http_alert_origin_error",
+	"workers_alert",
+	"weekly_account_overview",
+	"expiring_service_token_alert",
+	"secondary_dns_all_primaries_failing",
+	"secondary_dns_zone_validation_warning",
+	"secondary_dns_primaries_failing",
+	"secondary_dns_zone_successfully_updated",
+	"dos_attack_l7",
+	"dos_attack_l4",
+	"advanced_ddos_attack_l7_alert",
+	"advanced_ddos_attack_l4_alert",
+	"fbm_volumetric_attack",
+	"fbm_auto_advertisement",
+	"load_balancing_pool_enablement_alert",
+	"load_balancing_health_alert",
+	"g6_health_alert",
+	"http_alert_edge_error",
+	"clickhouse_alert_fw_anomaly",
+	"clickhouse_alert_fw_ent_anomaly",
+	"failing_logpush_job_disabled_alert",
+	"scriptmonitor_alert_new_hosts",
+	"scriptmonitor_alert_new_scripts",
+	"scriptmonitor_alert_new_malicious_scripts",
+	"scriptmonitor_alert_new_malicious_url",
+	"scriptmonitor_alert_new_code_change_detections",
+	"scriptmonitor_alert_new_max_length_script_url",
+	"scriptmonitor_alert_new_malicious_hosts",
+	"sentinel_alert",
+	"hostname_aop_custom_certificate_expiration_type",
+	"stream_live_notifications",
+	"block_notification_new_block",
+	"block_notification_review_rejected",
+	"block_notification_review_accepted",
+	"web_analytics_metrics_update",
+	"workers_uptime",
+}
+
 func resourceCloudflareNotificationPolicySchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.AccountIDSchemaKey: {
@@ -31,104 +79,15 @@ func resourceCloudflareNotificationPolicySchema() map[string]*schema.Schema {
 			Description: "The status of the notification policy.",
 		},
 		"alert_type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ValidateFunc: validation.StringInSlice([]string{
-				"billing_usage_alert",
-				"health_check_status_notification",
-				"g6_pool_toggle_alert",
-				"real_origin_monitoring",
-				"universal_ssl_event_type",
-				"dedicated_ssl_certificate_event_type",
-				"custom_ssl_certificate_event_type",
-				"access_custom_certificate_expiration_type",
-				"zone_aop_custom_certificate_expiration_type",
-				"bgp_hijack_notification",
-				"http_alert_origin_error",
-				"workers_alert",
-				"weekly_account_overview",
-				"expiring_service_token_alert",
-				"secondary_dns_all_primaries_failing",
-				"secondary_dns_zone_validation_warning",
-				"secondary_dns_primaries_failing",
-				"secondary_dns_zone_successfully_updated",
-				"dos_attack_l7",
-				"dos_attack_l4",
-				"advanced_ddos_attack_l7_alert",
-				"advanced_ddos_attack_l4_alert",
-				"fbm_volumetric_attack",
-				"fbm_auto_advertisement",
-				"load_balancing_pool_enablement_alert",
-				"load_balancing_health_alert",
-				"g6_health_alert",
-				"http_alert_edge_error",
-				"clickhouse_alert_fw_anomaly",
-				"clickhouse_alert_fw_ent_anomaly",
-				"failing_logpush_job_disabled_alert",
-				"scriptmonitor_alert_new_hosts",
-				"scriptmonitor_alert_new_scripts",
-				"scriptmonitor_alert_new_malicious_scripts",
-				"scriptmonitor_alert_new_malicious_url",
-				"scriptmonitor_alert_new_code_change_detections",
-				"scriptmonitor_alert_new_max_length_script_url",
-				"scriptmonitor_alert_new_malicious_hosts",
-				"sentinel_alert",
-				"hostname_aop_custom_certificate_expiration_type",
-				"stream_live_notifications",
-				"block_notification_new_block",
-				"block_notification_review_rejected",
-				"block_notification_review_accepted",
-				"web_analytics_metrics_update",
-				"workers_uptime",
-			}, false),
-			Description: fmt.Sprintf("The event type that will trigger the dispatch of a notification. See the developer documentation for descriptions of [available alert types](https://developers.cloudflare.com/fundamentals/notifications/notification-available/). %s", renderAvailableDocumentationValuesStringSlice([]string{
-				"billing_usage_alert",
-				"health_check_status_notification",
-				"g6_pool_toggle_alert",
-				"real_origin_monitoring",
-				"universal_ssl_event_type",
-				"dedicated_ssl_certificate_event_type",
-				"custom_ssl_certificate_event_type",
-				"access_custom_certificate_expiration_type",
-				"zone_aop_custom_certificate_expiration_type",
-				"bgp_hijack_notification",
-				"http_alert_origin_error",
-				"workers_alert",
-				"weekly_account_overview",
-				"expiring_service_token_alert",
-				"secondary_dns_all_primaries_failing",
-				"secondary_dns_zone_validation_warning",
-				"secondary_dns_primaries_failing",
-				"secondary_dns_zone_successfully_updated",
-				"dos_attack_l7",
-				"dos_attack_l4",
-				"advanced_ddos_attack_l7_alert",
-				"advanced_ddos_attack_l4_alert",
-				"fbm_volumetric_attack",
-				"fbm_auto_advertisement",
-				"load_balancing_pool_enablement_alert",
-				"load_balancing_health_alert",
-				"g6_health_alert",
-				"http_alert_edge_error",
-				"clickhouse_alert_fw_anomaly",
-				"clickhouse_alert_fw_ent_anomaly",
-				"failing_logpush_job_disabled_alert",
-				"scriptmonitor_alert_new_hosts",
-				"scriptmonitor_alert_new_scripts",
-				"scriptmonitor_alert_new_malicious_scripts",
-				"scriptmonitor_alert_new_malicious_url",
-				"scriptmonitor_alert_new_code_change_detections",
-				"scriptmonitor_alert_new_max_length_script_url",
-				"scriptmonitor_alert_new_malicious_hosts",
-				"sentinel_alert",
-				"hostname_aop_custom_certificate_expiration_type",
-				"stream_live_notifications",
-				"block_notification_new_block",
-				"block_notification_review_rejected",
-				"block_notification_review_accepted",
-				"web_analytics_metrics_update",
-				"workers_uptime",
-			})),
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: fmt.Sprintf("The event type that will trigger the dispatch of a notification. See the developer documentation for descriptions of [available alert types](https://developers.cloudflare.com/fundamentals/notifications/notification-available/). Unknown alert types are rejected unless `skip_alert_type_validation` is set. %s", renderAvailableDocumentationValuesStringSlice(notificationPolicyAlertTypes)),
+		},
+		"skip_alert_type_validation": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to skip the validation of `alert_type` against the alert types known to the provider. Useful for alert types which were recently added to the API.",
 		},
 		"filters": notificationPolicyFilterSchema(),
 		"created": {
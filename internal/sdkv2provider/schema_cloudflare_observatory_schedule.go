@@ -0,0 +1,40 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareObservatoryScheduledTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The page to test, without the scheme, such as `example.com/path`.",
+		},
+		"frequency": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(observatoryScheduleFrequencies, false),
+			Description:  fmt.Sprintf("The frequency the test runs at. %s", renderAvailableDocumentationValuesStringSlice(observatoryScheduleFrequencies)),
+		},
+		"region": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(observatoryRegions, false),
+			Description:  fmt.Sprintf("The region the test runs from. %s", renderAvailableDocumentationValuesStringSlice(observatoryRegions)),
+		},
+	}
+}
@@ -51,9 +51,10 @@ func resourceCloudflareOriginCACertificateSchema() map[string]*schema.Schema {
 			Description:  fmt.Sprintf("The number of days for which the certificate should be valid. %s", renderAvailableDocumentationValuesIntSlice([]int{7, 30, 90, 365, 730, 1095, 5475})),
 		},
 		"min_days_for_renewal": {
-			Type:        schema.TypeInt,
-			Optional:    true,
-			Description: "Number of days prior to the expiry to trigger a renewal of the certificate if a Terraform operation is run.",
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Number of days prior to the expiry to trigger a renewal of the certificate if a Terraform operation is run. Once fewer days remain until `expires_on`, the plan replaces the certificate.",
 		},
 	}
 }
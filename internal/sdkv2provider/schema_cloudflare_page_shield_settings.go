@@ -0,0 +1,34 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflarePageShieldSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"enabled": {
+			Description: "Whether Page Shield is enabled for the zone.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"use_cloudflare_reporting_endpoint": {
+			Description: "Whether CSP reports are sent to a Cloudflare owned endpoint instead of the zone itself.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+		},
+		"use_connection_url_path": {
+			Description: "Whether connections are reported with their full URL path instead of only the host.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
@@ -2,6 +2,7 @@ package sdkv2provider
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
@@ -51,6 +52,11 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Name of entitlement that is shareable between entities.",
 		},
+		"modified_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The RFC3339 timestamp of when the ruleset was last modified.",
+		},
 		"rules": {
 			Type:        schema.TypeList,
 			Optional:    true,
@@ -84,7 +90,7 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 						Description:  fmt.Sprintf("Action to perform in the ruleset rule. %s", renderAvailableDocumentationValuesStringSlice(cloudflare.RulesetRuleActionValues())),
 					},
 					"expression": {
-						Description: "Criteria for an HTTP request to trigger the ruleset rule action. Uses the Firewall Rules expression language based on Wireshark display filters. Refer to the [Firewall Rules language](https://developers.cloudflare.com/firewall/cf-firewall-language) documentation for all available fields, operators, and functions",
+						Description: "Criteria for an HTTP request to trigger the ruleset rule action. Uses the Firewall Rules expression language based on Wireshark display filters. Lists are referenced by name using the `$` prefix, for example `ip.src in $my_list`. Refer to the [Firewall Rules language](https://developers.cloudflare.com/firewall/cf-firewall-language) documentation for all available fields, operators, and functions",
 						Type:        schema.TypeString,
 						Required:    true,
 					},
@@ -837,9 +843,10 @@ func resourceCloudflareRulesetSchema() map[string]*schema.Schema {
 									Elem: &schema.Resource{
 										Schema: map[string]*schema.Schema{
 											"name": {
-												Type:        schema.TypeString,
-												Description: "Name of the list.",
-												Required:    true,
+												Type:         schema.TypeString,
+												Description:  "Name of the list.",
+												Required:     true,
+												ValidateFunc: validation.StringMatch(regexp.MustCompile("^[0-9a-z_]+$"), "list name must only contain lowercase letters, numbers and underscores and must not include the `$` prefix used in expressions"),
 											},
 											"key": {
 												Type:        schema.TypeString,
@@ -125,23 +125,27 @@ func resourceCloudflareSpectrumApplicationSchema() map[string]*schema.Schema {
 			Type:         schema.TypeString,
 			Optional:     true,
 			Default:      "off",
-			Description:  fmt.Sprintf("Enables a proxy protocol to the origin. %s", renderAvailableDocumentationValuesStringSlice([]string{"off", "v1", "v2", "simple"})),
+			Description:  fmt.Sprintf("Enables a proxy protocol to the origin. `v1` is only supported by TCP applications and `simple` by UDP applications. Requires `traffic_type` to be `direct`. %s", renderAvailableDocumentationValuesStringSlice([]string{"off", "v1", "v2", "simple"})),
 			ValidateFunc: validation.StringInSlice([]string{"off", "v1", "v2", "simple"}, false),
 		},
 
 		"edge_ips": {
-			Type:        schema.TypeSet,
-			Optional:    true,
-			Elem:        &schema.Schema{Type: schema.TypeString},
-			Description: "A list of edge IPs (IPv4 and/or IPv6) to configure Spectrum application to. Requires [Bring Your Own IP](https://developers.cloudflare.com/spectrum/getting-started/byoip/) provisioned.",
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			Description: "A list of edge IPs (IPv4 and/or IPv6) to configure Spectrum application to. Requires [Bring Your Own IP](https://developers.cloudflare.com/spectrum/getting-started/byoip/) provisioned and a `dns` record of type `ADDRESS`.",
 		},
 
 		"edge_ip_connectivity": {
-			Type:         schema.TypeString,
-			Optional:     true,
-			Computed:     true,
-			ValidateFunc: validation.StringInSlice([]string{"all", "ipv4", "ipv6"}, false),
-			Description:  fmt.Sprintf("Choose which types of IP addresses will be provisioned for this subdomain. %s", renderAvailableDocumentationValuesStringSlice([]string{"all", "ipv4", "ipv6"})),
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ConflictsWith: []string{"edge_ips"},
+			ValidateFunc:  validation.StringInSlice([]string{"all", "ipv4", "ipv6"}, false),
+			Description:   fmt.Sprintf("Choose which types of IP addresses will be provisioned for this subdomain. %s", renderAvailableDocumentationValuesStringSlice([]string{"all", "ipv4", "ipv6"})),
 		},
 
 		"argo_smart_routing": {
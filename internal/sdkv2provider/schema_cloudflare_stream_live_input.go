@@ -0,0 +1,109 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var streamLiveInputRecordingModes = []string{"off", "automatic"}
+
+func resourceCloudflareStreamLiveInputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"uid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier of the live input.",
+		},
+		"meta": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "A map of user modifiable key-value pairs describing the live input, such as its `name`.",
+		},
+		"recording": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Computed:    true,
+			MaxItems:    1,
+			Description: "The recording settings of the live input.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mode": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "off",
+						ValidateFunc: validation.StringInSlice(streamLiveInputRecordingModes, false),
+						Description:  fmt.Sprintf("Whether the live input is recorded. %s", renderAvailableDocumentationValuesStringSlice(streamLiveInputRecordingModes)),
+					},
+					"timeout_seconds": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "The number of seconds to wait for the broadcast to resume after a disconnection before ending the recording. `0` uses the Stream default.",
+					},
+					"require_signed_urls": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "Whether signed URLs are required to view the recordings of the live input.",
+					},
+				},
+			},
+		},
+		"rtmps": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The RTMPS connection details used to broadcast to the live input.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The RTMPS URL to broadcast to.",
+					},
+					"stream_key": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+						Description: "The secret key used to broadcast over RTMPS.",
+					},
+				},
+			},
+		},
+		"srt": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The SRT connection details used to broadcast to the live input.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The SRT URL to broadcast to.",
+					},
+					"stream_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The SRT stream identifier.",
+					},
+					"passphrase": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Sensitive:   true,
+						Description: "The secret passphrase used to broadcast over SRT.",
+					},
+				},
+			},
+		},
+	}
+}
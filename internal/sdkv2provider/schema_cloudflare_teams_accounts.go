@@ -69,7 +69,7 @@ func resourceCloudflareTeamsAccountSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: proxySchema,
 			},
-			Description: "Configuration block for specifying which protocols are proxied.",
+			Description: "Configuration block for specifying which protocols are proxied. Conflicts with `gateway_proxy_enabled` and `gateway_udp_proxy_enabled` of `cloudflare_zero_trust_device_settings`.",
 		},
 	}
 }
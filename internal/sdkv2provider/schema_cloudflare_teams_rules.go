@@ -150,6 +150,34 @@ var teamsRuleSettings = map[string]*schema.Schema{
 		},
 		Description: "Configure how Proxy traffic egresses. Can be set for rules with Egress action and Egress filter. Can be omitted to indicate local egress via Warp IPs.",
 	},
+	"untrusted_cert": {
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: teamsUntrustedCertSettingsSchema,
+		},
+		Description: "Configure behavior when an upstream cert is invalid / an SSL error occurs.",
+	},
+	"resolve_dns_through_cloudflare": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Enable sending queries that match the resolver policy to Cloudflare's default 1.1.1.1 DNS resolver.",
+	},
+	"ip_categories": {
+		Type:        schema.TypeBool,
+		Optional:    true,
+		Description: "Turns on IP category based filter on dns if the rule contains dns category checks.",
+	},
+}
+
+var teamsUntrustedCertSettingsSchema = map[string]*schema.Schema{
+	"action": {
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(teamsUntrustedCertActions, false),
+		Description:  fmt.Sprintf("Action to be taken when the SSL certificate of upstream is invalid. %s", renderAvailableDocumentationValuesStringSlice(teamsUntrustedCertActions)),
+	},
 }
 
 var egressSettings = map[string]*schema.Schema{
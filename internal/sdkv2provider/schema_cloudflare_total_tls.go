@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var totalTLSCertificateAuthorities = []string{"google", "lets_encrypt", "ssl_com"}
+
 func resourceCloudflareTotalTLSSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.ZoneIDSchemaKey: {
@@ -22,10 +24,11 @@ func resourceCloudflareTotalTLSSchema() map[string]*schema.Schema {
 			Required:    true,
 		},
 		"certificate_authority": {
-			Description:  fmt.Sprintf("The Certificate Authority that Total TLS certificates will be issued through. %s", renderAvailableDocumentationValuesStringSlice([]string{"google", "lets_encrypt"})),
+			Description:  fmt.Sprintf("The Certificate Authority that Total TLS certificates will be issued through. When not set, Cloudflare picks one. %s", renderAvailableDocumentationValuesStringSlice(totalTLSCertificateAuthorities)),
 			Type:         schema.TypeString,
 			Optional:     true,
-			ValidateFunc: validation.StringInSlice([]string{"google", "lets_encrypt"}, false),
+			Computed:     true,
+			ValidateFunc: validation.StringInSlice(totalTLSCertificateAuthorities, false),
 		},
 	}
 }
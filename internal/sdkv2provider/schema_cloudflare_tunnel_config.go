@@ -21,11 +21,18 @@ func resourceCloudflareTunnelConfigSchema() map[string]*schema.Schema {
 			Description: "The account identifier to target for the resource.",
 		},
 
+		"source": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      tunnelConfigSourceCloudflare,
+			ValidateFunc: validation.StringInSlice(tunnelConfigSources, false),
+			Description:  fmt.Sprintf("Where the Tunnel configuration is managed. When set to `%s`, `config` must not be set as `cloudflared` reads its configuration from a local file, and the Tunnel must not already be managed by Cloudflare. %s", tunnelConfigSourceLocal, renderAvailableDocumentationValuesStringSlice(tunnelConfigSources)),
+		},
 		"config": {
 			Type:        schema.TypeList,
 			MaxItems:    1,
-			Required:    true,
-			Description: "Configuration block for Tunnel Configuration.",
+			Optional:    true,
+			Description: fmt.Sprintf("Configuration block for Tunnel Configuration. Required when `source` is `%s`.", tunnelConfigSourceCloudflare),
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"warp_routing": {
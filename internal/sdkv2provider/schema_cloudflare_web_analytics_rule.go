@@ -0,0 +1,48 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWebAnalyticsRuleSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"ruleset_id": {
+			Description: "The ID of the Web Analytics ruleset the rule belongs to.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"host": {
+			Description: "The host the rule applies to.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"paths": {
+			Description: "A list of paths the rule applies to.",
+			Type:        schema.TypeList,
+			Required:    true,
+			MinItems:    1,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"inclusive": {
+			Description: "Whether the rule includes or excludes the matched traffic from being measured in Web Analytics.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"is_paused": {
+			Description: "Whether the rule is paused or not.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		},
+	}
+}
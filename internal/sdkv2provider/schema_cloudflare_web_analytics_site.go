@@ -0,0 +1,93 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareWebAnalyticsSiteSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"zone_tag": {
+			Description:  "The zone identifier of a proxied zone to measure. Must provide only one of `zone_tag`, `host`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"zone_tag", "host"},
+		},
+		"host": {
+			Description:  "The hostname to use for gray-clouded sites. Must provide only one of `zone_tag`, `host`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ExactlyOneOf: []string{"zone_tag", "host"},
+		},
+		"auto_install": {
+			Description: "Whether Cloudflare will automatically inject the JavaScript snippet for orange-clouded sites.",
+			Type:        schema.TypeBool,
+			Required:    true,
+		},
+		"site_tag": {
+			Description: "The Web Analytics site tag.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"site_token": {
+			Description: "The token for the Web Analytics site.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"snippet": {
+			Description: "The JS beacon snippet to add to your site's HTML page if `auto_install` is `false`. It embeds the `site_token` and changes along with it.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"ruleset_id": {
+			Description: "The ID of the ruleset holding the rules of the Web Analytics site.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"rules": {
+			Description: "A summary of the rules of the Web Analytics site.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The Web Analytics rule identifier.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"host": {
+						Description: "The host the rule applies to.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"paths": {
+						Description: "The paths the rule applies to.",
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem: &schema.Schema{
+							Type: schema.TypeString,
+						},
+					},
+					"inclusive": {
+						Description: "Whether the rule includes or excludes the matched traffic from being measured.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"is_paused": {
+						Description: "Whether the rule is paused.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareWorkersForPlatformsDispatchNamespaceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			Description:  "The name of the Workers for Platforms dispatch namespace.",
+		},
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the dispatch namespace.",
+		},
+		"created_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the dispatch namespace was created.",
+		},
+		"scripts_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of Worker scripts uploaded to the dispatch namespace.",
+		},
+	}
+}
@@ -3,6 +3,7 @@ package sdkv2provider
 import (
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 )
 
 func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
@@ -26,9 +27,22 @@ func resourceCloudflareWorkerKVSchema() map[string]*schema.Schema {
 			Description: "The ID of the Workers KV namespace in which you want to create the KV pair.",
 		},
 		"value": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ExactlyOneOf:     []string{"value", "value_file"},
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+			Description:      "Value of the KV pair. Formatting differences of JSON encoded values are ignored unless `exact_content_diffs` is enabled in the provider configuration. Must provide only one of `value`, `value_file`.",
+		},
+		"value_file": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"value", "value_file"},
+			Description:  "Path to a file holding the value of the KV pair. The file is uploaded as is so it may contain binary data, and only its hash is stored in the state. Must provide only one of `value`, `value_file`.",
+		},
+		"value_file_sha256": {
 			Type:        schema.TypeString,
-			Required:    true,
-			Description: "Value of the KV pair.",
+			Computed:    true,
+			Description: "The SHA-256 hash of the value of the KV pair when using `value_file`, used to detect changes to the file and to the stored value.",
 		},
 	}
 }
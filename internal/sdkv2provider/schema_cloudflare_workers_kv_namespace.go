@@ -16,7 +16,7 @@ func resourceCloudflareWorkersKVNamespaceSchema() map[string]*schema.Schema {
 		"title": {
 			Type:        schema.TypeString,
 			Required:    true,
-			Description: "Title value of the Worker KV Namespace.",
+			Description: "Title value of the Worker KV Namespace. Changing the title renames the namespace in place, keeping its ID and keys.",
 		},
 	}
 }
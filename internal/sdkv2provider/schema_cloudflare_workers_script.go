@@ -116,6 +116,36 @@ var analyticsEngineBindingResource = &schema.Resource{
 	},
 }
 
+var queueBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"binding": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the global variable for the binding in your Worker code.",
+		},
+		"queue": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the queue you want to use.",
+		},
+	},
+}
+
+var d1DatabaseBindingResource = &schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The global variable for the binding in your Worker code.",
+		},
+		"database_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Database ID of D1 database to use.",
+		},
+	},
+}
+
 func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		consts.AccountIDSchemaKey: {
@@ -130,9 +160,10 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Description: "The name for the script.",
 		},
 		"content": {
-			Type:        schema.TypeString,
-			Required:    true,
-			Description: "The script content.",
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: suppressTrailingWhitespaceDiff,
+			Description:      "The script content. Differences in trailing whitespace are ignored unless `exact_content_diffs` is enabled in the provider configuration.",
 		},
 		"module": {
 			Type:        schema.TypeBool,
@@ -174,5 +205,15 @@ func resourceCloudflareWorkerScriptSchema() map[string]*schema.Schema {
 			Optional: true,
 			Elem:     analyticsEngineBindingResource,
 		},
+		"queue_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     queueBindingResource,
+		},
+		"d1_database_binding": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     d1DatabaseBindingResource,
+		},
 	}
 }
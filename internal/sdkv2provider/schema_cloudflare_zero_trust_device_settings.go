@@ -0,0 +1,49 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceCloudflareZeroTrustDeviceSettingsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"disable_for_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Sets the time limit, in seconds, that a user can use an override code to bypass WARP.",
+		},
+		"gateway_proxy_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable gateway proxy filtering on TCP. Conflicts with the `proxy` block of `cloudflare_teams_account`.",
+		},
+		"gateway_udp_proxy_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable gateway proxy filtering on UDP. Conflicts with the `proxy` block of `cloudflare_teams_account`.",
+		},
+		"root_certificate_installation_enabled": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable installation of cloudflare managed root certificate.",
+		},
+		"use_zt_virtual_ip": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Computed:    true,
+			Description: "Enable using CGNAT virtual IPv4.",
+		},
+	}
+}
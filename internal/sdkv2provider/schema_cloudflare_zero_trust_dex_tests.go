@@ -0,0 +1,106 @@
+package sdkv2provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	dexTestKinds       = []string{"http", "traceroute"}
+	dexTestHTTPMethods = []string{"GET"}
+)
+
+func resourceCloudflareZeroTrustDexTestSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the Device DEX test. Must be unique.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Additional details about the test.",
+		},
+		"interval": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+				if _, err := time.ParseDuration(val.(string)); err != nil {
+					errs = append(errs, fmt.Errorf(`%q only supports "s", "m", or "h" as valid units`, key))
+				}
+				return
+			},
+			DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+				oldInterval, oldErr := time.ParseDuration(oldValue)
+				newInterval, newErr := time.ParseDuration(newValue)
+				return oldErr == nil && newErr == nil && oldInterval == newInterval
+			},
+			Description: "How often the test will run. Must be in the format `30m` or `0h30m0s`.",
+		},
+		"enabled": {
+			Type:        schema.TypeBool,
+			Required:    true,
+			Description: "Determines whether or not the test is active.",
+		},
+		"data": {
+			Type:        schema.TypeList,
+			Required:    true,
+			MaxItems:    1,
+			Description: "The configuration object which contains the details for the WARP client to conduct the test.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"kind": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(dexTestKinds, false),
+						Description:  fmt.Sprintf("The type of Device DEX test. %s", renderAvailableDocumentationValuesStringSlice(dexTestKinds)),
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The host URL for `http` test `kind`. For `traceroute`, it must be a valid hostname or IP address.",
+					},
+					"method": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice(dexTestHTTPMethods, false),
+						Description:  fmt.Sprintf("The HTTP request method type. %s", renderAvailableDocumentationValuesStringSlice(dexTestHTTPMethods)),
+					},
+				},
+			},
+		},
+		"targeted": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether the test only runs on the devices of the device settings policies in `target_policies`. Requires `target_policies` when enabled.",
+		},
+		"target_policies": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "IDs of the device settings policies whose devices run the test when `targeted` is enabled.",
+		},
+		"created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp of when the Device DEX test was created.",
+		},
+		"updated": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Timestamp of when the Device DEX test was last updated.",
+		},
+	}
+}
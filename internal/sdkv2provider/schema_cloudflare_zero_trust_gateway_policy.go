@@ -0,0 +1,39 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	gatewayPolicyActions = append(cloudflare.TeamsRulesActionValues(), gatewayPolicyResolveAction)
+	gatewayPolicyFilters = []string{
+		string(cloudflare.HttpFilter),
+		string(cloudflare.DnsFilter),
+		string(cloudflare.L4Filter),
+		string(cloudflare.EgressFilter),
+		gatewayPolicyDNSResolverFilter,
+	}
+)
+
+func resourceCloudflareZeroTrustGatewayPolicySchema() map[string]*schema.Schema {
+	policySchema := resourceCloudflareTeamsRuleSchema()
+
+	policySchema["name"].Description = "The name of the Gateway policy."
+	policySchema["description"].Description = "The description of the Gateway policy."
+	policySchema["precedence"].Description = "The evaluation precedence of the Gateway policy."
+	policySchema["enabled"].Description = "Whether the Gateway policy is enabled."
+	policySchema["action"].ValidateFunc = validation.StringInSlice(gatewayPolicyActions, false)
+	policySchema["action"].Description = fmt.Sprintf("The action executed by the matched Gateway policy. The `isolate` action requires the Browser Isolation add-on. %s", renderAvailableDocumentationValuesStringSlice(gatewayPolicyActions))
+	policySchema["filters"].Elem = &schema.Schema{
+		Type:         schema.TypeString,
+		ValidateFunc: validation.StringInSlice(gatewayPolicyFilters, false),
+	}
+	policySchema["filters"].Description = fmt.Sprintf("The protocol or layer the traffic and identity expressions of the Gateway policy are evaluated against. %s", renderAvailableDocumentationValuesStringSlice(gatewayPolicyFilters))
+	policySchema["rule_settings"].Description = "Additional settings for the Gateway policy. Most settings only apply to a single action."
+
+	return policySchema
+}
@@ -0,0 +1,46 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCloudflareZeroTrustTunnelCloudflaredSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "A user-friendly name chosen when the tunnel is created.",
+		},
+		"secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "32 or more bytes, encoded as a base64 string. Changing the secret recreates the tunnel unless `rotate_secret` is enabled.",
+		},
+		"rotate_secret": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether changes to `secret` rotate the secret of the existing tunnel instead of recreating it.",
+		},
+		"cname": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Usable CNAME for accessing the tunnel.",
+		},
+		"tunnel_token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "Base64 encoded token used by a connector to authenticate and run the tunnel, such as with `cloudflared service install <tunnel_token>`.",
+		},
+	}
+}
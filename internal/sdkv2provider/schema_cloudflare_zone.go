@@ -27,6 +27,12 @@ func resourceCloudflareZoneSchema() map[string]*schema.Schema {
 			Optional:    true,
 			Description: "Whether to scan for DNS records on creation. Ignored after zone is created.",
 		},
+		"activate": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to request an activation check for a zone of type `partial` that is still pending and wait until it becomes active. The check is only requested when the value changes to `true` on an existing zone so create the TXT record containing the `verification_key` first.",
+		},
 		"paused": {
 			Type:        schema.TypeBool,
 			Optional:    true,
@@ -0,0 +1,40 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func resourceCloudflareZoneSettingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"setting_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The identifier of the zone setting to manage, such as `always_online` or `browser_cache_ttl`.",
+		},
+		"value": {
+			Type:             schema.TypeString,
+			Required:         true,
+			DiffSuppressFunc: structure.SuppressJsonDiff,
+			Description:      "The value of the zone setting. Settings with a string value take it as is, all other values must be JSON encoded, such as with `jsonencode()`.",
+		},
+		"editable": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the zone setting can be modified for the plan of the zone.",
+		},
+		"modified_on": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "When the zone setting was last modified.",
+		},
+	}
+}
@@ -321,9 +321,10 @@ var resourceCloudflareZoneSettingsSchema = map[string]*schema.Schema{
 				},
 
 				"max_age": {
-					Type:     schema.TypeInt,
-					Optional: true,
-					Computed: true,
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(0, 31536000),
 				},
 
 				"include_subdomains": {
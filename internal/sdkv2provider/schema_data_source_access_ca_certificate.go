@@ -0,0 +1,38 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessCACertificateSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description:  "The account identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		consts.ZoneIDSchemaKey: {
+			Description:  "The zone identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		"application_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Access Application ID associated with the CA certificate.",
+		},
+		"aud": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Application Audience (AUD) Tag of the CA certificate.",
+		},
+		"public_key": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cryptographic public key of the CA certificate.",
+		},
+	}
+}
@@ -0,0 +1,82 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessOrganizationSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description:  "The account identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		consts.ZoneIDSchemaKey: {
+			Description:  "The zone identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of your Zero Trust organization.",
+		},
+		"auth_domain": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique subdomain assigned to your Zero Trust organization.",
+		},
+		"is_ui_read_only": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the Zero Trust settings can only be changed through the API or Terraform.",
+		},
+		"user_seat_expiration_inactive_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The amount of time a user seat is inactive before it expires.",
+		},
+		"session_duration": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "How often a user will be forced to re-authorise across all applications of the organization.",
+		},
+		"login_design": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The design of the Access login page.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"background_color": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The background color on the login page",
+					},
+					"text_color": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The text color on the login page",
+					},
+					"logo_path": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The URL of the logo on the login page",
+					},
+					"header_text": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The text at the top of the login page",
+					},
+					"footer_text": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The text at the bottom of the login page",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,79 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCloudflareAPIShieldOperations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCloudflareAPIShieldOperationsRead,
+
+		Schema: map[string]*schema.Schema{
+			consts.ZoneIDSchemaKey: {
+				Description: "The zone identifier to target for the resource.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			"filter": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "One or more values used to look up API Shield operations. If more than one value is given all values must match in order to be included.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(apiShieldOperationMethods, false),
+							Description:  "The HTTP method of the operations to lookup.",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The host of the operations to lookup.",
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The endpoint of the operations to lookup.",
+						},
+					},
+				},
+			},
+
+			"operations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A list of API Shield operations.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operation_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Identifier of the operation.",
+						},
+						"method": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The HTTP method used to access the endpoint.",
+						},
+						"host": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "RFC3986-compliant host.",
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The endpoint which can contain path parameter templates in curly braces.",
+						},
+					},
+				},
+			},
+		},
+		Description: "Use this data source to lookup API Shield operations.",
+	}
+}
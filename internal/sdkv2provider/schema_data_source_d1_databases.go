@@ -0,0 +1,50 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareD1DatabasesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return D1 databases whose name contains this value.",
+		},
+		"databases": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of D1 databases in the account.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"uuid": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The identifier of the D1 database.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the D1 database.",
+					},
+					"version": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The storage backend version of the D1 database.",
+					},
+					"created_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "When the D1 database was created.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,66 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareFirewallRulesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"rules": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of firewall rules in the zone along with their filters.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The firewall rule identifier.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the firewall rule.",
+					},
+					"action": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The action to apply to a matched request.",
+					},
+					"paused": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the firewall rule is paused.",
+					},
+					"priority": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The priority of the firewall rule. `0` when the rule has no priority.",
+					},
+					"products": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The products bypassed by the firewall rule.",
+					},
+					"filter_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The identifier of the filter linked to the firewall rule.",
+					},
+					"expression": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The filter expression of the firewall rule.",
+					},
+				},
+			},
+		},
+	}
+}
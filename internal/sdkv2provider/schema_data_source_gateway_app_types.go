@@ -0,0 +1,50 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareGatewayAppTypesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return applications and application types with this name. The comparison is case insensitive.",
+		},
+		"app_types": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of Gateway applications and application types.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The identifier of the application or application type, as used in Gateway rule expressions.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the application or application type.",
+					},
+					"application_type_id": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The identifier of the application type of an application. `0` for application types.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of an application type.",
+					},
+				},
+			},
+		},
+	}
+}
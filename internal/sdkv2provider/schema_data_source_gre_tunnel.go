@@ -0,0 +1,77 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareGRETunnelSchema() map[string]*schema.Schema {
+	return mergeTunnelHealthCheckDataSourceSchema(map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the GRE tunnel to look up.",
+		},
+		"customer_gre_endpoint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address assigned to the customer side of the GRE tunnel.",
+		},
+		"cloudflare_gre_endpoint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The IP address assigned to the Cloudflare side of the GRE tunnel.",
+		},
+		"interface_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "31-bit prefix (/31 in CIDR notation) supporting 2 hosts, one for each side of the tunnel.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the GRE tunnel intent.",
+		},
+		"ttl": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Time To Live (TTL) in number of hops of the GRE tunnel.",
+		},
+		"mtu": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum Transmission Unit (MTU) in bytes for the GRE tunnel.",
+		},
+	})
+}
+
+// mergeTunnelHealthCheckDataSourceSchema adds the tunnel health check
+// attributes shared by the GRE and IPsec tunnel data sources to s.
+func mergeTunnelHealthCheckDataSourceSchema(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["health_check_enabled"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether ICMP tunnel health checks are enabled. `false` when the tunnel reports no health check data.",
+	}
+	s["health_check_target"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The IP address of the customer endpoint that receives tunnel health checks.",
+	}
+	s["health_check_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The ICMP echo type used by the health check.",
+	}
+	s["tunnel_health_check_rate"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "How frequently the tunnel health check is run. Empty when the tunnel reports no health check rate.",
+	}
+	return s
+}
@@ -0,0 +1,46 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareIPsecTunnelSchema() map[string]*schema.Schema {
+	return mergeTunnelHealthCheckDataSourceSchema(map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the IPsec tunnel to look up.",
+		},
+		"customer_endpoint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "IP address assigned to the customer side of the IPsec tunnel.",
+		},
+		"cloudflare_endpoint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "IP address assigned to the Cloudflare side of the IPsec tunnel.",
+		},
+		"interface_address": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "31-bit prefix (/31 in CIDR notation) supporting 2 hosts, one for each side of the tunnel.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Description of the IPsec tunnel.",
+		},
+		"allow_null_cipher": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether null-cipher ESP encryption is allowed for the IPsec tunnel.",
+		},
+	})
+}
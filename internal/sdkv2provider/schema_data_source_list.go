@@ -0,0 +1,36 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareListSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the list to look up.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The description of the list.",
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of items the list contains.",
+		},
+		"num_items": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of items in the list.",
+		},
+	}
+}
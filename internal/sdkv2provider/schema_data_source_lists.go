@@ -0,0 +1,55 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareListsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"kind": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Only return lists containing items of this type, such as `ip` or `redirect`.",
+		},
+		"lists": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of lists in the account.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The list identifier.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the list.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the list.",
+					},
+					"kind": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The type of items the list contains.",
+					},
+					"num_items": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "The number of items in the list.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareNotificationPolicyAvailableAlertsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"alerts": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of alert types that notification policies can be created for in the account.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"product": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The product the alert type belongs to.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The alert type, used as the `alert_type` of a notification policy.",
+					},
+					"display_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The human readable name of the alert type.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the alert type.",
+					},
+					"filters": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The filters supported by the alert type.",
+					},
+				},
+			},
+		},
+		"mechanisms": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The delivery mechanisms the account is eligible to use for notification policies.",
+		},
+	}
+}
@@ -0,0 +1,40 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareObservatoryPagesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"pages": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of pages tested by Observatory.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"url": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The tested page.",
+					},
+					"region": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The region the page was tested from.",
+					},
+					"schedule_frequency": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The frequency of the scheduled test of the page, if any.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,9 @@
+package sdkv2provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflarePageShieldConnectionsSchema() map[string]*schema.Schema {
+	return dataSourceCloudflarePageShieldResourcesSchema("connections", "connection")
+}
@@ -0,0 +1,88 @@
+package sdkv2provider
+
+import (
+	"fmt"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	pageShieldResourceStatuses   = []string{"active", "infrequent", "inactive"}
+	pageShieldResourceDirections = []string{"asc", "desc"}
+)
+
+func dataSourceCloudflarePageShieldScriptsSchema() map[string]*schema.Schema {
+	return dataSourceCloudflarePageShieldResourcesSchema("scripts", "script")
+}
+
+// dataSourceCloudflarePageShieldResourcesSchema returns the schema shared by
+// the Page Shield scripts and connections data sources, which only differ in
+// the name of the attribute holding the results.
+func dataSourceCloudflarePageShieldResourcesSchema(key, kind string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"status": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(pageShieldResourceStatuses, false),
+			Description:  fmt.Sprintf("Only return %ss with this status. %s", kind, renderAvailableDocumentationValuesStringSlice(pageShieldResourceStatuses)),
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Only return %ss loaded from this host.", kind),
+		},
+		"direction": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(pageShieldResourceDirections, false),
+			Description:  fmt.Sprintf("The direction used to sort the %ss. %s", kind, renderAvailableDocumentationValuesStringSlice(pageShieldResourceDirections)),
+		},
+		key: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("A list of %ss discovered by Page Shield.", kind),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("The identifier of the %s.", kind),
+					},
+					"url": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("The URL of the %s.", kind),
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("The host of the %s.", kind),
+					},
+					"first_seen_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("When the %s was first seen.", kind),
+					},
+					"last_seen_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: fmt.Sprintf("When the %s was last seen.", kind),
+					},
+					"page_urls": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: fmt.Sprintf("The pages the %s was seen on.", kind),
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareTeamsProxyEndpointsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description: "The account identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"endpoints": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of Teams Proxy Endpoints in the account.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The proxy endpoint identifier.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the proxy endpoint.",
+					},
+					"subdomain": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The FQDN that proxy clients should be pointed at.",
+					},
+					"ips": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "The networks CIDRs that are allowed to initiate proxy connections.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWaitingRoomEventsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"waiting_room_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The waiting room identifier to list events for.",
+		},
+		"events": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of events configured for the waiting room.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The waiting room event identifier.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the waiting room event.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the waiting room event.",
+					},
+					"event_start_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ISO 8601 timestamp that marks the start of the event.",
+					},
+					"event_end_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ISO 8601 timestamp that marks the end of the event.",
+					},
+					"prequeue_start_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ISO 8601 timestamp that marks when to begin queueing all users before the event starts.",
+					},
+					"suspended": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the event is suspended.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,56 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareWaitingRoomsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.ZoneIDSchemaKey: {
+			Description: "The zone identifier to target for the resource.",
+			Type:        schema.TypeString,
+			Required:    true,
+		},
+		"include_status": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Whether to look up the current status of each waiting room. This makes an additional request per waiting room.",
+		},
+		"waiting_rooms": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of waiting rooms in the zone.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The waiting room identifier.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the waiting room.",
+					},
+					"host": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The host name the waiting room is applied to.",
+					},
+					"path": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The path the waiting room is applied to.",
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The current status of the waiting room. Only set when `include_status` is enabled.",
+					},
+				},
+			},
+		},
+	}
+}
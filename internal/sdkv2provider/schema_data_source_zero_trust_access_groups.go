@@ -0,0 +1,65 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessGroupsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description:  "The account identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		consts.ZoneIDSchemaKey: {
+			Description:  "The zone identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The name of the Access group to look up. More than one group matching the name is an error.",
+		},
+		"groups": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of Access groups matching the lookup.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The Access group identifier.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the Access group.",
+					},
+					"include": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        AccessGroupOptionSchemaElement,
+						Description: "The rules of which at least one must match for a user to be part of the group.",
+					},
+					"exclude": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        AccessGroupOptionSchemaElement,
+						Description: "The rules of which none may match for a user to be part of the group.",
+					},
+					"require": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Elem:        AccessGroupOptionSchemaElement,
+						Description: "The rules of which all must match for a user to be part of the group.",
+					},
+				},
+			},
+		},
+	}
+}
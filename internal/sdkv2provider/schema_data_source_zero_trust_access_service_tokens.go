@@ -0,0 +1,57 @@
+package sdkv2provider
+
+import (
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCloudflareAccessServiceTokensSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		consts.AccountIDSchemaKey: {
+			Description:  "The account identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		consts.ZoneIDSchemaKey: {
+			Description:  "The zone identifier to target for the resource.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ExactlyOneOf: []string{"zone_id", "account_id"},
+		},
+		"service_tokens": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "A list of Access service tokens. Client secrets are not included.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The service token identifier.",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the service token.",
+					},
+					"client_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The client ID of the service token.",
+					},
+					"expires_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Date when the service token will expire.",
+					},
+					"duration": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The duration the service token is valid for.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,15 +2,20 @@ package sdkv2provider
 
 import (
 	"bytes"
+	"context"
 	"crypto/md5"
+	"encoding/json"
 	"fmt"
 	"hash/crc32"
 	"log"
+	"net/http"
+	"net/url"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 
+	"github.com/cloudflare/cloudflare-go"
 	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -268,3 +273,108 @@ func renderAvailableDocumentationValuesIntSlice(s []int) string {
 	}
 	return output
 }
+
+// closestStringMatches returns up to limit candidates that are within a small
+// edit distance of value, closest first. It is used to suggest corrections
+// for mistyped enum values.
+//
+// Example: "dos_attack_l6" -> ["dos_attack_l7", "dos_attack_l4"].
+func closestStringMatches(value string, candidates []string, limit int) []string {
+	maxDistance := len(value)/4 + 1
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	type match struct {
+		value    string
+		distance int
+	}
+
+	var matches []match
+	for _, c := range candidates {
+		distance := levenshteinDistance(value, c)
+		if distance <= maxDistance || (len(value) > 3 && strings.Contains(c, value)) {
+			matches = append(matches, match{c, distance})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	var output []string
+	for i := 0; i < len(matches) && i < limit; i++ {
+		output = append(output, matches[i].value)
+	}
+	return output
+}
+
+// levenshteinDistance returns the number of single character insertions,
+// deletions or substitutions needed to turn a into b.
+func levenshteinDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = previous[j-1] + cost
+			if previous[j]+1 < current[j] {
+				current[j] = previous[j] + 1
+			}
+			if current[j-1]+1 < current[j] {
+				current[j] = current[j-1] + 1
+			}
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+// listAllPagesPerPage is the page size requested by listAllPages.
+const listAllPagesPerPage = 50
+
+// listAllPages requests every page of the collection endpoint at uri, handing
+// the result of each page to appendPage which returns the number of items it
+// contained. Listing stops at the first empty page rather than the first short
+// one so endpoints capping `per_page` below the requested size aren't cut off,
+// and fails if a page repeats the previous one as the endpoint then ignores
+// `page` and would otherwise be requested forever.
+func listAllPages(ctx context.Context, client *cloudflare.API, uri string, filters url.Values, appendPage func(json.RawMessage) (int, error)) error {
+	var previous json.RawMessage
+	for page := 1; ; page++ {
+		params := url.Values{}
+		for k, v := range filters {
+			params[k] = v
+		}
+		params.Set("page", strconv.Itoa(page))
+		params.Set("per_page", strconv.Itoa(listAllPagesPerPage))
+
+		res, err := client.Raw(ctx, http.MethodGet, fmt.Sprintf("%s?%s", uri, params.Encode()), nil, nil)
+		if err != nil {
+			return err
+		}
+
+		if page > 1 && bytes.Equal(res, previous) {
+			return fmt.Errorf("page %d of %s repeats page %d; the endpoint does not support pagination", page, uri, page-1)
+		}
+		previous = res
+
+		count, err := appendPage(res)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal page %d: %w", page, err)
+		}
+
+		if count == 0 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package sdkv2provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAllPages(t *testing.T) {
+	testCases := map[string]struct {
+		total         int
+		pageSize      int
+		ignorePage    bool
+		expectedPages []string
+		expectedError string
+	}{
+		"multiple pages": {
+			total:         listAllPagesPerPage + 3,
+			pageSize:      listAllPagesPerPage,
+			expectedPages: []string{"1", "2", "3"},
+		},
+		"page size capped by the endpoint": {
+			total:         25,
+			pageSize:      10,
+			expectedPages: []string{"1", "2", "3", "4"},
+		},
+		"empty collection": {
+			pageSize:      listAllPagesPerPage,
+			expectedPages: []string{"1"},
+		},
+		"page ignored by the endpoint": {
+			total:         5,
+			pageSize:      listAllPagesPerPage,
+			ignorePage:    true,
+			expectedPages: []string{"1", "2"},
+			expectedError: "page 2 of /zones/zone/items repeats page 1; the endpoint does not support pagination",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var requestedPages []string
+
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/zones/zone/items", r.URL.Path)
+				assert.Equal(t, "active", r.URL.Query().Get("status"))
+				assert.Equal(t, strconv.Itoa(listAllPagesPerPage), r.URL.Query().Get("per_page"))
+
+				requestedPages = append(requestedPages, r.URL.Query().Get("page"))
+				page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+				if tc.ignorePage {
+					page = 1
+				}
+
+				items := []string{}
+				for i := (page - 1) * tc.pageSize; i < tc.total && i < page*tc.pageSize; i++ {
+					items = append(items, strconv.Itoa(i))
+				}
+
+				testclient.WriteResult(w, items)
+			}))
+
+			var items []string
+			err := listAllPages(context.Background(), client, "/zones/zone/items", url.Values{"status": {"active"}}, func(page json.RawMessage) (int, error) {
+				var pageItems []string
+				if err := json.Unmarshal(page, &pageItems); err != nil {
+					return 0, err
+				}
+				items = append(items, pageItems...)
+				return len(pageItems), nil
+			})
+
+			assert.Equal(t, tc.expectedPages, requestedPages)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, items, tc.total)
+		})
+	}
+}
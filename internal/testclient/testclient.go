@@ -0,0 +1,72 @@
+// Package testclient provides a Cloudflare API client backed by a local test
+// server so that unit tests can exercise API calls without an account.
+package testclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// response is the envelope every Cloudflare API response is wrapped in.
+type response struct {
+	cloudflare.Response
+	Result     interface{}            `json:"result"`
+	ResultInfo *cloudflare.ResultInfo `json:"result_info,omitempty"`
+}
+
+// New returns an API client whose requests are served by handler. Any opts
+// are applied after the base URL of the test server. The test server is
+// closed once the test completes.
+func New(t *testing.T, handler http.Handler, opts ...cloudflare.Option) *cloudflare.API {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := cloudflare.NewWithAPIToken("token", append([]cloudflare.Option{cloudflare.BaseURL(server.URL)}, opts...)...)
+	if err != nil {
+		t.Fatalf("failed to create API client: %s", err)
+	}
+
+	return client
+}
+
+// WriteResult writes a successful response with result, which may be a
+// json.RawMessage to respond with a literal JSON document.
+func WriteResult(w http.ResponseWriter, result interface{}) {
+	write(w, http.StatusOK, response{Response: cloudflare.Response{Success: true}, Result: result})
+}
+
+// WriteResultWithInfo writes a successful response with result and the
+// pagination details of a list endpoint.
+func WriteResultWithInfo(w http.ResponseWriter, result interface{}, info cloudflare.ResultInfo) {
+	write(w, http.StatusOK, response{Response: cloudflare.Response{Success: true}, Result: result, ResultInfo: &info})
+}
+
+// WriteErrors writes an unsuccessful response with status and errors.
+func WriteErrors(w http.ResponseWriter, status int, errors ...cloudflare.ResponseInfo) {
+	write(w, status, response{Response: cloudflare.Response{Errors: errors}})
+}
+
+func write(w http.ResponseWriter, status int, res response) {
+	if res.Errors == nil {
+		res.Errors = []cloudflare.ResponseInfo{}
+	}
+	if res.Messages == nil {
+		res.Messages = []cloudflare.ResponseInfo{}
+	}
+
+	body, err := json.Marshal(res)
+	if err != nil {
+		panic(fmt.Sprintf("failed to marshal test response: %s", err))
+	}
+
+	w.Header().Set("content-type", "application/json")
+	w.WriteHeader(status)
+	w.Write(body) //nolint:errcheck
+}
@@ -0,0 +1,21 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+)
+
+// accountIDRegexp matches a Cloudflare account identifier.
+var accountIDRegexp = regexp.MustCompile(`^[a-f0-9]{32}$`)
+
+// ValidateAccountID returns an error if the provider level account_id is not
+// a valid account identifier. Checking it when the provider is configured
+// reports a mistake once instead of on every resource using it.
+func ValidateAccountID(accountID string) error {
+	if !accountIDRegexp.MatchString(accountID) {
+		return fmt.Errorf("%q must be a 32 character hexadecimal account ID, got %q", consts.AccountIDSchemaKey, accountID)
+	}
+	return nil
+}
@@ -0,0 +1,13 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAccountID(t *testing.T) {
+	assert.NoError(t, ValidateAccountID("f037e56e89293a057740de681ac9abbe"))
+	assert.EqualError(t, ValidateAccountID("my-account"), `"account_id" must be a 32 character hexadecimal account ID, got "my-account"`)
+	assert.Error(t, ValidateAccountID("F037E56E89293A057740DE681AC9ABBE"))
+}
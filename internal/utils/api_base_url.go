@@ -0,0 +1,26 @@
+package utils
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// ValidateAPIBaseURL checks that s is an absolute HTTP or HTTPS URL, such as
+// "https://api.cloudflare.com/client/v4", that can be handed to the API
+// client as its base URL.
+func ValidateAPIBaseURL(s string) error {
+	u, err := url.Parse(s)
+	if err != nil {
+		return fmt.Errorf("invalid API base URL %q: %w", s, err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid API base URL %q: scheme must be http or https", s)
+	}
+
+	if u.Host == "" {
+		return fmt.Errorf("invalid API base URL %q: missing host", s)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateAPIBaseURL(t *testing.T) {
+	testCases := map[string]struct {
+		value string
+		err   bool
+	}{
+		"default API":    {value: "https://api.cloudflare.com/client/v4"},
+		"local mock":     {value: "http://127.0.0.1:8080"},
+		"gateway path":   {value: "https://gateway.example.com/cloudflare/client/v4"},
+		"missing scheme": {value: "api.cloudflare.com/client/v4", err: true},
+		"other scheme":   {value: "ftp://api.cloudflare.com", err: true},
+		"missing host":   {value: "https:///client/v4", err: true},
+		"unparsable":     {value: "https://api.cloudflare.com:port", err: true},
+		"empty":          {value: "", err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAPIBaseURL(tc.value)
+			if tc.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
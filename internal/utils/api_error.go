@@ -0,0 +1,56 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+)
+
+// cloudflareAPIError is implemented by every error type cloudflare-go returns
+// for an unsuccessful API response.
+type cloudflareAPIError interface {
+	error
+	ErrorCodes() []int
+	RayID() string
+	Type() cloudflare.ErrorType
+}
+
+// APIErrorDetail returns the error codes, error type and ray ID of the
+// Cloudflare API error found anywhere in the chain of err, one per line, using
+// a fixed format so they can be searched for in logs and support requests.
+// An empty string is returned when err doesn't wrap an API error.
+func APIErrorDetail(err error) string {
+	var apiErr cloudflareAPIError
+	if !errors.As(err, &apiErr) {
+		return ""
+	}
+
+	var detail []string
+	if codes := apiErr.ErrorCodes(); len(codes) > 0 {
+		formatted := make([]string, 0, len(codes))
+		for _, code := range codes {
+			formatted = append(formatted, fmt.Sprint(code))
+		}
+		detail = append(detail, fmt.Sprintf("Cloudflare error codes: %s", strings.Join(formatted, ", ")))
+	}
+	if errorType := apiErr.Type(); errorType != "" {
+		detail = append(detail, fmt.Sprintf("Cloudflare error type: %s", errorType))
+	}
+	if rayID := apiErr.RayID(); rayID != "" {
+		detail = append(detail, fmt.Sprintf("Cloudflare ray ID: %s", rayID))
+	}
+
+	return strings.Join(detail, "\n")
+}
+
+// ErrorDetail returns the message of err followed by its APIErrorDetail, for
+// use as the detail of a plugin framework diagnostic.
+func ErrorDetail(err error) string {
+	if detail := APIErrorDetail(err); detail != "" {
+		return err.Error() + "\n\n" + detail
+	}
+
+	return err.Error()
+}
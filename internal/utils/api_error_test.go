@@ -0,0 +1,29 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorDetail(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("cf-ray", "7f1234567890abcd-LHR")
+		testclient.WriteErrors(w, http.StatusBadRequest, cloudflare.ResponseInfo{Code: 1004, Message: "DNS Validation Error"})
+	}))
+
+	_, apiErr := client.Raw(context.Background(), http.MethodPost, "/zones/zone/dns_records", nil, nil)
+	assert.Error(t, apiErr)
+
+	assert.Equal(t,
+		"error creating DNS record: DNS Validation Error (1004)\n\nCloudflare error codes: 1004\nCloudflare error type: request\nCloudflare ray ID: 7f1234567890abcd-LHR",
+		ErrorDetail(fmt.Errorf("error creating DNS record: %w", apiErr)),
+	)
+	assert.Equal(t, "boom", ErrorDetail(errors.New("boom")))
+}
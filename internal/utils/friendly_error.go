@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"errors"
+)
+
+// cloudflareErrorCodes is implemented by every error type cloudflare-go
+// returns for an unsuccessful API response.
+type cloudflareErrorCodes interface {
+	ErrorCodes() []int
+}
+
+// FriendlyError replaces the message of err with message when a Cloudflare API
+// error carrying code is found in its chain, which allows resources to tell
+// users how to resolve a known failure rather than only echoing the API. The
+// original error is kept as the cause so its codes and ray ID remain
+// available through errors.As. Any other error is returned unchanged.
+func FriendlyError(err error, code int, message string) error {
+	var apiErr cloudflareErrorCodes
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	for _, c := range apiErr.ErrorCodes() {
+		if c == code {
+			return &friendlyError{message: message, cause: err}
+		}
+	}
+
+	return err
+}
+
+// IsFriendlyError reports whether err has been mapped by FriendlyError.
+func IsFriendlyError(err error) bool {
+	var friendly *friendlyError
+	return errors.As(err, &friendly)
+}
+
+type friendlyError struct {
+	message string
+	cause   error
+}
+
+func (e *friendlyError) Error() string {
+	return e.message
+}
+
+func (e *friendlyError) Unwrap() error {
+	return e.cause
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFriendlyError(t *testing.T) {
+	client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		testclient.WriteErrors(w, http.StatusBadRequest, cloudflare.ResponseInfo{Code: 81057, Message: "Record already exists."})
+	}))
+
+	_, apiErr := client.Raw(context.Background(), http.MethodPost, "/zones/zone/dns_records", nil, nil)
+	assert.Error(t, apiErr)
+
+	testCases := map[string]struct {
+		err      error
+		code     int
+		expected string
+		friendly bool
+	}{
+		"matching code":         {err: apiErr, code: 81057, expected: "record exists, import it", friendly: true},
+		"matching wrapped code": {err: fmt.Errorf("create: %w", apiErr), code: 81057, expected: "record exists, import it", friendly: true},
+		"other code":            {err: apiErr, code: 1004, expected: apiErr.Error()},
+		"not an API error":      {err: errors.New("boom"), code: 81057, expected: "boom"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got := FriendlyError(tc.err, tc.code, "record exists, import it")
+			assert.Equal(t, tc.expected, got.Error())
+			assert.Equal(t, tc.friendly, IsFriendlyError(got))
+			assert.ErrorIs(t, got, tc.err)
+		})
+	}
+}
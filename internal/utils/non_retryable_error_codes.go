@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// ParseErrorCodes parses a comma separated list of Cloudflare API error codes
+// such as "1004,10000".
+func ParseErrorCodes(s string) ([]int, error) {
+	var codes []int
+
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		code, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid error code %q: %w", v, err)
+		}
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+// NewNonRetryableErrorCodesTransport wraps base with a http.RoundTripper that
+// rewrites server error (5xx) responses containing any of the provided
+// Cloudflare API error codes into a 400 Bad Request. The API client only
+// retries rate limited and server error responses so this makes matching
+// requests fail immediately with the original error body instead of backing
+// off until the retry policy is exhausted.
+func NewNonRetryableErrorCodesTransport(codes []int, base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	lookup := make(map[int]struct{}, len(codes))
+	for _, code := range codes {
+		lookup[code] = struct{}{}
+	}
+
+	return &nonRetryableErrorCodesTransport{codes: lookup, base: base}
+}
+
+type nonRetryableErrorCodesTransport struct {
+	codes map[int]struct{}
+	base  http.RoundTripper
+}
+
+type apiErrorResponse struct {
+	Errors []struct {
+		Code int `json:"code"`
+	} `json:"errors"`
+}
+
+func (t *nonRetryableErrorCodesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil || resp.StatusCode < http.StatusInternalServerError || len(t.codes) == 0 {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	var apiErr apiErrorResponse
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return resp, nil
+	}
+
+	for _, e := range apiErr.Errors {
+		if _, ok := t.codes[e.Code]; ok {
+			resp.StatusCode = http.StatusBadRequest
+			resp.Status = fmt.Sprintf("%d %s", http.StatusBadRequest, http.StatusText(http.StatusBadRequest))
+			break
+		}
+	}
+
+	return resp, nil
+}
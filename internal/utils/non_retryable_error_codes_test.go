@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/cloudflare/cloudflare-go"
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/testclient"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseErrorCodes(t *testing.T) {
+	testCases := map[string]struct {
+		value    string
+		expected []int
+		err      bool
+	}{
+		"empty":          {value: "", expected: nil},
+		"single":         {value: "1004", expected: []int{1004}},
+		"multiple":       {value: "1004,10000", expected: []int{1004, 10000}},
+		"whitespace":     {value: " 1004 , 10000 ,", expected: []int{1004, 10000}},
+		"invalid number": {value: "1004,abc", err: true},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseErrorCodes(tc.value)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestNonRetryableErrorCodesTransport(t *testing.T) {
+	testCases := map[string]struct {
+		errorCode        int
+		expectedRequests int
+	}{
+		"configured code fails fast":      {errorCode: 1004, expectedRequests: 1},
+		"other codes follow retry policy": {errorCode: 10000, expectedRequests: 3},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			requests := 0
+			client := testclient.New(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				testclient.WriteErrors(w, http.StatusInternalServerError, cloudflare.ResponseInfo{Code: tc.errorCode, Message: "something went wrong"})
+			}),
+				cloudflare.UsingRetryPolicy(2, 0, 0),
+				cloudflare.HTTPClient(&http.Client{
+					Transport: NewNonRetryableErrorCodesTransport([]int{1004}, nil),
+				}),
+			)
+
+			_, err := client.Raw(context.Background(), http.MethodGet, "/zones", nil, nil)
+			assert.Error(t, err)
+			assert.Equal(t, tc.expectedRequests, requests)
+		})
+	}
+}
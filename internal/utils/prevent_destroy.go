@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cloudflare/terraform-provider-cloudflare/internal/consts"
+)
+
+// PreventDestroyError is returned instead of deleting a resource when
+// prevent_destroy_override is enabled.
+func PreventDestroyError(typeName, id string) error {
+	return fmt.Errorf("refusing to delete %s %q: %s is enabled in the provider configuration", typeName, id, consts.PreventDestroyOverrideSchemaKey)
+}
+
+// PreventDestroyOverrideFromEnv returns whether prevent_destroy_override is
+// enabled in the environment, for providers that don't configure it.
+func PreventDestroyOverrideFromEnv() (bool, error) {
+	v := GetDefaultFromEnv(consts.PreventDestroyOverrideEnvVarKey, "")
+	if v == "" {
+		return false, nil
+	}
+
+	enabled, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid value %q for %s: %w", v, consts.PreventDestroyOverrideEnvVarKey, err)
+	}
+
+	return enabled, nil
+}
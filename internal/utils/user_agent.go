@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// UserAgentOperatorSuffixRegexp matches the characters permitted in the
+// operator suffix. Only printable ASCII is allowed as anything else (control
+// characters, new lines, etc) would break the User-Agent header.
+var UserAgentOperatorSuffixRegexp = regexp.MustCompile(`^[\x20-\x7E]*$`)
+
+// BuildUserAgent appends the sanitised operator suffix to the User-Agent
+// string. An error is returned if the suffix contains characters that are not
+// safe to send in a HTTP header.
+func BuildUserAgent(ua, suffix string) (string, error) {
+	suffix = strings.TrimSpace(suffix)
+	if suffix == "" {
+		return ua, nil
+	}
+
+	if !UserAgentOperatorSuffixRegexp.MatchString(suffix) {
+		return "", fmt.Errorf("user agent operator suffix %q must only contain printable ASCII characters", suffix)
+	}
+
+	return fmt.Sprintf("%s %s", ua, suffix), nil
+}
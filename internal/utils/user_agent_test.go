@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildUserAgent(t *testing.T) {
+	ua := "terraform/1.4.0 terraform-plugin-sdk/2.29.0 terraform-provider-cloudflare/dev"
+
+	testCases := map[string]struct {
+		suffix   string
+		expected string
+		err      bool
+	}{
+		"empty":                  {suffix: "", expected: ua},
+		"whitespace only":        {suffix: "   ", expected: ua},
+		"simple":                 {suffix: "team-a", expected: ua + " team-a"},
+		"trimmed":                {suffix: "  team-a/product-b  ", expected: ua + " team-a/product-b"},
+		"new line":               {suffix: "team-a\r\nX-Injected: true", err: true},
+		"control character":      {suffix: "team\x00a", err: true},
+		"non ascii":              {suffix: "équipe", err: true},
+		"tab inside the value":   {suffix: "team\ta", err: true},
+		"punctuation is allowed": {suffix: "(team-a; product=b)", expected: ua + " (team-a; product=b)"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := BuildUserAgent(ua, tc.suffix)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
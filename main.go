@@ -6,12 +6,7 @@ import (
 	"log"
 
 	framework "github.com/cloudflare/terraform-provider-cloudflare/internal/framework/provider"
-	"github.com/cloudflare/terraform-provider-cloudflare/internal/sdkv2provider"
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 )
 
 var (
@@ -25,20 +20,7 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers")
 	flag.Parse()
 
-	ctx := context.Background()
-	upgradedSdkProvider, err := tf5to6server.UpgradeServer(ctx, sdkv2provider.New(version)().GRPCProvider)
-	if err != nil {
-		log.Fatal(err)
-	}
-	providers := []func() tfprotov6.ProviderServer{
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkProvider
-		},
-		providerserver.NewProtocol6(framework.New(version)()),
-	}
-
-	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
-
+	muxServer, err := framework.ProtocolVersion6ProviderServerFactory(context.Background(), version)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -50,7 +32,7 @@ func main() {
 
 	err = tf6server.Serve(
 		"registry.terraform.io/cloudflare/cloudflare",
-		muxServer.ProviderServer,
+		muxServer,
 		serveOpts...,
 	)
 